@@ -27,16 +27,19 @@ const (
 type LockMechanism int32
 
 const (
-	LockMechanism_HTLC LockMechanism = 0
+	LockMechanism_HTLC      LockMechanism = 0
+	LockMechanism_SIGNATURE LockMechanism = 1
 )
 
 // Enum value maps for LockMechanism.
 var (
 	LockMechanism_name = map[int32]string{
 		0: "HTLC",
+		1: "SIGNATURE",
 	}
 	LockMechanism_value = map[string]int32{
-		"HTLC": 0,
+		"HTLC":      0,
+		"SIGNATURE": 1,
 	}
 )
 
@@ -72,6 +75,10 @@ type HashMechanism int32
 const (
 	HashMechanism_SHA256 HashMechanism = 0
 	HashMechanism_SHA512 HashMechanism = 1
+	// Keccak-256, as used by Ethereum (e.g., by HTLC contracts on Ethereum and Besu networks)
+	HashMechanism_KECCAK256 HashMechanism = 2
+	// SHA3-256, as standardized in FIPS 202
+	HashMechanism_SHA3_256 HashMechanism = 3
 )
 
 // Enum value maps for HashMechanism.
@@ -79,10 +86,14 @@ var (
 	HashMechanism_name = map[int32]string{
 		0: "SHA256",
 		1: "SHA512",
+		2: "KECCAK256",
+		3: "SHA3_256",
 	}
 	HashMechanism_value = map[string]int32{
-		"SHA256": 0,
-		"SHA512": 1,
+		"SHA256":    0,
+		"SHA512":    1,
+		"KECCAK256": 2,
+		"SHA3_256":  3,
 	}
 )
 
@@ -159,6 +170,67 @@ func (TimeSpec) EnumDescriptor() ([]byte, []int) {
 	return file_common_asset_locks_proto_rawDescGZIP(), []int{2}
 }
 
+type AssetLockEventType int32
+
+const (
+	AssetLockEventType_LOCKED            AssetLockEventType = 0
+	AssetLockEventType_CLAIMED           AssetLockEventType = 1
+	AssetLockEventType_UNLOCKED          AssetLockEventType = 2
+	AssetLockEventType_EXTENDED          AssetLockEventType = 3 // expiry of the lock extended by agreement of the locker and the recipient
+	AssetLockEventType_CANCELLED         AssetLockEventType = 4 // lock cancelled by agreement of the locker and the recipient
+	AssetLockEventType_EXPIRED           AssetLockEventType = 5 // expiry of the lock elapsed; the lock stays until the locker unlocks the asset to take it back
+	AssetLockEventType_PARTIALLY_CLAIMED AssetLockEventType = 6 // some of the locked units of a group of fungible assets claimed; the rest stay locked
+)
+
+// Enum value maps for AssetLockEventType.
+var (
+	AssetLockEventType_name = map[int32]string{
+		0: "LOCKED",
+		1: "CLAIMED",
+		2: "UNLOCKED",
+		3: "EXTENDED",
+		4: "CANCELLED",
+		5: "EXPIRED",
+		6: "PARTIALLY_CLAIMED",
+	}
+	AssetLockEventType_value = map[string]int32{
+		"LOCKED":            0,
+		"CLAIMED":           1,
+		"UNLOCKED":          2,
+		"EXTENDED":          3,
+		"CANCELLED":         4,
+		"EXPIRED":           5,
+		"PARTIALLY_CLAIMED": 6,
+	}
+)
+
+func (x AssetLockEventType) Enum() *AssetLockEventType {
+	p := new(AssetLockEventType)
+	*p = x
+	return p
+}
+
+func (x AssetLockEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetLockEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_asset_locks_proto_enumTypes[3].Descriptor()
+}
+
+func (AssetLockEventType) Type() protoreflect.EnumType {
+	return &file_common_asset_locks_proto_enumTypes[3]
+}
+
+func (x AssetLockEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetLockEventType.Descriptor instead.
+func (AssetLockEventType) EnumDescriptor() ([]byte, []int) {
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{3}
+}
+
 type AssetLock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -274,10 +346,12 @@ type AssetLockHTLC struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HashMechanism  HashMechanism `protobuf:"varint,1,opt,name=hashMechanism,proto3,enum=common.asset_locks.HashMechanism" json:"hashMechanism,omitempty"`
-	HashBase64     []byte        `protobuf:"bytes,2,opt,name=hashBase64,proto3" json:"hashBase64,omitempty"`
-	ExpiryTimeSecs uint64        `protobuf:"varint,3,opt,name=expiryTimeSecs,proto3" json:"expiryTimeSecs,omitempty"`
-	TimeSpec       TimeSpec      `protobuf:"varint,4,opt,name=timeSpec,proto3,enum=common.asset_locks.TimeSpec" json:"timeSpec,omitempty"`
+	HashMechanism HashMechanism `protobuf:"varint,1,opt,name=hashMechanism,proto3,enum=common.asset_locks.HashMechanism" json:"hashMechanism,omitempty"`
+	HashBase64    []byte        `protobuf:"bytes,2,opt,name=hashBase64,proto3" json:"hashBase64,omitempty"`
+	// Interpreted as per 'timeSpec': an absolute expiry time (EPOCH) or a lock duration (DURATION),
+	// both in seconds
+	ExpiryTimeSecs uint64   `protobuf:"varint,3,opt,name=expiryTimeSecs,proto3" json:"expiryTimeSecs,omitempty"`
+	TimeSpec       TimeSpec `protobuf:"varint,4,opt,name=timeSpec,proto3,enum=common.asset_locks.TimeSpec" json:"timeSpec,omitempty"`
 }
 
 func (x *AssetLockHTLC) Reset() {
@@ -395,6 +469,185 @@ func (x *AssetClaimHTLC) GetHashPreimageBase64() []byte {
 	return nil
 }
 
+// Lock that is released to the recipient on a claim signed by at least 'threshold' of the 'approvers'
+// (e.g., a single arbiter or escrow with threshold 1, or an M-of-N set of approvers)
+type AssetLockSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base64 encoded PEM certificates of the approvers
+	Approvers []string `protobuf:"bytes,1,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Threshold uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Interpreted as per 'timeSpec', as in 'AssetLockHTLC'
+	ExpiryTimeSecs uint64   `protobuf:"varint,3,opt,name=expiryTimeSecs,proto3" json:"expiryTimeSecs,omitempty"`
+	TimeSpec       TimeSpec `protobuf:"varint,4,opt,name=timeSpec,proto3,enum=common.asset_locks.TimeSpec" json:"timeSpec,omitempty"`
+}
+
+func (x *AssetLockSignature) Reset() {
+	*x = AssetLockSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_asset_locks_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetLockSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetLockSignature) ProtoMessage() {}
+
+func (x *AssetLockSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_common_asset_locks_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetLockSignature.ProtoReflect.Descriptor instead.
+func (*AssetLockSignature) Descriptor() ([]byte, []int) {
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AssetLockSignature) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *AssetLockSignature) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AssetLockSignature) GetExpiryTimeSecs() uint64 {
+	if x != nil {
+		return x.ExpiryTimeSecs
+	}
+	return 0
+}
+
+func (x *AssetLockSignature) GetTimeSpec() TimeSpec {
+	if x != nil {
+		return x.TimeSpec
+	}
+	return TimeSpec_EPOCH
+}
+
+type ApproverSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base64 encoded PEM certificate of the approver, as listed in the lock
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	// Signature by the approver over the contractId of the lock
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ApproverSignature) Reset() {
+	*x = ApproverSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_asset_locks_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproverSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproverSignature) ProtoMessage() {}
+
+func (x *ApproverSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_common_asset_locks_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproverSignature.ProtoReflect.Descriptor instead.
+func (*ApproverSignature) Descriptor() ([]byte, []int) {
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ApproverSignature) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *ApproverSignature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type AssetClaimSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signatures []*ApproverSignature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *AssetClaimSignature) Reset() {
+	*x = AssetClaimSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_asset_locks_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetClaimSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetClaimSignature) ProtoMessage() {}
+
+func (x *AssetClaimSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_common_asset_locks_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetClaimSignature.ProtoReflect.Descriptor instead.
+func (*AssetClaimSignature) Descriptor() ([]byte, []int) {
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssetClaimSignature) GetSignatures() []*ApproverSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
 type AssetExchangeAgreement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -409,7 +662,7 @@ type AssetExchangeAgreement struct {
 func (x *AssetExchangeAgreement) Reset() {
 	*x = AssetExchangeAgreement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_asset_locks_proto_msgTypes[4]
+		mi := &file_common_asset_locks_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +675,7 @@ func (x *AssetExchangeAgreement) String() string {
 func (*AssetExchangeAgreement) ProtoMessage() {}
 
 func (x *AssetExchangeAgreement) ProtoReflect() protoreflect.Message {
-	mi := &file_common_asset_locks_proto_msgTypes[4]
+	mi := &file_common_asset_locks_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +688,7 @@ func (x *AssetExchangeAgreement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetExchangeAgreement.ProtoReflect.Descriptor instead.
 func (*AssetExchangeAgreement) Descriptor() ([]byte, []int) {
-	return file_common_asset_locks_proto_rawDescGZIP(), []int{4}
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AssetExchangeAgreement) GetAssetType() string {
@@ -482,7 +735,7 @@ type HybridAssetExchangeAgreement struct {
 func (x *HybridAssetExchangeAgreement) Reset() {
 	*x = HybridAssetExchangeAgreement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_asset_locks_proto_msgTypes[5]
+		mi := &file_common_asset_locks_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -495,7 +748,7 @@ func (x *HybridAssetExchangeAgreement) String() string {
 func (*HybridAssetExchangeAgreement) ProtoMessage() {}
 
 func (x *HybridAssetExchangeAgreement) ProtoReflect() protoreflect.Message {
-	mi := &file_common_asset_locks_proto_msgTypes[5]
+	mi := &file_common_asset_locks_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +761,7 @@ func (x *HybridAssetExchangeAgreement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HybridAssetExchangeAgreement.ProtoReflect.Descriptor instead.
 func (*HybridAssetExchangeAgreement) Descriptor() ([]byte, []int) {
-	return file_common_asset_locks_proto_rawDescGZIP(), []int{5}
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HybridAssetExchangeAgreement) GetAssetType() string {
@@ -567,7 +820,7 @@ type FungibleAssetExchangeAgreement struct {
 func (x *FungibleAssetExchangeAgreement) Reset() {
 	*x = FungibleAssetExchangeAgreement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_asset_locks_proto_msgTypes[6]
+		mi := &file_common_asset_locks_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -580,7 +833,7 @@ func (x *FungibleAssetExchangeAgreement) String() string {
 func (*FungibleAssetExchangeAgreement) ProtoMessage() {}
 
 func (x *FungibleAssetExchangeAgreement) ProtoReflect() protoreflect.Message {
-	mi := &file_common_asset_locks_proto_msgTypes[6]
+	mi := &file_common_asset_locks_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +846,7 @@ func (x *FungibleAssetExchangeAgreement) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FungibleAssetExchangeAgreement.ProtoReflect.Descriptor instead.
 func (*FungibleAssetExchangeAgreement) Descriptor() ([]byte, []int) {
-	return file_common_asset_locks_proto_rawDescGZIP(), []int{6}
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *FungibleAssetExchangeAgreement) GetAssetType() string {
@@ -638,7 +891,7 @@ type AssetContractHTLC struct {
 func (x *AssetContractHTLC) Reset() {
 	*x = AssetContractHTLC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_asset_locks_proto_msgTypes[7]
+		mi := &file_common_asset_locks_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -651,7 +904,7 @@ func (x *AssetContractHTLC) String() string {
 func (*AssetContractHTLC) ProtoMessage() {}
 
 func (x *AssetContractHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_common_asset_locks_proto_msgTypes[7]
+	mi := &file_common_asset_locks_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +917,7 @@ func (x *AssetContractHTLC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssetContractHTLC.ProtoReflect.Descriptor instead.
 func (*AssetContractHTLC) Descriptor() ([]byte, []int) {
-	return file_common_asset_locks_proto_rawDescGZIP(), []int{7}
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AssetContractHTLC) GetContractId() string {
@@ -709,7 +962,7 @@ type FungibleAssetContractHTLC struct {
 func (x *FungibleAssetContractHTLC) Reset() {
 	*x = FungibleAssetContractHTLC{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_asset_locks_proto_msgTypes[8]
+		mi := &file_common_asset_locks_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -722,7 +975,7 @@ func (x *FungibleAssetContractHTLC) String() string {
 func (*FungibleAssetContractHTLC) ProtoMessage() {}
 
 func (x *FungibleAssetContractHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_common_asset_locks_proto_msgTypes[8]
+	mi := &file_common_asset_locks_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +988,7 @@ func (x *FungibleAssetContractHTLC) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FungibleAssetContractHTLC.ProtoReflect.Descriptor instead.
 func (*FungibleAssetContractHTLC) Descriptor() ([]byte, []int) {
-	return file_common_asset_locks_proto_rawDescGZIP(), []int{8}
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FungibleAssetContractHTLC) GetContractId() string {
@@ -766,6 +1019,169 @@ func (x *FungibleAssetContractHTLC) GetClaim() *AssetClaimHTLC {
 	return nil
 }
 
+// Event emitted on a state transition of an asset lock; 'assetId' is set for a non-fungible asset,
+// 'numUnits' for a group of fungible assets, and both for a hybrid asset; a claim of fungible assets
+// reports the units it moved, as the lock can be claimed in tranches
+type AssetLockEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType  AssetLockEventType `protobuf:"varint,1,opt,name=eventType,proto3,enum=common.asset_locks.AssetLockEventType" json:"eventType,omitempty"`
+	ContractId string             `protobuf:"bytes,2,opt,name=contractId,proto3" json:"contractId,omitempty"`
+	AssetType  string             `protobuf:"bytes,3,opt,name=assetType,proto3" json:"assetType,omitempty"`
+	AssetId    string             `protobuf:"bytes,4,opt,name=assetId,proto3" json:"assetId,omitempty"`
+	NumUnits   uint64             `protobuf:"varint,5,opt,name=numUnits,proto3" json:"numUnits,omitempty"`
+	Locker     string             `protobuf:"bytes,6,opt,name=locker,proto3" json:"locker,omitempty"`
+	Recipient  string             `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Resolved expiry, an epoch time in seconds unless 'timeSpec' says otherwise
+	ExpiryTimeSecs uint64   `protobuf:"varint,8,opt,name=expiryTimeSecs,proto3" json:"expiryTimeSecs,omitempty"`
+	TimeSpec       TimeSpec `protobuf:"varint,9,opt,name=timeSpec,proto3,enum=common.asset_locks.TimeSpec" json:"timeSpec,omitempty"`
+}
+
+func (x *AssetLockEvent) Reset() {
+	*x = AssetLockEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_asset_locks_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetLockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetLockEvent) ProtoMessage() {}
+
+func (x *AssetLockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_common_asset_locks_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetLockEvent.ProtoReflect.Descriptor instead.
+func (*AssetLockEvent) Descriptor() ([]byte, []int) {
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AssetLockEvent) GetEventType() AssetLockEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AssetLockEventType_LOCKED
+}
+
+func (x *AssetLockEvent) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *AssetLockEvent) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *AssetLockEvent) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *AssetLockEvent) GetNumUnits() uint64 {
+	if x != nil {
+		return x.NumUnits
+	}
+	return 0
+}
+
+func (x *AssetLockEvent) GetLocker() string {
+	if x != nil {
+		return x.Locker
+	}
+	return ""
+}
+
+func (x *AssetLockEvent) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AssetLockEvent) GetExpiryTimeSecs() uint64 {
+	if x != nil {
+		return x.ExpiryTimeSecs
+	}
+	return 0
+}
+
+func (x *AssetLockEvent) GetTimeSpec() TimeSpec {
+	if x != nil {
+		return x.TimeSpec
+	}
+	return TimeSpec_EPOCH
+}
+
+// Event emitted by a transaction making several asset lock state transitions, as a transaction carries a single event
+type AssetLockEvents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AssetLockEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *AssetLockEvents) Reset() {
+	*x = AssetLockEvents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_asset_locks_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetLockEvents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetLockEvents) ProtoMessage() {}
+
+func (x *AssetLockEvents) ProtoReflect() protoreflect.Message {
+	mi := &file_common_asset_locks_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetLockEvents.ProtoReflect.Descriptor instead.
+func (*AssetLockEvents) Descriptor() ([]byte, []int) {
+	return file_common_asset_locks_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AssetLockEvents) GetEvents() []*AssetLockEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 var File_common_asset_locks_proto protoreflect.FileDescriptor
 
 var file_common_asset_locks_proto_rawDesc = []byte{
@@ -808,83 +1224,144 @@ var file_common_asset_locks_proto_rawDesc = []byte{
 	0x0d, 0x68, 0x61, 0x73, 0x68, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x2e,
 	0x0a, 0x12, 0x68, 0x61, 0x73, 0x68, 0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61,
 	0x73, 0x65, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x68, 0x61, 0x73, 0x68,
-	0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x7c,
-	0x0a, 0x16, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
-	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xbc, 0x01, 0x0a,
-	0x1c, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d,
-	0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d,
-	0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1e,
-	0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
+	0x50, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0xb2,
+	0x01, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x22, 0x4d, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x7c, 0x0a, 0x16, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
 	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
 	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xee,
-	0x01, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x48, 0x54, 0x4c, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35,
-	0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x54, 0x4c, 0x43, 0x52,
-	0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43,
-	0x6c, 0x61, 0x69, 0x6d, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22,
-	0xfe, 0x01, 0x0a, 0x19, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a,
-	0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x35, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x54, 0x4c, 0x43,
-	0x52, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d,
-	0x2a, 0x19, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73,
-	0x6d, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x4c, 0x43, 0x10, 0x00, 0x2a, 0x27, 0x0a, 0x0d, 0x48,
-	0x61, 0x73, 0x68, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x0a, 0x0a, 0x06,
-	0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x35,
-	0x31, 0x32, 0x10, 0x01, 0x2a, 0x23, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44,
-	0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x42, 0x7e, 0x0a, 0x36, 0x6f, 0x72, 0x67,
-	0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x61, 0x63,
-	0x74, 0x69, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xbc,
+	0x01, 0x0a, 0x1c, 0x48, 0x79, 0x62, 0x72, 0x69, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e,
+	0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x01,
+	0x0a, 0x1e, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x73, 0x73, 0x65, 0x74, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x22, 0xee, 0x01, 0x0a, 0x11, 0x41, 0x73, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72, 0x65,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x63, 0x61, 0x63, 0x74,
-	0x69, 0x2f, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2d, 0x67, 0x6f, 0x2f,
-	0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x54, 0x4c,
+	0x43, 0x52, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x22, 0xfe, 0x01, 0x0a, 0x19, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12,
+	0x50, 0x0a, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x46, 0x75, 0x6e, 0x67, 0x69, 0x62, 0x6c, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x67, 0x72,
+	0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x61, 0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x35, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x48, 0x54,
+	0x4c, 0x43, 0x52, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x05, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x22, 0xe2, 0x02, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x38, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x53, 0x70, 0x65, 0x63, 0x22, 0x4d, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x28, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x6b, 0x4d, 0x65,
+	0x63, 0x68, 0x61, 0x6e, 0x69, 0x73, 0x6d, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x4c, 0x43, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x47, 0x4e, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01,
+	0x2a, 0x44, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x68, 0x4d, 0x65, 0x63, 0x68, 0x61, 0x6e, 0x69, 0x73,
+	0x6d, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x48, 0x41, 0x35, 0x31, 0x32, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x4b, 0x45, 0x43,
+	0x43, 0x41, 0x4b, 0x32, 0x35, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x48, 0x41, 0x33,
+	0x5f, 0x32, 0x35, 0x36, 0x10, 0x03, 0x2a, 0x37, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x50, 0x4f, 0x43, 0x48, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x22, 0x04, 0x08, 0x02, 0x10,
+	0x02, 0x2a, 0x0c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x48, 0x45, 0x49, 0x47, 0x48, 0x54, 0x2a,
+	0x7c, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08,
+	0x45, 0x58, 0x54, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41,
+	0x4c, 0x4c, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x45, 0x44, 0x10, 0x06, 0x42, 0x7e, 0x0a,
+	0x36, 0x6f, 0x72, 0x67, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d,
+	0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x77, 0x65, 0x61, 0x76,
+	0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -899,21 +1376,27 @@ func file_common_asset_locks_proto_rawDescGZIP() []byte {
 	return file_common_asset_locks_proto_rawDescData
 }
 
-var file_common_asset_locks_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_common_asset_locks_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_common_asset_locks_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_common_asset_locks_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_common_asset_locks_proto_goTypes = []interface{}{
 	(LockMechanism)(0),                     // 0: common.asset_locks.LockMechanism
 	(HashMechanism)(0),                     // 1: common.asset_locks.HashMechanism
 	(TimeSpec)(0),                          // 2: common.asset_locks.TimeSpec
-	(*AssetLock)(nil),                      // 3: common.asset_locks.AssetLock
-	(*AssetClaim)(nil),                     // 4: common.asset_locks.AssetClaim
-	(*AssetLockHTLC)(nil),                  // 5: common.asset_locks.AssetLockHTLC
-	(*AssetClaimHTLC)(nil),                 // 6: common.asset_locks.AssetClaimHTLC
-	(*AssetExchangeAgreement)(nil),         // 7: common.asset_locks.AssetExchangeAgreement
-	(*HybridAssetExchangeAgreement)(nil),   // 8: common.asset_locks.HybridAssetExchangeAgreement
-	(*FungibleAssetExchangeAgreement)(nil), // 9: common.asset_locks.FungibleAssetExchangeAgreement
-	(*AssetContractHTLC)(nil),              // 10: common.asset_locks.AssetContractHTLC
-	(*FungibleAssetContractHTLC)(nil),      // 11: common.asset_locks.FungibleAssetContractHTLC
+	(AssetLockEventType)(0),                // 3: common.asset_locks.AssetLockEventType
+	(*AssetLock)(nil),                      // 4: common.asset_locks.AssetLock
+	(*AssetClaim)(nil),                     // 5: common.asset_locks.AssetClaim
+	(*AssetLockHTLC)(nil),                  // 6: common.asset_locks.AssetLockHTLC
+	(*AssetClaimHTLC)(nil),                 // 7: common.asset_locks.AssetClaimHTLC
+	(*AssetLockSignature)(nil),             // 8: common.asset_locks.AssetLockSignature
+	(*ApproverSignature)(nil),              // 9: common.asset_locks.ApproverSignature
+	(*AssetClaimSignature)(nil),            // 10: common.asset_locks.AssetClaimSignature
+	(*AssetExchangeAgreement)(nil),         // 11: common.asset_locks.AssetExchangeAgreement
+	(*HybridAssetExchangeAgreement)(nil),   // 12: common.asset_locks.HybridAssetExchangeAgreement
+	(*FungibleAssetExchangeAgreement)(nil), // 13: common.asset_locks.FungibleAssetExchangeAgreement
+	(*AssetContractHTLC)(nil),              // 14: common.asset_locks.AssetContractHTLC
+	(*FungibleAssetContractHTLC)(nil),      // 15: common.asset_locks.FungibleAssetContractHTLC
+	(*AssetLockEvent)(nil),                 // 16: common.asset_locks.AssetLockEvent
+	(*AssetLockEvents)(nil),                // 17: common.asset_locks.AssetLockEvents
 }
 var file_common_asset_locks_proto_depIdxs = []int32{
 	0,  // 0: common.asset_locks.AssetLock.lockMechanism:type_name -> common.asset_locks.LockMechanism
@@ -921,17 +1404,22 @@ var file_common_asset_locks_proto_depIdxs = []int32{
 	1,  // 2: common.asset_locks.AssetLockHTLC.hashMechanism:type_name -> common.asset_locks.HashMechanism
 	2,  // 3: common.asset_locks.AssetLockHTLC.timeSpec:type_name -> common.asset_locks.TimeSpec
 	1,  // 4: common.asset_locks.AssetClaimHTLC.hashMechanism:type_name -> common.asset_locks.HashMechanism
-	7,  // 5: common.asset_locks.AssetContractHTLC.agreement:type_name -> common.asset_locks.AssetExchangeAgreement
-	5,  // 6: common.asset_locks.AssetContractHTLC.lock:type_name -> common.asset_locks.AssetLockHTLC
-	6,  // 7: common.asset_locks.AssetContractHTLC.claim:type_name -> common.asset_locks.AssetClaimHTLC
-	9,  // 8: common.asset_locks.FungibleAssetContractHTLC.agreement:type_name -> common.asset_locks.FungibleAssetExchangeAgreement
-	5,  // 9: common.asset_locks.FungibleAssetContractHTLC.lock:type_name -> common.asset_locks.AssetLockHTLC
-	6,  // 10: common.asset_locks.FungibleAssetContractHTLC.claim:type_name -> common.asset_locks.AssetClaimHTLC
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 5: common.asset_locks.AssetLockSignature.timeSpec:type_name -> common.asset_locks.TimeSpec
+	9,  // 6: common.asset_locks.AssetClaimSignature.signatures:type_name -> common.asset_locks.ApproverSignature
+	11, // 7: common.asset_locks.AssetContractHTLC.agreement:type_name -> common.asset_locks.AssetExchangeAgreement
+	6,  // 8: common.asset_locks.AssetContractHTLC.lock:type_name -> common.asset_locks.AssetLockHTLC
+	7,  // 9: common.asset_locks.AssetContractHTLC.claim:type_name -> common.asset_locks.AssetClaimHTLC
+	13, // 10: common.asset_locks.FungibleAssetContractHTLC.agreement:type_name -> common.asset_locks.FungibleAssetExchangeAgreement
+	6,  // 11: common.asset_locks.FungibleAssetContractHTLC.lock:type_name -> common.asset_locks.AssetLockHTLC
+	7,  // 12: common.asset_locks.FungibleAssetContractHTLC.claim:type_name -> common.asset_locks.AssetClaimHTLC
+	3,  // 13: common.asset_locks.AssetLockEvent.eventType:type_name -> common.asset_locks.AssetLockEventType
+	2,  // 14: common.asset_locks.AssetLockEvent.timeSpec:type_name -> common.asset_locks.TimeSpec
+	16, // 15: common.asset_locks.AssetLockEvents.events:type_name -> common.asset_locks.AssetLockEvent
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_common_asset_locks_proto_init() }
@@ -989,7 +1477,7 @@ func file_common_asset_locks_proto_init() {
 			}
 		}
 		file_common_asset_locks_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetExchangeAgreement); i {
+			switch v := v.(*AssetLockSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1001,7 +1489,7 @@ func file_common_asset_locks_proto_init() {
 			}
 		}
 		file_common_asset_locks_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HybridAssetExchangeAgreement); i {
+			switch v := v.(*ApproverSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1013,7 +1501,7 @@ func file_common_asset_locks_proto_init() {
 			}
 		}
 		file_common_asset_locks_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FungibleAssetExchangeAgreement); i {
+			switch v := v.(*AssetClaimSignature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1025,7 +1513,7 @@ func file_common_asset_locks_proto_init() {
 			}
 		}
 		file_common_asset_locks_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssetContractHTLC); i {
+			switch v := v.(*AssetExchangeAgreement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1037,6 +1525,42 @@ func file_common_asset_locks_proto_init() {
 			}
 		}
 		file_common_asset_locks_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HybridAssetExchangeAgreement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_asset_locks_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FungibleAssetExchangeAgreement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_asset_locks_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetContractHTLC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_asset_locks_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FungibleAssetContractHTLC); i {
 			case 0:
 				return &v.state
@@ -1048,14 +1572,38 @@ func file_common_asset_locks_proto_init() {
 				return nil
 			}
 		}
+		file_common_asset_locks_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLockEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_asset_locks_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetLockEvents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_asset_locks_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
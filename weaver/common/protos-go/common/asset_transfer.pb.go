@@ -24,6 +24,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AssetPledgeEventType int32
+
+const (
+	AssetPledgeEventType_PLEDGED        AssetPledgeEventType = 0
+	AssetPledgeEventType_REMOTE_CLAIMED AssetPledgeEventType = 1
+	AssetPledgeEventType_RECLAIMED      AssetPledgeEventType = 2
+)
+
+// Enum value maps for AssetPledgeEventType.
+var (
+	AssetPledgeEventType_name = map[int32]string{
+		0: "PLEDGED",
+		1: "REMOTE_CLAIMED",
+		2: "RECLAIMED",
+	}
+	AssetPledgeEventType_value = map[string]int32{
+		"PLEDGED":        0,
+		"REMOTE_CLAIMED": 1,
+		"RECLAIMED":      2,
+	}
+)
+
+func (x AssetPledgeEventType) Enum() *AssetPledgeEventType {
+	p := new(AssetPledgeEventType)
+	*p = x
+	return p
+}
+
+func (x AssetPledgeEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssetPledgeEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_asset_transfer_proto_enumTypes[0].Descriptor()
+}
+
+func (AssetPledgeEventType) Type() protoreflect.EnumType {
+	return &file_common_asset_transfer_proto_enumTypes[0]
+}
+
+func (x AssetPledgeEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssetPledgeEventType.Descriptor instead.
+func (AssetPledgeEventType) EnumDescriptor() ([]byte, []int) {
+	return file_common_asset_transfer_proto_rawDescGZIP(), []int{0}
+}
+
 type AssetPledge struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -198,6 +247,128 @@ func (x *AssetClaimStatus) GetExpirationStatus() bool {
 	return false
 }
 
+// Event emitted on a state transition of an asset pledge; 'assetType', 'assetIdOrQuantity' and 'pledger' are set
+// when known to the network emitting the event, and the asset is otherwise identified by 'assetDetails'; as in
+// 'AssetPledge', 'localNetworkID' is the pledging network and 'remoteNetworkID' the recipient's network
+type AssetPledgeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType         AssetPledgeEventType `protobuf:"varint,1,opt,name=eventType,proto3,enum=common.asset_transfer.AssetPledgeEventType" json:"eventType,omitempty"`
+	PledgeId          string               `protobuf:"bytes,2,opt,name=pledgeId,proto3" json:"pledgeId,omitempty"`
+	AssetType         string               `protobuf:"bytes,3,opt,name=assetType,proto3" json:"assetType,omitempty"`
+	AssetIdOrQuantity string               `protobuf:"bytes,4,opt,name=assetIdOrQuantity,proto3" json:"assetIdOrQuantity,omitempty"`
+	AssetDetails      []byte               `protobuf:"bytes,5,opt,name=assetDetails,proto3" json:"assetDetails,omitempty"`
+	Pledger           string               `protobuf:"bytes,6,opt,name=pledger,proto3" json:"pledger,omitempty"`
+	Recipient         string               `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	ExpiryTimeSecs    uint64               `protobuf:"varint,8,opt,name=expiryTimeSecs,proto3" json:"expiryTimeSecs,omitempty"`
+	LocalNetworkID    string               `protobuf:"bytes,9,opt,name=localNetworkID,proto3" json:"localNetworkID,omitempty"`
+	RemoteNetworkID   string               `protobuf:"bytes,10,opt,name=remoteNetworkID,proto3" json:"remoteNetworkID,omitempty"`
+}
+
+func (x *AssetPledgeEvent) Reset() {
+	*x = AssetPledgeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_asset_transfer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetPledgeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetPledgeEvent) ProtoMessage() {}
+
+func (x *AssetPledgeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_common_asset_transfer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetPledgeEvent.ProtoReflect.Descriptor instead.
+func (*AssetPledgeEvent) Descriptor() ([]byte, []int) {
+	return file_common_asset_transfer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AssetPledgeEvent) GetEventType() AssetPledgeEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return AssetPledgeEventType_PLEDGED
+}
+
+func (x *AssetPledgeEvent) GetPledgeId() string {
+	if x != nil {
+		return x.PledgeId
+	}
+	return ""
+}
+
+func (x *AssetPledgeEvent) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *AssetPledgeEvent) GetAssetIdOrQuantity() string {
+	if x != nil {
+		return x.AssetIdOrQuantity
+	}
+	return ""
+}
+
+func (x *AssetPledgeEvent) GetAssetDetails() []byte {
+	if x != nil {
+		return x.AssetDetails
+	}
+	return nil
+}
+
+func (x *AssetPledgeEvent) GetPledger() string {
+	if x != nil {
+		return x.Pledger
+	}
+	return ""
+}
+
+func (x *AssetPledgeEvent) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *AssetPledgeEvent) GetExpiryTimeSecs() uint64 {
+	if x != nil {
+		return x.ExpiryTimeSecs
+	}
+	return 0
+}
+
+func (x *AssetPledgeEvent) GetLocalNetworkID() string {
+	if x != nil {
+		return x.LocalNetworkID
+	}
+	return ""
+}
+
+func (x *AssetPledgeEvent) GetRemoteNetworkID() string {
+	if x != nil {
+		return x.RemoteNetworkID
+	}
+	return ""
+}
+
 var File_common_asset_transfer_proto protoreflect.FileDescriptor
 
 var file_common_asset_transfer_proto_rawDesc = []byte{
@@ -234,16 +405,47 @@ var file_common_asset_transfer_proto_rawDesc = []byte{
 	0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x53,
 	0x65, 0x63, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
-	0x81, 0x01, 0x0a, 0x39, 0x6f, 0x72, 0x67, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2e, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5a, 0x44, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c,
-	0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x63, 0x61, 0x63, 0x74,
-	0x69, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x9b, 0x03, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x4f, 0x72, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x4f, 0x72, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x44, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x44, 0x2a, 0x46, 0x0a,
+	0x14, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x43, 0x4c, 0x41,
+	0x49, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x43, 0x4c, 0x41, 0x49,
+	0x4d, 0x45, 0x44, 0x10, 0x02, 0x42, 0x81, 0x01, 0x0a, 0x39, 0x6f, 0x72, 0x67, 0x2e, 0x68, 0x79,
+	0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x63, 0x61, 0x63, 0x74,
+	0x69, 0x2f, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2d, 0x67, 0x6f, 0x2f,
+	0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -258,17 +460,21 @@ func file_common_asset_transfer_proto_rawDescGZIP() []byte {
 	return file_common_asset_transfer_proto_rawDescData
 }
 
-var file_common_asset_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_common_asset_transfer_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_common_asset_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_common_asset_transfer_proto_goTypes = []interface{}{
-	(*AssetPledge)(nil),      // 0: common.asset_transfer.AssetPledge
-	(*AssetClaimStatus)(nil), // 1: common.asset_transfer.AssetClaimStatus
+	(AssetPledgeEventType)(0), // 0: common.asset_transfer.AssetPledgeEventType
+	(*AssetPledge)(nil),       // 1: common.asset_transfer.AssetPledge
+	(*AssetClaimStatus)(nil),  // 2: common.asset_transfer.AssetClaimStatus
+	(*AssetPledgeEvent)(nil),  // 3: common.asset_transfer.AssetPledgeEvent
 }
 var file_common_asset_transfer_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: common.asset_transfer.AssetPledgeEvent.eventType:type_name -> common.asset_transfer.AssetPledgeEventType
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_common_asset_transfer_proto_init() }
@@ -301,19 +507,32 @@ func file_common_asset_transfer_proto_init() {
 				return nil
 			}
 		}
+		file_common_asset_transfer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetPledgeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_asset_transfer_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_common_asset_transfer_proto_goTypes,
 		DependencyIndexes: file_common_asset_transfer_proto_depIdxs,
+		EnumInfos:         file_common_asset_transfer_proto_enumTypes,
 		MessageInfos:      file_common_asset_transfer_proto_msgTypes,
 	}.Build()
 	File_common_asset_transfer_proto = out.File
@@ -24,6 +24,8 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Only HMACs are supported: an AEAD tag (e.g., AES-GCM) is not a sound replacement, as its key is revealed
+// to the verifier, and with a known key a tag can be matched by another payload
 type ConfidentialPayload_HashType int32
 
 const (
@@ -64,7 +66,7 @@ func (x ConfidentialPayload_HashType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConfidentialPayload_HashType.Descriptor instead.
 func (ConfidentialPayload_HashType) EnumDescriptor() ([]byte, []int) {
-	return file_common_interop_payload_proto_rawDescGZIP(), []int{1, 0}
+	return file_common_interop_payload_proto_rawDescGZIP(), []int{2, 0}
 }
 
 type InteropPayload struct {
@@ -77,6 +79,8 @@ type InteropPayload struct {
 	Confidential         bool   `protobuf:"varint,3,opt,name=confidential,proto3" json:"confidential,omitempty"`
 	RequestorCertificate string `protobuf:"bytes,4,opt,name=requestor_certificate,json=requestorCertificate,proto3" json:"requestor_certificate,omitempty"`
 	Nonce                string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Values substituted for the placeholders of an event query address, in address order
+	DynamicArgs []*DynamicArg `protobuf:"bytes,6,rep,name=dynamic_args,json=dynamicArgs,proto3" json:"dynamic_args,omitempty"`
 }
 
 func (x *InteropPayload) Reset() {
@@ -146,6 +150,69 @@ func (x *InteropPayload) GetNonce() string {
 	return ""
 }
 
+func (x *InteropPayload) GetDynamicArgs() []*DynamicArg {
+	if x != nil {
+		return x.DynamicArgs
+	}
+	return nil
+}
+
+type DynamicArg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of a named ('?{<name>}') placeholder; empty for a positional ('?') placeholder
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *DynamicArg) Reset() {
+	*x = DynamicArg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_common_interop_payload_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicArg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicArg) ProtoMessage() {}
+
+func (x *DynamicArg) ProtoReflect() protoreflect.Message {
+	mi := &file_common_interop_payload_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicArg.ProtoReflect.Descriptor instead.
+func (*DynamicArg) Descriptor() ([]byte, []int) {
+	return file_common_interop_payload_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DynamicArg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DynamicArg) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type ConfidentialPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -159,7 +226,7 @@ type ConfidentialPayload struct {
 func (x *ConfidentialPayload) Reset() {
 	*x = ConfidentialPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_interop_payload_proto_msgTypes[1]
+		mi := &file_common_interop_payload_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -172,7 +239,7 @@ func (x *ConfidentialPayload) String() string {
 func (*ConfidentialPayload) ProtoMessage() {}
 
 func (x *ConfidentialPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_common_interop_payload_proto_msgTypes[1]
+	mi := &file_common_interop_payload_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -185,7 +252,7 @@ func (x *ConfidentialPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfidentialPayload.ProtoReflect.Descriptor instead.
 func (*ConfidentialPayload) Descriptor() ([]byte, []int) {
-	return file_common_interop_payload_proto_rawDescGZIP(), []int{1}
+	return file_common_interop_payload_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ConfidentialPayload) GetEncryptedPayload() []byte {
@@ -221,7 +288,7 @@ type ConfidentialPayloadContents struct {
 func (x *ConfidentialPayloadContents) Reset() {
 	*x = ConfidentialPayloadContents{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_common_interop_payload_proto_msgTypes[2]
+		mi := &file_common_interop_payload_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -234,7 +301,7 @@ func (x *ConfidentialPayloadContents) String() string {
 func (*ConfidentialPayloadContents) ProtoMessage() {}
 
 func (x *ConfidentialPayloadContents) ProtoReflect() protoreflect.Message {
-	mi := &file_common_interop_payload_proto_msgTypes[2]
+	mi := &file_common_interop_payload_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -247,7 +314,7 @@ func (x *ConfidentialPayloadContents) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfidentialPayloadContents.ProtoReflect.Descriptor instead.
 func (*ConfidentialPayloadContents) Descriptor() ([]byte, []int) {
-	return file_common_interop_payload_proto_rawDescGZIP(), []int{2}
+	return file_common_interop_payload_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ConfidentialPayloadContents) GetPayload() []byte {
@@ -270,7 +337,7 @@ var file_common_interop_payload_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6f, 0x70,
 	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16,
 	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6f, 0x70, 0x5f, 0x70,
-	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
 	0x6f, 0x70, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
 	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
 	0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
@@ -281,33 +348,41 @@ var file_common_interop_payload_proto_rawDesc = []byte{
 	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x43, 0x65, 0x72, 0x74, 0x69,
 	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0xbf, 0x01, 0x0a,
-	0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
-	0x64, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x51, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x14, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4d, 0x41, 0x43, 0x10, 0x00, 0x22, 0x4f,
-	0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42,
-	0x82, 0x01, 0x0a, 0x3a, 0x6f, 0x72, 0x67, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2e, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5a, 0x44,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x63, 0x61, 0x63,
-	0x74, 0x69, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0c,
+	0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x41, 0x72, 0x67, 0x52, 0x0b, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41,
+	0x72, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x0a, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x41, 0x72,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x13,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x51, 0x0a, 0x09, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x14, 0x0a, 0x08, 0x48, 0x61, 0x73, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4d, 0x41, 0x43, 0x10, 0x00, 0x22, 0x4f, 0x0a,
+	0x1b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x82,
+	0x01, 0x0a, 0x3a, 0x6f, 0x72, 0x67, 0x2e, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5a, 0x44, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2d, 0x63, 0x61, 0x63, 0x74, 0x69, 0x2f, 0x63, 0x61, 0x63, 0x74,
+	0x69, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -323,20 +398,22 @@ func file_common_interop_payload_proto_rawDescGZIP() []byte {
 }
 
 var file_common_interop_payload_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_common_interop_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_common_interop_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_common_interop_payload_proto_goTypes = []interface{}{
 	(ConfidentialPayload_HashType)(0),   // 0: common.interop_payload.ConfidentialPayload.HashType
 	(*InteropPayload)(nil),              // 1: common.interop_payload.InteropPayload
-	(*ConfidentialPayload)(nil),         // 2: common.interop_payload.ConfidentialPayload
-	(*ConfidentialPayloadContents)(nil), // 3: common.interop_payload.ConfidentialPayloadContents
+	(*DynamicArg)(nil),                  // 2: common.interop_payload.DynamicArg
+	(*ConfidentialPayload)(nil),         // 3: common.interop_payload.ConfidentialPayload
+	(*ConfidentialPayloadContents)(nil), // 4: common.interop_payload.ConfidentialPayloadContents
 }
 var file_common_interop_payload_proto_depIdxs = []int32{
-	0, // 0: common.interop_payload.ConfidentialPayload.hash_type:type_name -> common.interop_payload.ConfidentialPayload.HashType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: common.interop_payload.InteropPayload.dynamic_args:type_name -> common.interop_payload.DynamicArg
+	0, // 1: common.interop_payload.ConfidentialPayload.hash_type:type_name -> common.interop_payload.ConfidentialPayload.HashType
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_common_interop_payload_proto_init() }
@@ -358,7 +435,7 @@ func file_common_interop_payload_proto_init() {
 			}
 		}
 		file_common_interop_payload_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfidentialPayload); i {
+			switch v := v.(*DynamicArg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -370,6 +447,18 @@ func file_common_interop_payload_proto_init() {
 			}
 		}
 		file_common_interop_payload_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfidentialPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_common_interop_payload_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConfidentialPayloadContents); i {
 			case 0:
 				return &v.state
@@ -388,7 +477,7 @@ func file_common_interop_payload_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_common_interop_payload_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
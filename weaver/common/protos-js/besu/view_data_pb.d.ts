@@ -0,0 +1,130 @@
+// package: besu
+// file: besu/view_data.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+
+export class BlockHeader extends jspb.Message { 
+    getParenthash(): string;
+    setParenthash(value: string): BlockHeader;
+    getSha3uncles(): string;
+    setSha3uncles(value: string): BlockHeader;
+    getMiner(): string;
+    setMiner(value: string): BlockHeader;
+    getStateroot(): string;
+    setStateroot(value: string): BlockHeader;
+    getTransactionsroot(): string;
+    setTransactionsroot(value: string): BlockHeader;
+    getReceiptsroot(): string;
+    setReceiptsroot(value: string): BlockHeader;
+    getLogsbloom(): string;
+    setLogsbloom(value: string): BlockHeader;
+    getDifficulty(): string;
+    setDifficulty(value: string): BlockHeader;
+    getNumber(): string;
+    setNumber(value: string): BlockHeader;
+    getGaslimit(): string;
+    setGaslimit(value: string): BlockHeader;
+    getGasused(): string;
+    setGasused(value: string): BlockHeader;
+    getTimestamp(): string;
+    setTimestamp(value: string): BlockHeader;
+    getExtradata(): string;
+    setExtradata(value: string): BlockHeader;
+    getMixhash(): string;
+    setMixhash(value: string): BlockHeader;
+    getNonce(): string;
+    setNonce(value: string): BlockHeader;
+    getBasefeepergas(): string;
+    setBasefeepergas(value: string): BlockHeader;
+    getWithdrawalsroot(): string;
+    setWithdrawalsroot(value: string): BlockHeader;
+    getBlobgasused(): string;
+    setBlobgasused(value: string): BlockHeader;
+    getExcessblobgas(): string;
+    setExcessblobgas(value: string): BlockHeader;
+    getParentbeaconblockroot(): string;
+    setParentbeaconblockroot(value: string): BlockHeader;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BlockHeader.AsObject;
+    static toObject(includeInstance: boolean, msg: BlockHeader): BlockHeader.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BlockHeader, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BlockHeader;
+    static deserializeBinaryFromReader(message: BlockHeader, reader: jspb.BinaryReader): BlockHeader;
+}
+
+export namespace BlockHeader {
+    export type AsObject = {
+        parenthash: string,
+        sha3uncles: string,
+        miner: string,
+        stateroot: string,
+        transactionsroot: string,
+        receiptsroot: string,
+        logsbloom: string,
+        difficulty: string,
+        number: string,
+        gaslimit: string,
+        gasused: string,
+        timestamp: string,
+        extradata: string,
+        mixhash: string,
+        nonce: string,
+        basefeepergas: string,
+        withdrawalsroot: string,
+        blobgasused: string,
+        excessblobgas: string,
+        parentbeaconblockroot: string,
+    }
+}
+
+export class BesuView extends jspb.Message { 
+    getInteropPayload(): Uint8Array | string;
+    getInteropPayload_asU8(): Uint8Array;
+    getInteropPayload_asB64(): string;
+    setInteropPayload(value: Uint8Array | string): BesuView;
+
+    hasBlockHeader(): boolean;
+    clearBlockHeader(): void;
+    getBlockHeader(): BlockHeader | undefined;
+    setBlockHeader(value?: BlockHeader): BesuView;
+    getMerkleProof(): Uint8Array | string;
+    getMerkleProof_asU8(): Uint8Array;
+    getMerkleProof_asB64(): string;
+    setMerkleProof(value: Uint8Array | string): BesuView;
+    getReceiptIndex(): number;
+    setReceiptIndex(value: number): BesuView;
+    getLogIndex(): number;
+    setLogIndex(value: number): BesuView;
+    clearValidatorSignaturesList(): void;
+    getValidatorSignaturesList(): Array<Uint8Array | string>;
+    getValidatorSignaturesList_asU8(): Array<Uint8Array>;
+    getValidatorSignaturesList_asB64(): Array<string>;
+    setValidatorSignaturesList(value: Array<Uint8Array | string>): BesuView;
+    addValidatorSignatures(value: Uint8Array | string, index?: number): Uint8Array | string;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): BesuView.AsObject;
+    static toObject(includeInstance: boolean, msg: BesuView): BesuView.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: BesuView, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): BesuView;
+    static deserializeBinaryFromReader(message: BesuView, reader: jspb.BinaryReader): BesuView;
+}
+
+export namespace BesuView {
+    export type AsObject = {
+        interopPayload: Uint8Array | string,
+        blockHeader?: BlockHeader.AsObject,
+        merkleProof: Uint8Array | string,
+        receiptIndex: number,
+        logIndex: number,
+        validatorSignaturesList: Array<Uint8Array | string>,
+    }
+}
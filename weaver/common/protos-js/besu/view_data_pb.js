@@ -0,0 +1,1167 @@
+// source: besu/view_data.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global = (function() {
+  if (this) { return this; }
+  if (typeof window !== 'undefined') { return window; }
+  if (typeof global !== 'undefined') { return global; }
+  if (typeof self !== 'undefined') { return self; }
+  return Function('return this')();
+}.call(null));
+
+goog.exportSymbol('proto.besu.BesuView', null, global);
+goog.exportSymbol('proto.besu.BlockHeader', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.besu.BlockHeader = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.besu.BlockHeader, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.besu.BlockHeader.displayName = 'proto.besu.BlockHeader';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.besu.BesuView = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.besu.BesuView.repeatedFields_, null);
+};
+goog.inherits(proto.besu.BesuView, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.besu.BesuView.displayName = 'proto.besu.BesuView';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.besu.BlockHeader.prototype.toObject = function(opt_includeInstance) {
+  return proto.besu.BlockHeader.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.besu.BlockHeader} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.besu.BlockHeader.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    parenthash: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sha3uncles: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    miner: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    stateroot: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    transactionsroot: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    receiptsroot: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    logsbloom: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    difficulty: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    number: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    gaslimit: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    gasused: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    timestamp: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    extradata: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    mixhash: jspb.Message.getFieldWithDefault(msg, 14, ""),
+    nonce: jspb.Message.getFieldWithDefault(msg, 15, ""),
+    basefeepergas: jspb.Message.getFieldWithDefault(msg, 16, ""),
+    withdrawalsroot: jspb.Message.getFieldWithDefault(msg, 17, ""),
+    blobgasused: jspb.Message.getFieldWithDefault(msg, 18, ""),
+    excessblobgas: jspb.Message.getFieldWithDefault(msg, 19, ""),
+    parentbeaconblockroot: jspb.Message.getFieldWithDefault(msg, 20, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.besu.BlockHeader}
+ */
+proto.besu.BlockHeader.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.besu.BlockHeader;
+  return proto.besu.BlockHeader.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.besu.BlockHeader} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.besu.BlockHeader}
+ */
+proto.besu.BlockHeader.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setParenthash(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSha3uncles(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMiner(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStateroot(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTransactionsroot(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReceiptsroot(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogsbloom(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDifficulty(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNumber(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGaslimit(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setGasused(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTimestamp(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExtradata(value);
+      break;
+    case 14:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMixhash(value);
+      break;
+    case 15:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNonce(value);
+      break;
+    case 16:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBasefeepergas(value);
+      break;
+    case 17:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWithdrawalsroot(value);
+      break;
+    case 18:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBlobgasused(value);
+      break;
+    case 19:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExcessblobgas(value);
+      break;
+    case 20:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setParentbeaconblockroot(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.besu.BlockHeader.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.besu.BlockHeader.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.besu.BlockHeader} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.besu.BlockHeader.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getParenthash();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSha3uncles();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMiner();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getStateroot();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getTransactionsroot();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getReceiptsroot();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getLogsbloom();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getDifficulty();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getGaslimit();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getGasused();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getTimestamp();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getExtradata();
+  if (f.length > 0) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = message.getMixhash();
+  if (f.length > 0) {
+    writer.writeString(
+      14,
+      f
+    );
+  }
+  f = message.getNonce();
+  if (f.length > 0) {
+    writer.writeString(
+      15,
+      f
+    );
+  }
+  f = message.getBasefeepergas();
+  if (f.length > 0) {
+    writer.writeString(
+      16,
+      f
+    );
+  }
+  f = message.getWithdrawalsroot();
+  if (f.length > 0) {
+    writer.writeString(
+      17,
+      f
+    );
+  }
+  f = message.getBlobgasused();
+  if (f.length > 0) {
+    writer.writeString(
+      18,
+      f
+    );
+  }
+  f = message.getExcessblobgas();
+  if (f.length > 0) {
+    writer.writeString(
+      19,
+      f
+    );
+  }
+  f = message.getParentbeaconblockroot();
+  if (f.length > 0) {
+    writer.writeString(
+      20,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string parentHash = 1;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getParenthash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setParenthash = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string sha3Uncles = 2;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getSha3uncles = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setSha3uncles = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string miner = 3;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getMiner = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setMiner = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string stateRoot = 4;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getStateroot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setStateroot = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string transactionsRoot = 5;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getTransactionsroot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setTransactionsroot = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string receiptsRoot = 6;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getReceiptsroot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setReceiptsroot = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string logsBloom = 7;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getLogsbloom = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setLogsbloom = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string difficulty = 8;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getDifficulty = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setDifficulty = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional string number = 9;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string gasLimit = 10;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getGaslimit = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setGaslimit = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * optional string gasUsed = 11;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getGasused = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setGasused = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string timestamp = 12;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getTimestamp = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setTimestamp = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional string extraData = 13;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getExtradata = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setExtradata = function(value) {
+  return jspb.Message.setProto3StringField(this, 13, value);
+};
+
+
+/**
+ * optional string mixHash = 14;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getMixhash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 14, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setMixhash = function(value) {
+  return jspb.Message.setProto3StringField(this, 14, value);
+};
+
+
+/**
+ * optional string nonce = 15;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getNonce = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 15, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setNonce = function(value) {
+  return jspb.Message.setProto3StringField(this, 15, value);
+};
+
+
+/**
+ * optional string baseFeePerGas = 16;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getBasefeepergas = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 16, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setBasefeepergas = function(value) {
+  return jspb.Message.setProto3StringField(this, 16, value);
+};
+
+
+/**
+ * optional string withdrawalsRoot = 17;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getWithdrawalsroot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 17, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setWithdrawalsroot = function(value) {
+  return jspb.Message.setProto3StringField(this, 17, value);
+};
+
+
+/**
+ * optional string blobGasUsed = 18;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getBlobgasused = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 18, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setBlobgasused = function(value) {
+  return jspb.Message.setProto3StringField(this, 18, value);
+};
+
+
+/**
+ * optional string excessBlobGas = 19;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getExcessblobgas = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 19, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setExcessblobgas = function(value) {
+  return jspb.Message.setProto3StringField(this, 19, value);
+};
+
+
+/**
+ * optional string parentBeaconBlockRoot = 20;
+ * @return {string}
+ */
+proto.besu.BlockHeader.prototype.getParentbeaconblockroot = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 20, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.besu.BlockHeader} returns this
+ */
+proto.besu.BlockHeader.prototype.setParentbeaconblockroot = function(value) {
+  return jspb.Message.setProto3StringField(this, 20, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.besu.BesuView.repeatedFields_ = [6];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.besu.BesuView.prototype.toObject = function(opt_includeInstance) {
+  return proto.besu.BesuView.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.besu.BesuView} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.besu.BesuView.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    interopPayload: msg.getInteropPayload_asB64(),
+    blockHeader: (f = msg.getBlockHeader()) && proto.besu.BlockHeader.toObject(includeInstance, f),
+    merkleProof: msg.getMerkleProof_asB64(),
+    receiptIndex: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    logIndex: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    validatorSignaturesList: msg.getValidatorSignaturesList_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.besu.BesuView}
+ */
+proto.besu.BesuView.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.besu.BesuView;
+  return proto.besu.BesuView.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.besu.BesuView} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.besu.BesuView}
+ */
+proto.besu.BesuView.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setInteropPayload(value);
+      break;
+    case 2:
+      var value = new proto.besu.BlockHeader;
+      reader.readMessage(value,proto.besu.BlockHeader.deserializeBinaryFromReader);
+      msg.setBlockHeader(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setMerkleProof(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setReceiptIndex(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setLogIndex(value);
+      break;
+    case 6:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.addValidatorSignatures(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.besu.BesuView.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.besu.BesuView.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.besu.BesuView} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.besu.BesuView.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInteropPayload_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+  f = message.getBlockHeader();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.besu.BlockHeader.serializeBinaryToWriter
+    );
+  }
+  f = message.getMerkleProof_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = message.getReceiptIndex();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getLogIndex();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getValidatorSignaturesList_asU8();
+  if (f.length > 0) {
+    writer.writeRepeatedBytes(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bytes interop_payload = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.besu.BesuView.prototype.getInteropPayload = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes interop_payload = 1;
+ * This is a type-conversion wrapper around `getInteropPayload()`
+ * @return {string}
+ */
+proto.besu.BesuView.prototype.getInteropPayload_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getInteropPayload()));
+};
+
+
+/**
+ * optional bytes interop_payload = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getInteropPayload()`
+ * @return {!Uint8Array}
+ */
+proto.besu.BesuView.prototype.getInteropPayload_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getInteropPayload()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.setInteropPayload = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * optional BlockHeader block_header = 2;
+ * @return {?proto.besu.BlockHeader}
+ */
+proto.besu.BesuView.prototype.getBlockHeader = function() {
+  return /** @type{?proto.besu.BlockHeader} */ (
+    jspb.Message.getWrapperField(this, proto.besu.BlockHeader, 2));
+};
+
+
+/**
+ * @param {?proto.besu.BlockHeader|undefined} value
+ * @return {!proto.besu.BesuView} returns this
+*/
+proto.besu.BesuView.prototype.setBlockHeader = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.clearBlockHeader = function() {
+  return this.setBlockHeader(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.besu.BesuView.prototype.hasBlockHeader = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bytes merkle_proof = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.besu.BesuView.prototype.getMerkleProof = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes merkle_proof = 3;
+ * This is a type-conversion wrapper around `getMerkleProof()`
+ * @return {string}
+ */
+proto.besu.BesuView.prototype.getMerkleProof_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getMerkleProof()));
+};
+
+
+/**
+ * optional bytes merkle_proof = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getMerkleProof()`
+ * @return {!Uint8Array}
+ */
+proto.besu.BesuView.prototype.getMerkleProof_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getMerkleProof()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.setMerkleProof = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 receipt_index = 4;
+ * @return {number}
+ */
+proto.besu.BesuView.prototype.getReceiptIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.setReceiptIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 log_index = 5;
+ * @return {number}
+ */
+proto.besu.BesuView.prototype.getLogIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.setLogIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * repeated bytes validator_signatures = 6;
+ * @return {!(Array<!Uint8Array>|Array<string>)}
+ */
+proto.besu.BesuView.prototype.getValidatorSignaturesList = function() {
+  return /** @type {!(Array<!Uint8Array>|Array<string>)} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * repeated bytes validator_signatures = 6;
+ * This is a type-conversion wrapper around `getValidatorSignaturesList()`
+ * @return {!Array<string>}
+ */
+proto.besu.BesuView.prototype.getValidatorSignaturesList_asB64 = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.bytesListAsB64(
+      this.getValidatorSignaturesList()));
+};
+
+
+/**
+ * repeated bytes validator_signatures = 6;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getValidatorSignaturesList()`
+ * @return {!Array<!Uint8Array>}
+ */
+proto.besu.BesuView.prototype.getValidatorSignaturesList_asU8 = function() {
+  return /** @type {!Array<!Uint8Array>} */ (jspb.Message.bytesListAsU8(
+      this.getValidatorSignaturesList()));
+};
+
+
+/**
+ * @param {!(Array<!Uint8Array>|Array<string>)} value
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.setValidatorSignaturesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @param {number=} opt_index
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.addValidatorSignatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.besu.BesuView} returns this
+ */
+proto.besu.BesuView.prototype.clearValidatorSignaturesList = function() {
+  return this.setValidatorSignaturesList([]);
+};
+
+
+goog.object.extend(exports, proto.besu);
@@ -40,6 +40,8 @@ export class Rule extends jspb.Message {
     setResource(value: string): Rule;
     getRead(): boolean;
     setRead(value: boolean): Rule;
+    getDeny(): boolean;
+    setDeny(value: boolean): Rule;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Rule.AsObject;
@@ -57,5 +59,6 @@ export namespace Rule {
         principaltype: string,
         resource: string,
         read: boolean,
+        deny: boolean,
     }
 }
@@ -290,7 +290,8 @@ proto.common.access_control.Rule.toObject = function(includeInstance, msg) {
     principal: jspb.Message.getFieldWithDefault(msg, 1, ""),
     principaltype: jspb.Message.getFieldWithDefault(msg, 2, ""),
     resource: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    read: jspb.Message.getBooleanFieldWithDefault(msg, 4, false)
+    read: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    deny: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -343,6 +344,10 @@ proto.common.access_control.Rule.deserializeBinaryFromReader = function(msg, rea
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setRead(value);
       break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDeny(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -400,6 +405,13 @@ proto.common.access_control.Rule.serializeBinaryToWriter = function(message, wri
       f
     );
   }
+  f = message.getDeny();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
@@ -475,4 +487,22 @@ proto.common.access_control.Rule.prototype.setRead = function(value) {
 };
 
 
+/**
+ * optional bool deny = 5;
+ * @return {boolean}
+ */
+proto.common.access_control.Rule.prototype.getDeny = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.common.access_control.Rule} returns this
+ */
+proto.common.access_control.Rule.prototype.setDeny = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
 goog.object.extend(exports, proto.common.access_control);
@@ -112,6 +112,84 @@ export namespace AssetClaimHTLC {
     }
 }
 
+export class AssetLockSignature extends jspb.Message { 
+    clearApproversList(): void;
+    getApproversList(): Array<string>;
+    setApproversList(value: Array<string>): AssetLockSignature;
+    addApprovers(value: string, index?: number): string;
+    getThreshold(): number;
+    setThreshold(value: number): AssetLockSignature;
+    getExpirytimesecs(): number;
+    setExpirytimesecs(value: number): AssetLockSignature;
+    getTimespec(): TimeSpec;
+    setTimespec(value: TimeSpec): AssetLockSignature;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AssetLockSignature.AsObject;
+    static toObject(includeInstance: boolean, msg: AssetLockSignature): AssetLockSignature.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AssetLockSignature, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AssetLockSignature;
+    static deserializeBinaryFromReader(message: AssetLockSignature, reader: jspb.BinaryReader): AssetLockSignature;
+}
+
+export namespace AssetLockSignature {
+    export type AsObject = {
+        approversList: Array<string>,
+        threshold: number,
+        expirytimesecs: number,
+        timespec: TimeSpec,
+    }
+}
+
+export class ApproverSignature extends jspb.Message { 
+    getApprover(): string;
+    setApprover(value: string): ApproverSignature;
+    getSignature(): Uint8Array | string;
+    getSignature_asU8(): Uint8Array;
+    getSignature_asB64(): string;
+    setSignature(value: Uint8Array | string): ApproverSignature;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ApproverSignature.AsObject;
+    static toObject(includeInstance: boolean, msg: ApproverSignature): ApproverSignature.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ApproverSignature, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ApproverSignature;
+    static deserializeBinaryFromReader(message: ApproverSignature, reader: jspb.BinaryReader): ApproverSignature;
+}
+
+export namespace ApproverSignature {
+    export type AsObject = {
+        approver: string,
+        signature: Uint8Array | string,
+    }
+}
+
+export class AssetClaimSignature extends jspb.Message { 
+    clearSignaturesList(): void;
+    getSignaturesList(): Array<ApproverSignature>;
+    setSignaturesList(value: Array<ApproverSignature>): AssetClaimSignature;
+    addSignatures(value?: ApproverSignature, index?: number): ApproverSignature;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AssetClaimSignature.AsObject;
+    static toObject(includeInstance: boolean, msg: AssetClaimSignature): AssetClaimSignature.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AssetClaimSignature, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AssetClaimSignature;
+    static deserializeBinaryFromReader(message: AssetClaimSignature, reader: jspb.BinaryReader): AssetClaimSignature;
+}
+
+export namespace AssetClaimSignature {
+    export type AsObject = {
+        signaturesList: Array<ApproverSignature.AsObject>,
+    }
+}
+
 export class AssetExchangeAgreement extends jspb.Message { 
     getAssettype(): string;
     setAssettype(value: string): AssetExchangeAgreement;
@@ -283,16 +361,95 @@ export namespace FungibleAssetContractHTLC {
     }
 }
 
+export class AssetLockEvent extends jspb.Message { 
+    getEventtype(): AssetLockEventType;
+    setEventtype(value: AssetLockEventType): AssetLockEvent;
+    getContractid(): string;
+    setContractid(value: string): AssetLockEvent;
+    getAssettype(): string;
+    setAssettype(value: string): AssetLockEvent;
+    getAssetid(): string;
+    setAssetid(value: string): AssetLockEvent;
+    getNumunits(): number;
+    setNumunits(value: number): AssetLockEvent;
+    getLocker(): string;
+    setLocker(value: string): AssetLockEvent;
+    getRecipient(): string;
+    setRecipient(value: string): AssetLockEvent;
+    getExpirytimesecs(): number;
+    setExpirytimesecs(value: number): AssetLockEvent;
+    getTimespec(): TimeSpec;
+    setTimespec(value: TimeSpec): AssetLockEvent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AssetLockEvent.AsObject;
+    static toObject(includeInstance: boolean, msg: AssetLockEvent): AssetLockEvent.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AssetLockEvent, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AssetLockEvent;
+    static deserializeBinaryFromReader(message: AssetLockEvent, reader: jspb.BinaryReader): AssetLockEvent;
+}
+
+export namespace AssetLockEvent {
+    export type AsObject = {
+        eventtype: AssetLockEventType,
+        contractid: string,
+        assettype: string,
+        assetid: string,
+        numunits: number,
+        locker: string,
+        recipient: string,
+        expirytimesecs: number,
+        timespec: TimeSpec,
+    }
+}
+
+export class AssetLockEvents extends jspb.Message { 
+    clearEventsList(): void;
+    getEventsList(): Array<AssetLockEvent>;
+    setEventsList(value: Array<AssetLockEvent>): AssetLockEvents;
+    addEvents(value?: AssetLockEvent, index?: number): AssetLockEvent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AssetLockEvents.AsObject;
+    static toObject(includeInstance: boolean, msg: AssetLockEvents): AssetLockEvents.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AssetLockEvents, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AssetLockEvents;
+    static deserializeBinaryFromReader(message: AssetLockEvents, reader: jspb.BinaryReader): AssetLockEvents;
+}
+
+export namespace AssetLockEvents {
+    export type AsObject = {
+        eventsList: Array<AssetLockEvent.AsObject>,
+    }
+}
+
 export enum LockMechanism {
     HTLC = 0,
+    SIGNATURE = 1,
 }
 
 export enum HashMechanism {
     SHA256 = 0,
     SHA512 = 1,
+    KECCAK256 = 2,
+    SHA3_256 = 3,
 }
 
 export enum TimeSpec {
     EPOCH = 0,
     DURATION = 1,
 }
+
+export enum AssetLockEventType {
+    LOCKED = 0,
+    CLAIMED = 1,
+    UNLOCKED = 2,
+    EXTENDED = 3,
+    CANCELLED = 4,
+    EXPIRED = 5,
+    PARTIALLY_CLAIMED = 6,
+}
@@ -21,12 +21,18 @@ var global = (function() {
   return Function('return this')();
 }.call(null));
 
+goog.exportSymbol('proto.common.asset_locks.ApproverSignature', null, global);
 goog.exportSymbol('proto.common.asset_locks.AssetClaim', null, global);
 goog.exportSymbol('proto.common.asset_locks.AssetClaimHTLC', null, global);
+goog.exportSymbol('proto.common.asset_locks.AssetClaimSignature', null, global);
 goog.exportSymbol('proto.common.asset_locks.AssetContractHTLC', null, global);
 goog.exportSymbol('proto.common.asset_locks.AssetExchangeAgreement', null, global);
 goog.exportSymbol('proto.common.asset_locks.AssetLock', null, global);
+goog.exportSymbol('proto.common.asset_locks.AssetLockEvent', null, global);
+goog.exportSymbol('proto.common.asset_locks.AssetLockEventType', null, global);
+goog.exportSymbol('proto.common.asset_locks.AssetLockEvents', null, global);
 goog.exportSymbol('proto.common.asset_locks.AssetLockHTLC', null, global);
+goog.exportSymbol('proto.common.asset_locks.AssetLockSignature', null, global);
 goog.exportSymbol('proto.common.asset_locks.FungibleAssetContractHTLC', null, global);
 goog.exportSymbol('proto.common.asset_locks.FungibleAssetExchangeAgreement', null, global);
 goog.exportSymbol('proto.common.asset_locks.HashMechanism', null, global);
@@ -117,6 +123,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.common.asset_locks.AssetClaimHTLC.displayName = 'proto.common.asset_locks.AssetClaimHTLC';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.asset_locks.AssetLockSignature = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.common.asset_locks.AssetLockSignature.repeatedFields_, null);
+};
+goog.inherits(proto.common.asset_locks.AssetLockSignature, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.asset_locks.AssetLockSignature.displayName = 'proto.common.asset_locks.AssetLockSignature';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.asset_locks.ApproverSignature = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.common.asset_locks.ApproverSignature, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.asset_locks.ApproverSignature.displayName = 'proto.common.asset_locks.ApproverSignature';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.asset_locks.AssetClaimSignature = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.common.asset_locks.AssetClaimSignature.repeatedFields_, null);
+};
+goog.inherits(proto.common.asset_locks.AssetClaimSignature, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.asset_locks.AssetClaimSignature.displayName = 'proto.common.asset_locks.AssetClaimSignature';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -222,6 +291,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.common.asset_locks.FungibleAssetContractHTLC.displayName = 'proto.common.asset_locks.FungibleAssetContractHTLC';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.asset_locks.AssetLockEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.common.asset_locks.AssetLockEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.asset_locks.AssetLockEvent.displayName = 'proto.common.asset_locks.AssetLockEvent';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.asset_locks.AssetLockEvents = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.common.asset_locks.AssetLockEvents.repeatedFields_, null);
+};
+goog.inherits(proto.common.asset_locks.AssetLockEvents, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.asset_locks.AssetLockEvents.displayName = 'proto.common.asset_locks.AssetLockEvents';
+}
 
 
 
@@ -1019,6 +1130,13 @@ proto.common.asset_locks.AssetClaimHTLC.prototype.setHashpreimagebase64 = functi
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.common.asset_locks.AssetLockSignature.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1034,8 +1152,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.toObject = function(opt_includeInstance) {
-  return proto.common.asset_locks.AssetExchangeAgreement.toObject(opt_includeInstance, this);
+proto.common.asset_locks.AssetLockSignature.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.AssetLockSignature.toObject(opt_includeInstance, this);
 };
 
 
@@ -1044,16 +1162,16 @@ proto.common.asset_locks.AssetExchangeAgreement.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.common.asset_locks.AssetExchangeAgreement} msg The msg instance to transform.
+ * @param {!proto.common.asset_locks.AssetLockSignature} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.AssetExchangeAgreement.toObject = function(includeInstance, msg) {
+proto.common.asset_locks.AssetLockSignature.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assettype: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    locker: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    recipient: jspb.Message.getFieldWithDefault(msg, 4, "")
+    approversList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    threshold: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    expirytimesecs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    timespec: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -1067,23 +1185,23 @@ proto.common.asset_locks.AssetExchangeAgreement.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.common.asset_locks.AssetExchangeAgreement}
+ * @return {!proto.common.asset_locks.AssetLockSignature}
  */
-proto.common.asset_locks.AssetExchangeAgreement.deserializeBinary = function(bytes) {
+proto.common.asset_locks.AssetLockSignature.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.common.asset_locks.AssetExchangeAgreement;
-  return proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.common.asset_locks.AssetLockSignature;
+  return proto.common.asset_locks.AssetLockSignature.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.common.asset_locks.AssetExchangeAgreement} msg The message object to deserialize into.
+ * @param {!proto.common.asset_locks.AssetLockSignature} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.common.asset_locks.AssetExchangeAgreement}
+ * @return {!proto.common.asset_locks.AssetLockSignature}
  */
-proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader = function(msg, reader) {
+proto.common.asset_locks.AssetLockSignature.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1092,19 +1210,19 @@ proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader = fu
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAssettype(value);
+      msg.addApprovers(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setThreshold(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLocker(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setExpirytimesecs(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRecipient(value);
+      var value = /** @type {!proto.common.asset_locks.TimeSpec} */ (reader.readEnum());
+      msg.setTimespec(value);
       break;
     default:
       reader.skipField();
@@ -1119,9 +1237,9 @@ proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.serializeBinary = function() {
+proto.common.asset_locks.AssetLockSignature.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter(this, writer);
+  proto.common.asset_locks.AssetLockSignature.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1129,36 +1247,36 @@ proto.common.asset_locks.AssetExchangeAgreement.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.common.asset_locks.AssetExchangeAgreement} message
+ * @param {!proto.common.asset_locks.AssetLockSignature} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter = function(message, writer) {
+proto.common.asset_locks.AssetLockSignature.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssettype();
+  f = message.getApproversList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       1,
       f
     );
   }
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getThreshold();
+  if (f !== 0) {
+    writer.writeUint32(
       2,
       f
     );
   }
-  f = message.getLocker();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getExpirytimesecs();
+  if (f !== 0) {
+    writer.writeUint64(
       3,
       f
     );
   }
-  f = message.getRecipient();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTimespec();
+  if (f !== 0.0) {
+    writer.writeEnum(
       4,
       f
     );
@@ -1167,74 +1285,93 @@ proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter = functi
 
 
 /**
- * optional string assetType = 1;
- * @return {string}
+ * repeated string approvers = 1;
+ * @return {!Array<string>}
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.getAssettype = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.common.asset_locks.AssetLockSignature.prototype.getApproversList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.common.asset_locks.AssetLockSignature} returns this
+ */
+proto.common.asset_locks.AssetLockSignature.prototype.setApproversList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
+ * @param {number=} opt_index
+ * @return {!proto.common.asset_locks.AssetLockSignature} returns this
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.setAssettype = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.common.asset_locks.AssetLockSignature.prototype.addApprovers = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
- * optional string id = 2;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.asset_locks.AssetLockSignature} returns this
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.common.asset_locks.AssetLockSignature.prototype.clearApproversList = function() {
+  return this.setApproversList([]);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
+ * optional uint32 threshold = 2;
+ * @return {number}
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.common.asset_locks.AssetLockSignature.prototype.getThreshold = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * optional string locker = 3;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.common.asset_locks.AssetLockSignature} returns this
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.getLocker = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.common.asset_locks.AssetLockSignature.prototype.setThreshold = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
+ * optional uint64 expiryTimeSecs = 3;
+ * @return {number}
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.setLocker = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.common.asset_locks.AssetLockSignature.prototype.getExpirytimesecs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * optional string recipient = 4;
- * @return {string}
+ * @param {number} value
+ * @return {!proto.common.asset_locks.AssetLockSignature} returns this
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.getRecipient = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.common.asset_locks.AssetLockSignature.prototype.setExpirytimesecs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
+ * optional TimeSpec timeSpec = 4;
+ * @return {!proto.common.asset_locks.TimeSpec}
  */
-proto.common.asset_locks.AssetExchangeAgreement.prototype.setRecipient = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.common.asset_locks.AssetLockSignature.prototype.getTimespec = function() {
+  return /** @type {!proto.common.asset_locks.TimeSpec} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.common.asset_locks.TimeSpec} value
+ * @return {!proto.common.asset_locks.AssetLockSignature} returns this
+ */
+proto.common.asset_locks.AssetLockSignature.prototype.setTimespec = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
 };
 
 
@@ -1254,8 +1391,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.toObject = function(opt_includeInstance) {
-  return proto.common.asset_locks.HybridAssetExchangeAgreement.toObject(opt_includeInstance, this);
+proto.common.asset_locks.ApproverSignature.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.ApproverSignature.toObject(opt_includeInstance, this);
 };
 
 
@@ -1264,18 +1401,14 @@ proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.toObject = funct
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.common.asset_locks.HybridAssetExchangeAgreement} msg The msg instance to transform.
+ * @param {!proto.common.asset_locks.ApproverSignature} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.toObject = function(includeInstance, msg) {
+proto.common.asset_locks.ApproverSignature.toObject = function(includeInstance, msg) {
   var f, obj = {
-    assettype: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    assetdata: msg.getAssetdata_asB64(),
-    numunits: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    locker: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    recipient: jspb.Message.getFieldWithDefault(msg, 6, "")
+    approver: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    signature: msg.getSignature_asB64()
   };
 
   if (includeInstance) {
@@ -1289,23 +1422,23 @@ proto.common.asset_locks.HybridAssetExchangeAgreement.toObject = function(includ
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement}
+ * @return {!proto.common.asset_locks.ApproverSignature}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinary = function(bytes) {
+proto.common.asset_locks.ApproverSignature.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.common.asset_locks.HybridAssetExchangeAgreement;
-  return proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.common.asset_locks.ApproverSignature;
+  return proto.common.asset_locks.ApproverSignature.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.common.asset_locks.HybridAssetExchangeAgreement} msg The message object to deserialize into.
+ * @param {!proto.common.asset_locks.ApproverSignature} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement}
+ * @return {!proto.common.asset_locks.ApproverSignature}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinaryFromReader = function(msg, reader) {
+proto.common.asset_locks.ApproverSignature.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1314,27 +1447,11 @@ proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinaryFromReade
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAssettype(value);
+      msg.setApprover(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
-      break;
-    case 3:
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setAssetdata(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setNumunits(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLocker(value);
-      break;
-    case 6:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRecipient(value);
+      msg.setSignature(value);
       break;
     default:
       reader.skipField();
@@ -1349,9 +1466,9 @@ proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinaryFromReade
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.serializeBinary = function() {
+proto.common.asset_locks.ApproverSignature.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.common.asset_locks.HybridAssetExchangeAgreement.serializeBinaryToWriter(this, writer);
+  proto.common.asset_locks.ApproverSignature.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1359,51 +1476,23 @@ proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.serializeBinary
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.common.asset_locks.HybridAssetExchangeAgreement} message
+ * @param {!proto.common.asset_locks.ApproverSignature} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.serializeBinaryToWriter = function(message, writer) {
+proto.common.asset_locks.ApproverSignature.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAssettype();
+  f = message.getApprover();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getAssetdata_asU8();
+  f = message.getSignature_asU8();
   if (f.length > 0) {
     writer.writeBytes(
-      3,
-      f
-    );
-  }
-  f = message.getNumunits();
-  if (f !== 0) {
-    writer.writeUint64(
-      4,
-      f
-    );
-  }
-  f = message.getLocker();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getRecipient();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
+      2,
       f
     );
   }
@@ -1411,134 +1500,222 @@ proto.common.asset_locks.HybridAssetExchangeAgreement.serializeBinaryToWriter =
 
 
 /**
- * optional string assetType = 1;
+ * optional string approver = 1;
  * @return {string}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssettype = function() {
+proto.common.asset_locks.ApproverSignature.prototype.getApprover = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ * @return {!proto.common.asset_locks.ApproverSignature} returns this
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setAssettype = function(value) {
+proto.common.asset_locks.ApproverSignature.prototype.setApprover = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string id = 2;
+ * optional bytes signature = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.common.asset_locks.ApproverSignature.prototype.getSignature = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes signature = 2;
+ * This is a type-conversion wrapper around `getSignature()`
  * @return {string}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.common.asset_locks.ApproverSignature.prototype.getSignature_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getSignature()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ * optional bytes signature = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getSignature()`
+ * @return {!Uint8Array}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.common.asset_locks.ApproverSignature.prototype.getSignature_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getSignature()));
 };
 
 
 /**
- * optional bytes assetData = 3;
- * @return {!(string|Uint8Array)}
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.common.asset_locks.ApproverSignature} returns this
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssetdata = function() {
-  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.common.asset_locks.ApproverSignature.prototype.setSignature = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
 };
 
 
+
 /**
- * optional bytes assetData = 3;
- * This is a type-conversion wrapper around `getAssetdata()`
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssetdata_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getAssetdata()));
+proto.common.asset_locks.AssetClaimSignature.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.asset_locks.AssetClaimSignature.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.AssetClaimSignature.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional bytes assetData = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getAssetdata()`
- * @return {!Uint8Array}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.asset_locks.AssetClaimSignature} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssetdata_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getAssetdata()));
+proto.common.asset_locks.AssetClaimSignature.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    signaturesList: jspb.Message.toObjectList(msg.getSignaturesList(),
+    proto.common.asset_locks.ApproverSignature.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.asset_locks.AssetClaimSignature}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setAssetdata = function(value) {
-  return jspb.Message.setProto3BytesField(this, 3, value);
+proto.common.asset_locks.AssetClaimSignature.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.asset_locks.AssetClaimSignature;
+  return proto.common.asset_locks.AssetClaimSignature.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional uint64 numUnits = 4;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.asset_locks.AssetClaimSignature} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.asset_locks.AssetClaimSignature}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getNumunits = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.common.asset_locks.AssetClaimSignature.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.common.asset_locks.ApproverSignature;
+      reader.readMessage(value,proto.common.asset_locks.ApproverSignature.deserializeBinaryFromReader);
+      msg.addSignatures(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setNumunits = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.common.asset_locks.AssetClaimSignature.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.asset_locks.AssetClaimSignature.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string locker = 5;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.asset_locks.AssetClaimSignature} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getLocker = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.common.asset_locks.AssetClaimSignature.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSignaturesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.common.asset_locks.ApproverSignature.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ * repeated ApproverSignature signatures = 1;
+ * @return {!Array<!proto.common.asset_locks.ApproverSignature>}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setLocker = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.common.asset_locks.AssetClaimSignature.prototype.getSignaturesList = function() {
+  return /** @type{!Array<!proto.common.asset_locks.ApproverSignature>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.common.asset_locks.ApproverSignature, 1));
 };
 
 
 /**
- * optional string recipient = 6;
- * @return {string}
+ * @param {!Array<!proto.common.asset_locks.ApproverSignature>} value
+ * @return {!proto.common.asset_locks.AssetClaimSignature} returns this
+*/
+proto.common.asset_locks.AssetClaimSignature.prototype.setSignaturesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.common.asset_locks.ApproverSignature=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.common.asset_locks.ApproverSignature}
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getRecipient = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.common.asset_locks.AssetClaimSignature.prototype.addSignatures = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.common.asset_locks.ApproverSignature, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.asset_locks.AssetClaimSignature} returns this
  */
-proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setRecipient = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.common.asset_locks.AssetClaimSignature.prototype.clearSignaturesList = function() {
+  return this.setSignaturesList([]);
 };
 
 
@@ -1558,8 +1735,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.toObject = function(opt_includeInstance) {
-  return proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject(opt_includeInstance, this);
+proto.common.asset_locks.AssetExchangeAgreement.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.AssetExchangeAgreement.toObject(opt_includeInstance, this);
 };
 
 
@@ -1568,14 +1745,14 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.toObject = fun
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.common.asset_locks.FungibleAssetExchangeAgreement} msg The msg instance to transform.
+ * @param {!proto.common.asset_locks.AssetExchangeAgreement} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject = function(includeInstance, msg) {
+proto.common.asset_locks.AssetExchangeAgreement.toObject = function(includeInstance, msg) {
   var f, obj = {
     assettype: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    numunits: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
     locker: jspb.Message.getFieldWithDefault(msg, 3, ""),
     recipient: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
@@ -1591,23 +1768,23 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject = function(incl
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement}
+ * @return {!proto.common.asset_locks.AssetExchangeAgreement}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinary = function(bytes) {
+proto.common.asset_locks.AssetExchangeAgreement.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.common.asset_locks.FungibleAssetExchangeAgreement;
-  return proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.common.asset_locks.AssetExchangeAgreement;
+  return proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.common.asset_locks.FungibleAssetExchangeAgreement} msg The message object to deserialize into.
+ * @param {!proto.common.asset_locks.AssetExchangeAgreement} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement}
+ * @return {!proto.common.asset_locks.AssetExchangeAgreement}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromReader = function(msg, reader) {
+proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1619,8 +1796,8 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromRea
       msg.setAssettype(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setNumunits(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
@@ -1643,9 +1820,9 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromRea
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.serializeBinary = function() {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter(this, writer);
+  proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1653,11 +1830,11 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.serializeBinar
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.common.asset_locks.FungibleAssetExchangeAgreement} message
+ * @param {!proto.common.asset_locks.AssetExchangeAgreement} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter = function(message, writer) {
+proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getAssettype();
   if (f.length > 0) {
@@ -1666,9 +1843,9 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter
       f
     );
   }
-  f = message.getNumunits();
-  if (f !== 0) {
-    writer.writeUint64(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
@@ -1694,35 +1871,35 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter
  * optional string assetType = 1;
  * @return {string}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getAssettype = function() {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.getAssettype = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setAssettype = function(value) {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.setAssettype = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint64 numUnits = 2;
- * @return {number}
+ * optional string id = 2;
+ * @return {string}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getNumunits = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.common.asset_locks.AssetExchangeAgreement.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ * @param {string} value
+ * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setNumunits = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.common.asset_locks.AssetExchangeAgreement.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -1730,16 +1907,16 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setNumunits =
  * optional string locker = 3;
  * @return {string}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getLocker = function() {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.getLocker = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setLocker = function(value) {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.setLocker = function(value) {
   return jspb.Message.setProto3StringField(this, 3, value);
 };
 
@@ -1748,16 +1925,16 @@ proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setLocker = fu
  * optional string recipient = 4;
  * @return {string}
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getRecipient = function() {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.getRecipient = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ * @return {!proto.common.asset_locks.AssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setRecipient = function(value) {
+proto.common.asset_locks.AssetExchangeAgreement.prototype.setRecipient = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
@@ -1778,8 +1955,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.toObject = function(opt_includeInstance) {
-  return proto.common.asset_locks.AssetContractHTLC.toObject(opt_includeInstance, this);
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.HybridAssetExchangeAgreement.toObject(opt_includeInstance, this);
 };
 
 
@@ -1788,16 +1965,18 @@ proto.common.asset_locks.AssetContractHTLC.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.common.asset_locks.AssetContractHTLC} msg The msg instance to transform.
+ * @param {!proto.common.asset_locks.HybridAssetExchangeAgreement} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.AssetContractHTLC.toObject = function(includeInstance, msg) {
+proto.common.asset_locks.HybridAssetExchangeAgreement.toObject = function(includeInstance, msg) {
   var f, obj = {
-    contractid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    agreement: (f = msg.getAgreement()) && proto.common.asset_locks.AssetExchangeAgreement.toObject(includeInstance, f),
-    lock: (f = msg.getLock()) && proto.common.asset_locks.AssetLockHTLC.toObject(includeInstance, f),
-    claim: (f = msg.getClaim()) && proto.common.asset_locks.AssetClaimHTLC.toObject(includeInstance, f)
+    assettype: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    assetdata: msg.getAssetdata_asB64(),
+    numunits: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    locker: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    recipient: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -1811,23 +1990,23 @@ proto.common.asset_locks.AssetContractHTLC.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.common.asset_locks.AssetContractHTLC}
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement}
  */
-proto.common.asset_locks.AssetContractHTLC.deserializeBinary = function(bytes) {
+proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.common.asset_locks.AssetContractHTLC;
-  return proto.common.asset_locks.AssetContractHTLC.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.common.asset_locks.HybridAssetExchangeAgreement;
+  return proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.common.asset_locks.AssetContractHTLC} msg The message object to deserialize into.
+ * @param {!proto.common.asset_locks.HybridAssetExchangeAgreement} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.common.asset_locks.AssetContractHTLC}
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement}
  */
-proto.common.asset_locks.AssetContractHTLC.deserializeBinaryFromReader = function(msg, reader) {
+proto.common.asset_locks.HybridAssetExchangeAgreement.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1836,22 +2015,27 @@ proto.common.asset_locks.AssetContractHTLC.deserializeBinaryFromReader = functio
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setContractid(value);
+      msg.setAssettype(value);
       break;
     case 2:
-      var value = new proto.common.asset_locks.AssetExchangeAgreement;
-      reader.readMessage(value,proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader);
-      msg.setAgreement(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 3:
-      var value = new proto.common.asset_locks.AssetLockHTLC;
-      reader.readMessage(value,proto.common.asset_locks.AssetLockHTLC.deserializeBinaryFromReader);
-      msg.setLock(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setAssetdata(value);
       break;
     case 4:
-      var value = new proto.common.asset_locks.AssetClaimHTLC;
-      reader.readMessage(value,proto.common.asset_locks.AssetClaimHTLC.deserializeBinaryFromReader);
-      msg.setClaim(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumunits(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLocker(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRecipient(value);
       break;
     default:
       reader.skipField();
@@ -1866,9 +2050,9 @@ proto.common.asset_locks.AssetContractHTLC.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.serializeBinary = function() {
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.common.asset_locks.AssetContractHTLC.serializeBinaryToWriter(this, writer);
+  proto.common.asset_locks.HybridAssetExchangeAgreement.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1876,172 +2060,186 @@ proto.common.asset_locks.AssetContractHTLC.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.common.asset_locks.AssetContractHTLC} message
+ * @param {!proto.common.asset_locks.HybridAssetExchangeAgreement} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.AssetContractHTLC.serializeBinaryToWriter = function(message, writer) {
+proto.common.asset_locks.HybridAssetExchangeAgreement.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getContractid();
+  f = message.getAssettype();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getAgreement();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
-      f,
-      proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getLock();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getAssetdata_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
       3,
-      f,
-      proto.common.asset_locks.AssetLockHTLC.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getClaim();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getNumunits();
+  if (f !== 0) {
+    writer.writeUint64(
       4,
-      f,
-      proto.common.asset_locks.AssetClaimHTLC.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getLocker();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getRecipient();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
     );
   }
 };
 
 
 /**
- * optional string contractId = 1;
+ * optional string assetType = 1;
  * @return {string}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.getContractid = function() {
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssettype = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.setContractid = function(value) {
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setAssettype = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional AssetExchangeAgreement agreement = 2;
- * @return {?proto.common.asset_locks.AssetExchangeAgreement}
+ * optional string id = 2;
+ * @return {string}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.getAgreement = function() {
-  return /** @type{?proto.common.asset_locks.AssetExchangeAgreement} */ (
-    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetExchangeAgreement, 2));
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {?proto.common.asset_locks.AssetExchangeAgreement|undefined} value
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
-*/
-proto.common.asset_locks.AssetContractHTLC.prototype.setAgreement = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ * optional bytes assetData = 3;
+ * @return {!(string|Uint8Array)}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.clearAgreement = function() {
-  return this.setAgreement(undefined);
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssetdata = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional bytes assetData = 3;
+ * This is a type-conversion wrapper around `getAssetdata()`
+ * @return {string}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.hasAgreement = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssetdata_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getAssetdata()));
 };
 
 
 /**
- * optional AssetLockHTLC lock = 3;
- * @return {?proto.common.asset_locks.AssetLockHTLC}
+ * optional bytes assetData = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getAssetdata()`
+ * @return {!Uint8Array}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.getLock = function() {
-  return /** @type{?proto.common.asset_locks.AssetLockHTLC} */ (
-    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetLockHTLC, 3));
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getAssetdata_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getAssetdata()));
 };
 
 
 /**
- * @param {?proto.common.asset_locks.AssetLockHTLC|undefined} value
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
-*/
-proto.common.asset_locks.AssetContractHTLC.prototype.setLock = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setAssetdata = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ * optional uint64 numUnits = 4;
+ * @return {number}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.clearLock = function() {
-  return this.setLock(undefined);
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getNumunits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {number} value
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.hasLock = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setNumunits = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 /**
- * optional AssetClaimHTLC claim = 4;
- * @return {?proto.common.asset_locks.AssetClaimHTLC}
+ * optional string locker = 5;
+ * @return {string}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.getClaim = function() {
-  return /** @type{?proto.common.asset_locks.AssetClaimHTLC} */ (
-    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetClaimHTLC, 4));
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getLocker = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {?proto.common.asset_locks.AssetClaimHTLC|undefined} value
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
-*/
-proto.common.asset_locks.AssetContractHTLC.prototype.setClaim = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * @param {string} value
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setLocker = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ * optional string recipient = 6;
+ * @return {string}
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.clearClaim = function() {
-  return this.setClaim(undefined);
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.getRecipient = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.common.asset_locks.HybridAssetExchangeAgreement} returns this
  */
-proto.common.asset_locks.AssetContractHTLC.prototype.hasClaim = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.common.asset_locks.HybridAssetExchangeAgreement.prototype.setRecipient = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
@@ -2061,8 +2259,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.toObject = function(opt_includeInstance) {
-  return proto.common.asset_locks.FungibleAssetContractHTLC.toObject(opt_includeInstance, this);
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject(opt_includeInstance, this);
 };
 
 
@@ -2071,16 +2269,16 @@ proto.common.asset_locks.FungibleAssetContractHTLC.prototype.toObject = function
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.common.asset_locks.FungibleAssetContractHTLC} msg The msg instance to transform.
+ * @param {!proto.common.asset_locks.FungibleAssetExchangeAgreement} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.toObject = function(includeInstance, msg) {
+proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject = function(includeInstance, msg) {
   var f, obj = {
-    contractid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    agreement: (f = msg.getAgreement()) && proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject(includeInstance, f),
-    lock: (f = msg.getLock()) && proto.common.asset_locks.AssetLockHTLC.toObject(includeInstance, f),
-    claim: (f = msg.getClaim()) && proto.common.asset_locks.AssetClaimHTLC.toObject(includeInstance, f)
+    assettype: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    numunits: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    locker: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    recipient: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -2094,23 +2292,23 @@ proto.common.asset_locks.FungibleAssetContractHTLC.toObject = function(includeIn
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC}
+ * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinary = function(bytes) {
+proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.common.asset_locks.FungibleAssetContractHTLC;
-  return proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.common.asset_locks.FungibleAssetExchangeAgreement;
+  return proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.common.asset_locks.FungibleAssetContractHTLC} msg The message object to deserialize into.
+ * @param {!proto.common.asset_locks.FungibleAssetExchangeAgreement} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC}
+ * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinaryFromReader = function(msg, reader) {
+proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2119,22 +2317,19 @@ proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinaryFromReader =
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setContractid(value);
+      msg.setAssettype(value);
       break;
     case 2:
-      var value = new proto.common.asset_locks.FungibleAssetExchangeAgreement;
-      reader.readMessage(value,proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromReader);
-      msg.setAgreement(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumunits(value);
       break;
     case 3:
-      var value = new proto.common.asset_locks.AssetLockHTLC;
-      reader.readMessage(value,proto.common.asset_locks.AssetLockHTLC.deserializeBinaryFromReader);
-      msg.setLock(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLocker(value);
       break;
     case 4:
-      var value = new proto.common.asset_locks.AssetClaimHTLC;
-      reader.readMessage(value,proto.common.asset_locks.AssetClaimHTLC.deserializeBinaryFromReader);
-      msg.setClaim(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRecipient(value);
       break;
     default:
       reader.skipField();
@@ -2149,9 +2344,9 @@ proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.serializeBinary = function() {
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.common.asset_locks.FungibleAssetContractHTLC.serializeBinaryToWriter(this, writer);
+  proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2159,172 +2354,1208 @@ proto.common.asset_locks.FungibleAssetContractHTLC.prototype.serializeBinary = f
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.common.asset_locks.FungibleAssetContractHTLC} message
+ * @param {!proto.common.asset_locks.FungibleAssetExchangeAgreement} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.serializeBinaryToWriter = function(message, writer) {
+proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getContractid();
+  f = message.getAssettype();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getAgreement();
+  f = message.getNumunits();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getLocker();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRecipient();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string assetType = 1;
+ * @return {string}
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getAssettype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setAssettype = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint64 numUnits = 2;
+ * @return {number}
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getNumunits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setNumunits = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string locker = 3;
+ * @return {string}
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getLocker = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setLocker = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string recipient = 4;
+ * @return {string}
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.getRecipient = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_locks.FungibleAssetExchangeAgreement} returns this
+ */
+proto.common.asset_locks.FungibleAssetExchangeAgreement.prototype.setRecipient = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.AssetContractHTLC.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.asset_locks.AssetContractHTLC} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.AssetContractHTLC.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    contractid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    agreement: (f = msg.getAgreement()) && proto.common.asset_locks.AssetExchangeAgreement.toObject(includeInstance, f),
+    lock: (f = msg.getLock()) && proto.common.asset_locks.AssetLockHTLC.toObject(includeInstance, f),
+    claim: (f = msg.getClaim()) && proto.common.asset_locks.AssetClaimHTLC.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.asset_locks.AssetContractHTLC}
+ */
+proto.common.asset_locks.AssetContractHTLC.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.asset_locks.AssetContractHTLC;
+  return proto.common.asset_locks.AssetContractHTLC.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.asset_locks.AssetContractHTLC} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.asset_locks.AssetContractHTLC}
+ */
+proto.common.asset_locks.AssetContractHTLC.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContractid(value);
+      break;
+    case 2:
+      var value = new proto.common.asset_locks.AssetExchangeAgreement;
+      reader.readMessage(value,proto.common.asset_locks.AssetExchangeAgreement.deserializeBinaryFromReader);
+      msg.setAgreement(value);
+      break;
+    case 3:
+      var value = new proto.common.asset_locks.AssetLockHTLC;
+      reader.readMessage(value,proto.common.asset_locks.AssetLockHTLC.deserializeBinaryFromReader);
+      msg.setLock(value);
+      break;
+    case 4:
+      var value = new proto.common.asset_locks.AssetClaimHTLC;
+      reader.readMessage(value,proto.common.asset_locks.AssetClaimHTLC.deserializeBinaryFromReader);
+      msg.setClaim(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.asset_locks.AssetContractHTLC.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.asset_locks.AssetContractHTLC} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.AssetContractHTLC.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContractid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAgreement();
   if (f != null) {
     writer.writeMessage(
       2,
       f,
-      proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter
+      proto.common.asset_locks.AssetExchangeAgreement.serializeBinaryToWriter
+    );
+  }
+  f = message.getLock();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.common.asset_locks.AssetLockHTLC.serializeBinaryToWriter
+    );
+  }
+  f = message.getClaim();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.common.asset_locks.AssetClaimHTLC.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string contractId = 1;
+ * @return {string}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.getContractid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.setContractid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional AssetExchangeAgreement agreement = 2;
+ * @return {?proto.common.asset_locks.AssetExchangeAgreement}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.getAgreement = function() {
+  return /** @type{?proto.common.asset_locks.AssetExchangeAgreement} */ (
+    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetExchangeAgreement, 2));
+};
+
+
+/**
+ * @param {?proto.common.asset_locks.AssetExchangeAgreement|undefined} value
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+*/
+proto.common.asset_locks.AssetContractHTLC.prototype.setAgreement = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.clearAgreement = function() {
+  return this.setAgreement(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.hasAgreement = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional AssetLockHTLC lock = 3;
+ * @return {?proto.common.asset_locks.AssetLockHTLC}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.getLock = function() {
+  return /** @type{?proto.common.asset_locks.AssetLockHTLC} */ (
+    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetLockHTLC, 3));
+};
+
+
+/**
+ * @param {?proto.common.asset_locks.AssetLockHTLC|undefined} value
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+*/
+proto.common.asset_locks.AssetContractHTLC.prototype.setLock = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.clearLock = function() {
+  return this.setLock(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.hasLock = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional AssetClaimHTLC claim = 4;
+ * @return {?proto.common.asset_locks.AssetClaimHTLC}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.getClaim = function() {
+  return /** @type{?proto.common.asset_locks.AssetClaimHTLC} */ (
+    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetClaimHTLC, 4));
+};
+
+
+/**
+ * @param {?proto.common.asset_locks.AssetClaimHTLC|undefined} value
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+*/
+proto.common.asset_locks.AssetContractHTLC.prototype.setClaim = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.common.asset_locks.AssetContractHTLC} returns this
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.clearClaim = function() {
+  return this.setClaim(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.common.asset_locks.AssetContractHTLC.prototype.hasClaim = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.FungibleAssetContractHTLC.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.asset_locks.FungibleAssetContractHTLC} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    contractid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    agreement: (f = msg.getAgreement()) && proto.common.asset_locks.FungibleAssetExchangeAgreement.toObject(includeInstance, f),
+    lock: (f = msg.getLock()) && proto.common.asset_locks.AssetLockHTLC.toObject(includeInstance, f),
+    claim: (f = msg.getClaim()) && proto.common.asset_locks.AssetClaimHTLC.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.asset_locks.FungibleAssetContractHTLC;
+  return proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.asset_locks.FungibleAssetContractHTLC} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContractid(value);
+      break;
+    case 2:
+      var value = new proto.common.asset_locks.FungibleAssetExchangeAgreement;
+      reader.readMessage(value,proto.common.asset_locks.FungibleAssetExchangeAgreement.deserializeBinaryFromReader);
+      msg.setAgreement(value);
+      break;
+    case 3:
+      var value = new proto.common.asset_locks.AssetLockHTLC;
+      reader.readMessage(value,proto.common.asset_locks.AssetLockHTLC.deserializeBinaryFromReader);
+      msg.setLock(value);
+      break;
+    case 4:
+      var value = new proto.common.asset_locks.AssetClaimHTLC;
+      reader.readMessage(value,proto.common.asset_locks.AssetClaimHTLC.deserializeBinaryFromReader);
+      msg.setClaim(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.asset_locks.FungibleAssetContractHTLC.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.asset_locks.FungibleAssetContractHTLC} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getContractid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAgreement();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.common.asset_locks.FungibleAssetExchangeAgreement.serializeBinaryToWriter
+    );
+  }
+  f = message.getLock();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.common.asset_locks.AssetLockHTLC.serializeBinaryToWriter
+    );
+  }
+  f = message.getClaim();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.common.asset_locks.AssetClaimHTLC.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string contractId = 1;
+ * @return {string}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getContractid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setContractid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional FungibleAssetExchangeAgreement agreement = 2;
+ * @return {?proto.common.asset_locks.FungibleAssetExchangeAgreement}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getAgreement = function() {
+  return /** @type{?proto.common.asset_locks.FungibleAssetExchangeAgreement} */ (
+    jspb.Message.getWrapperField(this, proto.common.asset_locks.FungibleAssetExchangeAgreement, 2));
+};
+
+
+/**
+ * @param {?proto.common.asset_locks.FungibleAssetExchangeAgreement|undefined} value
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+*/
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setAgreement = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.clearAgreement = function() {
+  return this.setAgreement(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasAgreement = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional AssetLockHTLC lock = 3;
+ * @return {?proto.common.asset_locks.AssetLockHTLC}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getLock = function() {
+  return /** @type{?proto.common.asset_locks.AssetLockHTLC} */ (
+    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetLockHTLC, 3));
+};
+
+
+/**
+ * @param {?proto.common.asset_locks.AssetLockHTLC|undefined} value
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+*/
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setLock = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.clearLock = function() {
+  return this.setLock(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasLock = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional AssetClaimHTLC claim = 4;
+ * @return {?proto.common.asset_locks.AssetClaimHTLC}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getClaim = function() {
+  return /** @type{?proto.common.asset_locks.AssetClaimHTLC} */ (
+    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetClaimHTLC, 4));
+};
+
+
+/**
+ * @param {?proto.common.asset_locks.AssetClaimHTLC|undefined} value
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+*/
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setClaim = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.clearClaim = function() {
+  return this.setClaim(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasClaim = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.AssetLockEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.asset_locks.AssetLockEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.AssetLockEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventtype: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    contractid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    assettype: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    assetid: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    numunits: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    locker: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    recipient: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    expirytimesecs: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    timespec: jspb.Message.getFieldWithDefault(msg, 9, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.asset_locks.AssetLockEvent}
+ */
+proto.common.asset_locks.AssetLockEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.asset_locks.AssetLockEvent;
+  return proto.common.asset_locks.AssetLockEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.asset_locks.AssetLockEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.asset_locks.AssetLockEvent}
+ */
+proto.common.asset_locks.AssetLockEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.common.asset_locks.AssetLockEventType} */ (reader.readEnum());
+      msg.setEventtype(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setContractid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAssettype(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAssetid(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setNumunits(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLocker(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRecipient(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setExpirytimesecs(value);
+      break;
+    case 9:
+      var value = /** @type {!proto.common.asset_locks.TimeSpec} */ (reader.readEnum());
+      msg.setTimespec(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.asset_locks.AssetLockEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.asset_locks.AssetLockEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.AssetLockEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventtype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getContractid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAssettype();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAssetid();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getNumunits();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
+  f = message.getLocker();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
     );
   }
-  f = message.getLock();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.common.asset_locks.AssetLockHTLC.serializeBinaryToWriter
+  f = message.getRecipient();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
     );
   }
-  f = message.getClaim();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.common.asset_locks.AssetClaimHTLC.serializeBinaryToWriter
+  f = message.getExpirytimesecs();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getTimespec();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      9,
+      f
     );
   }
 };
 
 
 /**
- * optional string contractId = 1;
+ * optional AssetLockEventType eventType = 1;
+ * @return {!proto.common.asset_locks.AssetLockEventType}
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.getEventtype = function() {
+  return /** @type {!proto.common.asset_locks.AssetLockEventType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.common.asset_locks.AssetLockEventType} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.setEventtype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string contractId = 2;
  * @return {string}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getContractid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.common.asset_locks.AssetLockEvent.prototype.getContractid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setContractid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.common.asset_locks.AssetLockEvent.prototype.setContractid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional FungibleAssetExchangeAgreement agreement = 2;
- * @return {?proto.common.asset_locks.FungibleAssetExchangeAgreement}
+ * optional string assetType = 3;
+ * @return {string}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getAgreement = function() {
-  return /** @type{?proto.common.asset_locks.FungibleAssetExchangeAgreement} */ (
-    jspb.Message.getWrapperField(this, proto.common.asset_locks.FungibleAssetExchangeAgreement, 2));
+proto.common.asset_locks.AssetLockEvent.prototype.getAssettype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {?proto.common.asset_locks.FungibleAssetExchangeAgreement|undefined} value
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
-*/
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setAgreement = function(value) {
-  return jspb.Message.setWrapperField(this, 2, value);
+ * @param {string} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.setAssettype = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ * optional string assetId = 4;
+ * @return {string}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.clearAgreement = function() {
-  return this.setAgreement(undefined);
+proto.common.asset_locks.AssetLockEvent.prototype.getAssetid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasAgreement = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.common.asset_locks.AssetLockEvent.prototype.setAssetid = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional AssetLockHTLC lock = 3;
- * @return {?proto.common.asset_locks.AssetLockHTLC}
+ * optional uint64 numUnits = 5;
+ * @return {number}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getLock = function() {
-  return /** @type{?proto.common.asset_locks.AssetLockHTLC} */ (
-    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetLockHTLC, 3));
+proto.common.asset_locks.AssetLockEvent.prototype.getNumunits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {?proto.common.asset_locks.AssetLockHTLC|undefined} value
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
-*/
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setLock = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {number} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.setNumunits = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ * optional string locker = 6;
+ * @return {string}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.clearLock = function() {
-  return this.setLock(undefined);
+proto.common.asset_locks.AssetLockEvent.prototype.getLocker = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasLock = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.common.asset_locks.AssetLockEvent.prototype.setLocker = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * optional AssetClaimHTLC claim = 4;
- * @return {?proto.common.asset_locks.AssetClaimHTLC}
+ * optional string recipient = 7;
+ * @return {string}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.getClaim = function() {
-  return /** @type{?proto.common.asset_locks.AssetClaimHTLC} */ (
-    jspb.Message.getWrapperField(this, proto.common.asset_locks.AssetClaimHTLC, 4));
+proto.common.asset_locks.AssetLockEvent.prototype.getRecipient = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * @param {?proto.common.asset_locks.AssetClaimHTLC|undefined} value
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ * @param {string} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.setRecipient = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 expiryTimeSecs = 8;
+ * @return {number}
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.getExpirytimesecs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.setExpirytimesecs = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional TimeSpec timeSpec = 9;
+ * @return {!proto.common.asset_locks.TimeSpec}
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.getTimespec = function() {
+  return /** @type {!proto.common.asset_locks.TimeSpec} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {!proto.common.asset_locks.TimeSpec} value
+ * @return {!proto.common.asset_locks.AssetLockEvent} returns this
+ */
+proto.common.asset_locks.AssetLockEvent.prototype.setTimespec = function(value) {
+  return jspb.Message.setProto3EnumField(this, 9, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.common.asset_locks.AssetLockEvents.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.asset_locks.AssetLockEvents.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_locks.AssetLockEvents.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.asset_locks.AssetLockEvents} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.AssetLockEvents.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventsList: jspb.Message.toObjectList(msg.getEventsList(),
+    proto.common.asset_locks.AssetLockEvent.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.asset_locks.AssetLockEvents}
+ */
+proto.common.asset_locks.AssetLockEvents.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.asset_locks.AssetLockEvents;
+  return proto.common.asset_locks.AssetLockEvents.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.asset_locks.AssetLockEvents} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.asset_locks.AssetLockEvents}
+ */
+proto.common.asset_locks.AssetLockEvents.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.common.asset_locks.AssetLockEvent;
+      reader.readMessage(value,proto.common.asset_locks.AssetLockEvent.deserializeBinaryFromReader);
+      msg.addEvents(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.asset_locks.AssetLockEvents.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.asset_locks.AssetLockEvents.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.asset_locks.AssetLockEvents} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_locks.AssetLockEvents.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.common.asset_locks.AssetLockEvent.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AssetLockEvent events = 1;
+ * @return {!Array<!proto.common.asset_locks.AssetLockEvent>}
+ */
+proto.common.asset_locks.AssetLockEvents.prototype.getEventsList = function() {
+  return /** @type{!Array<!proto.common.asset_locks.AssetLockEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.common.asset_locks.AssetLockEvent, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.common.asset_locks.AssetLockEvent>} value
+ * @return {!proto.common.asset_locks.AssetLockEvents} returns this
 */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.setClaim = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+proto.common.asset_locks.AssetLockEvents.prototype.setEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.common.asset_locks.FungibleAssetContractHTLC} returns this
+ * @param {!proto.common.asset_locks.AssetLockEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.common.asset_locks.AssetLockEvent}
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.clearClaim = function() {
-  return this.setClaim(undefined);
+proto.common.asset_locks.AssetLockEvents.prototype.addEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.common.asset_locks.AssetLockEvent, opt_index);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.asset_locks.AssetLockEvents} returns this
  */
-proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasClaim = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.common.asset_locks.AssetLockEvents.prototype.clearEventsList = function() {
+  return this.setEventsList([]);
 };
 
 
@@ -2332,7 +3563,8 @@ proto.common.asset_locks.FungibleAssetContractHTLC.prototype.hasClaim = function
  * @enum {number}
  */
 proto.common.asset_locks.LockMechanism = {
-  HTLC: 0
+  HTLC: 0,
+  SIGNATURE: 1
 };
 
 /**
@@ -2340,7 +3572,9 @@ proto.common.asset_locks.LockMechanism = {
  */
 proto.common.asset_locks.HashMechanism = {
   SHA256: 0,
-  SHA512: 1
+  SHA512: 1,
+  KECCAK256: 2,
+  SHA3_256: 3
 };
 
 /**
@@ -2351,4 +3585,17 @@ proto.common.asset_locks.TimeSpec = {
   DURATION: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.common.asset_locks.AssetLockEventType = {
+  LOCKED: 0,
+  CLAIMED: 1,
+  UNLOCKED: 2,
+  EXTENDED: 3,
+  CANCELLED: 4,
+  EXPIRED: 5,
+  PARTIALLY_CLAIMED: 6
+};
+
 goog.object.extend(exports, proto.common.asset_locks);
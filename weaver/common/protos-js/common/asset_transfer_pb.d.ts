@@ -79,3 +79,58 @@ export namespace AssetClaimStatus {
         expirationstatus: boolean,
     }
 }
+
+export class AssetPledgeEvent extends jspb.Message { 
+    getEventtype(): AssetPledgeEventType;
+    setEventtype(value: AssetPledgeEventType): AssetPledgeEvent;
+    getPledgeid(): string;
+    setPledgeid(value: string): AssetPledgeEvent;
+    getAssettype(): string;
+    setAssettype(value: string): AssetPledgeEvent;
+    getAssetidorquantity(): string;
+    setAssetidorquantity(value: string): AssetPledgeEvent;
+    getAssetdetails(): Uint8Array | string;
+    getAssetdetails_asU8(): Uint8Array;
+    getAssetdetails_asB64(): string;
+    setAssetdetails(value: Uint8Array | string): AssetPledgeEvent;
+    getPledger(): string;
+    setPledger(value: string): AssetPledgeEvent;
+    getRecipient(): string;
+    setRecipient(value: string): AssetPledgeEvent;
+    getExpirytimesecs(): number;
+    setExpirytimesecs(value: number): AssetPledgeEvent;
+    getLocalnetworkid(): string;
+    setLocalnetworkid(value: string): AssetPledgeEvent;
+    getRemotenetworkid(): string;
+    setRemotenetworkid(value: string): AssetPledgeEvent;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): AssetPledgeEvent.AsObject;
+    static toObject(includeInstance: boolean, msg: AssetPledgeEvent): AssetPledgeEvent.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: AssetPledgeEvent, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): AssetPledgeEvent;
+    static deserializeBinaryFromReader(message: AssetPledgeEvent, reader: jspb.BinaryReader): AssetPledgeEvent;
+}
+
+export namespace AssetPledgeEvent {
+    export type AsObject = {
+        eventtype: AssetPledgeEventType,
+        pledgeid: string,
+        assettype: string,
+        assetidorquantity: string,
+        assetdetails: Uint8Array | string,
+        pledger: string,
+        recipient: string,
+        expirytimesecs: number,
+        localnetworkid: string,
+        remotenetworkid: string,
+    }
+}
+
+export enum AssetPledgeEventType {
+    PLEDGED = 0,
+    REMOTE_CLAIMED = 1,
+    RECLAIMED = 2,
+}
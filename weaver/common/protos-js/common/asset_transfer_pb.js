@@ -23,6 +23,8 @@ var global = (function() {
 
 goog.exportSymbol('proto.common.asset_transfer.AssetClaimStatus', null, global);
 goog.exportSymbol('proto.common.asset_transfer.AssetPledge', null, global);
+goog.exportSymbol('proto.common.asset_transfer.AssetPledgeEvent', null, global);
+goog.exportSymbol('proto.common.asset_transfer.AssetPledgeEventType', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -65,6 +67,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.common.asset_transfer.AssetClaimStatus.displayName = 'proto.common.asset_transfer.AssetClaimStatus';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.asset_transfer.AssetPledgeEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.common.asset_transfer.AssetPledgeEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.asset_transfer.AssetPledgeEvent.displayName = 'proto.common.asset_transfer.AssetPledgeEvent';
+}
 
 
 
@@ -673,4 +696,437 @@ proto.common.asset_transfer.AssetClaimStatus.prototype.setExpirationstatus = fun
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.asset_transfer.AssetPledgeEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.asset_transfer.AssetPledgeEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_transfer.AssetPledgeEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    eventtype: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pledgeid: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    assettype: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    assetidorquantity: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    assetdetails: msg.getAssetdetails_asB64(),
+    pledger: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    recipient: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    expirytimesecs: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    localnetworkid: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    remotenetworkid: jspb.Message.getFieldWithDefault(msg, 10, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.asset_transfer.AssetPledgeEvent;
+  return proto.common.asset_transfer.AssetPledgeEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.asset_transfer.AssetPledgeEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.common.asset_transfer.AssetPledgeEventType} */ (reader.readEnum());
+      msg.setEventtype(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPledgeid(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAssettype(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAssetidorquantity(value);
+      break;
+    case 5:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setAssetdetails(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPledger(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRecipient(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setExpirytimesecs(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLocalnetworkid(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRemotenetworkid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.asset_transfer.AssetPledgeEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.asset_transfer.AssetPledgeEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.asset_transfer.AssetPledgeEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEventtype();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getPledgeid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAssettype();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getAssetidorquantity();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getAssetdetails_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      5,
+      f
+    );
+  }
+  f = message.getPledger();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getRecipient();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getExpirytimesecs();
+  if (f !== 0) {
+    writer.writeUint64(
+      8,
+      f
+    );
+  }
+  f = message.getLocalnetworkid();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getRemotenetworkid();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional AssetPledgeEventType eventType = 1;
+ * @return {!proto.common.asset_transfer.AssetPledgeEventType}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getEventtype = function() {
+  return /** @type {!proto.common.asset_transfer.AssetPledgeEventType} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.common.asset_transfer.AssetPledgeEventType} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setEventtype = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional string pledgeId = 2;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getPledgeid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setPledgeid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string assetType = 3;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getAssettype = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setAssettype = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string assetIdOrQuantity = 4;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getAssetidorquantity = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setAssetidorquantity = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bytes assetDetails = 5;
+ * @return {!(string|Uint8Array)}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getAssetdetails = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * optional bytes assetDetails = 5;
+ * This is a type-conversion wrapper around `getAssetdetails()`
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getAssetdetails_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getAssetdetails()));
+};
+
+
+/**
+ * optional bytes assetDetails = 5;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getAssetdetails()`
+ * @return {!Uint8Array}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getAssetdetails_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getAssetdetails()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setAssetdetails = function(value) {
+  return jspb.Message.setProto3BytesField(this, 5, value);
+};
+
+
+/**
+ * optional string pledger = 6;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getPledger = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setPledger = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string recipient = 7;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getRecipient = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setRecipient = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional uint64 expiryTimeSecs = 8;
+ * @return {number}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getExpirytimesecs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setExpirytimesecs = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional string localNetworkID = 9;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getLocalnetworkid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setLocalnetworkid = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string remoteNetworkID = 10;
+ * @return {string}
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.getRemotenetworkid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.asset_transfer.AssetPledgeEvent} returns this
+ */
+proto.common.asset_transfer.AssetPledgeEvent.prototype.setRemotenetworkid = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.common.asset_transfer.AssetPledgeEventType = {
+  PLEDGED: 0,
+  REMOTE_CLAIMED: 1,
+  RECLAIMED: 2
+};
+
 goog.object.extend(exports, proto.common.asset_transfer);
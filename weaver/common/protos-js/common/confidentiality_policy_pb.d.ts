@@ -0,0 +1,55 @@
+// package: common.confidentiality_policy
+// file: common/confidentiality_policy.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+
+export class ConfidentialityPolicy extends jspb.Message { 
+    getSecuritydomain(): string;
+    setSecuritydomain(value: string): ConfidentialityPolicy;
+    clearRulesList(): void;
+    getRulesList(): Array<ConfidentialityRule>;
+    setRulesList(value: Array<ConfidentialityRule>): ConfidentialityPolicy;
+    addRules(value?: ConfidentialityRule, index?: number): ConfidentialityRule;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ConfidentialityPolicy.AsObject;
+    static toObject(includeInstance: boolean, msg: ConfidentialityPolicy): ConfidentialityPolicy.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ConfidentialityPolicy, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ConfidentialityPolicy;
+    static deserializeBinaryFromReader(message: ConfidentialityPolicy, reader: jspb.BinaryReader): ConfidentialityPolicy;
+}
+
+export namespace ConfidentialityPolicy {
+    export type AsObject = {
+        securitydomain: string,
+        rulesList: Array<ConfidentialityRule.AsObject>,
+    }
+}
+
+export class ConfidentialityRule extends jspb.Message { 
+    getResource(): string;
+    setResource(value: string): ConfidentialityRule;
+    getConfidential(): boolean;
+    setConfidential(value: boolean): ConfidentialityRule;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ConfidentialityRule.AsObject;
+    static toObject(includeInstance: boolean, msg: ConfidentialityRule): ConfidentialityRule.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ConfidentialityRule, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ConfidentialityRule;
+    static deserializeBinaryFromReader(message: ConfidentialityRule, reader: jspb.BinaryReader): ConfidentialityRule;
+}
+
+export namespace ConfidentialityRule {
+    export type AsObject = {
+        resource: string,
+        confidential: boolean,
+    }
+}
@@ -0,0 +1,418 @@
+// source: common/confidentiality_policy.proto
+/**
+ * @fileoverview
+ * @enhanceable
+ * @suppress {missingRequire} reports error on implicit type usages.
+ * @suppress {messageConventions} JS Compiler reports an error if a variable or
+ *     field starts with 'MSG_' and isn't a translatable message.
+ * @public
+ */
+// GENERATED CODE -- DO NOT EDIT!
+/* eslint-disable */
+// @ts-nocheck
+
+var jspb = require('google-protobuf');
+var goog = jspb;
+var global = (function() {
+  if (this) { return this; }
+  if (typeof window !== 'undefined') { return window; }
+  if (typeof global !== 'undefined') { return global; }
+  if (typeof self !== 'undefined') { return self; }
+  return Function('return this')();
+}.call(null));
+
+goog.exportSymbol('proto.common.confidentiality_policy.ConfidentialityPolicy', null, global);
+goog.exportSymbol('proto.common.confidentiality_policy.ConfidentialityRule', null, global);
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.common.confidentiality_policy.ConfidentialityPolicy.repeatedFields_, null);
+};
+goog.inherits(proto.common.confidentiality_policy.ConfidentialityPolicy, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.confidentiality_policy.ConfidentialityPolicy.displayName = 'proto.common.confidentiality_policy.ConfidentialityPolicy';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.confidentiality_policy.ConfidentialityRule = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.common.confidentiality_policy.ConfidentialityRule, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.confidentiality_policy.ConfidentialityRule.displayName = 'proto.common.confidentiality_policy.ConfidentialityRule';
+}
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.confidentiality_policy.ConfidentialityPolicy.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.confidentiality_policy.ConfidentialityPolicy} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    securitydomain: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    rulesList: jspb.Message.toObjectList(msg.getRulesList(),
+    proto.common.confidentiality_policy.ConfidentialityRule.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.confidentiality_policy.ConfidentialityPolicy}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.confidentiality_policy.ConfidentialityPolicy;
+  return proto.common.confidentiality_policy.ConfidentialityPolicy.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.confidentiality_policy.ConfidentialityPolicy} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.confidentiality_policy.ConfidentialityPolicy}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSecuritydomain(value);
+      break;
+    case 2:
+      var value = new proto.common.confidentiality_policy.ConfidentialityRule;
+      reader.readMessage(value,proto.common.confidentiality_policy.ConfidentialityRule.deserializeBinaryFromReader);
+      msg.addRules(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.confidentiality_policy.ConfidentialityPolicy.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.confidentiality_policy.ConfidentialityPolicy} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSecuritydomain();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getRulesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.common.confidentiality_policy.ConfidentialityRule.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string securityDomain = 1;
+ * @return {string}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.getSecuritydomain = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.confidentiality_policy.ConfidentialityPolicy} returns this
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.setSecuritydomain = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated ConfidentialityRule rules = 2;
+ * @return {!Array<!proto.common.confidentiality_policy.ConfidentialityRule>}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.getRulesList = function() {
+  return /** @type{!Array<!proto.common.confidentiality_policy.ConfidentialityRule>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.common.confidentiality_policy.ConfidentialityRule, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.common.confidentiality_policy.ConfidentialityRule>} value
+ * @return {!proto.common.confidentiality_policy.ConfidentialityPolicy} returns this
+*/
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.setRulesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.common.confidentiality_policy.ConfidentialityRule=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.common.confidentiality_policy.ConfidentialityRule}
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.addRules = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.common.confidentiality_policy.ConfidentialityRule, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.confidentiality_policy.ConfidentialityPolicy} returns this
+ */
+proto.common.confidentiality_policy.ConfidentialityPolicy.prototype.clearRulesList = function() {
+  return this.setRulesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.confidentiality_policy.ConfidentialityRule.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.confidentiality_policy.ConfidentialityRule} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resource: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    confidential: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.confidentiality_policy.ConfidentialityRule}
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.confidentiality_policy.ConfidentialityRule;
+  return proto.common.confidentiality_policy.ConfidentialityRule.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.confidentiality_policy.ConfidentialityRule} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.confidentiality_policy.ConfidentialityRule}
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setResource(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setConfidential(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.confidentiality_policy.ConfidentialityRule.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.confidentiality_policy.ConfidentialityRule} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResource();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getConfidential();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string resource = 1;
+ * @return {string}
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.prototype.getResource = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.confidentiality_policy.ConfidentialityRule} returns this
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.prototype.setResource = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool confidential = 2;
+ * @return {boolean}
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.prototype.getConfidential = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.common.confidentiality_policy.ConfidentialityRule} returns this
+ */
+proto.common.confidentiality_policy.ConfidentialityRule.prototype.setConfidential = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+goog.object.extend(exports, proto.common.confidentiality_policy);
@@ -19,6 +19,10 @@ export class InteropPayload extends jspb.Message {
     setRequestorCertificate(value: string): InteropPayload;
     getNonce(): string;
     setNonce(value: string): InteropPayload;
+    clearDynamicArgsList(): void;
+    getDynamicArgsList(): Array<DynamicArg>;
+    setDynamicArgsList(value: Array<DynamicArg>): InteropPayload;
+    addDynamicArgs(value?: DynamicArg, index?: number): DynamicArg;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): InteropPayload.AsObject;
@@ -37,6 +41,30 @@ export namespace InteropPayload {
         confidential: boolean,
         requestorCertificate: string,
         nonce: string,
+        dynamicArgsList: Array<DynamicArg.AsObject>,
+    }
+}
+
+export class DynamicArg extends jspb.Message { 
+    getName(): string;
+    setName(value: string): DynamicArg;
+    getValue(): string;
+    setValue(value: string): DynamicArg;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): DynamicArg.AsObject;
+    static toObject(includeInstance: boolean, msg: DynamicArg): DynamicArg.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: DynamicArg, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): DynamicArg;
+    static deserializeBinaryFromReader(message: DynamicArg, reader: jspb.BinaryReader): DynamicArg;
+}
+
+export namespace DynamicArg {
+    export type AsObject = {
+        name: string,
+        value: string,
     }
 }
 
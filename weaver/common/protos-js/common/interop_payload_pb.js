@@ -24,6 +24,7 @@ var global = (function() {
 goog.exportSymbol('proto.common.interop_payload.ConfidentialPayload', null, global);
 goog.exportSymbol('proto.common.interop_payload.ConfidentialPayload.HashType', null, global);
 goog.exportSymbol('proto.common.interop_payload.ConfidentialPayloadContents', null, global);
+goog.exportSymbol('proto.common.interop_payload.DynamicArg', null, global);
 goog.exportSymbol('proto.common.interop_payload.InteropPayload', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -36,7 +37,7 @@ goog.exportSymbol('proto.common.interop_payload.InteropPayload', null, global);
  * @constructor
  */
 proto.common.interop_payload.InteropPayload = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.common.interop_payload.InteropPayload.repeatedFields_, null);
 };
 goog.inherits(proto.common.interop_payload.InteropPayload, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
@@ -46,6 +47,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.common.interop_payload.InteropPayload.displayName = 'proto.common.interop_payload.InteropPayload';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.interop_payload.DynamicArg = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.common.interop_payload.DynamicArg, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.interop_payload.DynamicArg.displayName = 'proto.common.interop_payload.DynamicArg';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -89,6 +111,13 @@ if (goog.DEBUG && !COMPILED) {
   proto.common.interop_payload.ConfidentialPayloadContents.displayName = 'proto.common.interop_payload.ConfidentialPayloadContents';
 }
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.common.interop_payload.InteropPayload.repeatedFields_ = [6];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -124,7 +153,9 @@ proto.common.interop_payload.InteropPayload.toObject = function(includeInstance,
     address: jspb.Message.getFieldWithDefault(msg, 2, ""),
     confidential: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
     requestorCertificate: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    nonce: jspb.Message.getFieldWithDefault(msg, 5, "")
+    nonce: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    dynamicArgsList: jspb.Message.toObjectList(msg.getDynamicArgsList(),
+    proto.common.interop_payload.DynamicArg.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -181,6 +212,11 @@ proto.common.interop_payload.InteropPayload.deserializeBinaryFromReader = functi
       var value = /** @type {string} */ (reader.readString());
       msg.setNonce(value);
       break;
+    case 6:
+      var value = new proto.common.interop_payload.DynamicArg;
+      reader.readMessage(value,proto.common.interop_payload.DynamicArg.deserializeBinaryFromReader);
+      msg.addDynamicArgs(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -245,6 +281,14 @@ proto.common.interop_payload.InteropPayload.serializeBinaryToWriter = function(m
       f
     );
   }
+  f = message.getDynamicArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.common.interop_payload.DynamicArg.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -362,6 +406,204 @@ proto.common.interop_payload.InteropPayload.prototype.setNonce = function(value)
 };
 
 
+/**
+ * repeated DynamicArg dynamic_args = 6;
+ * @return {!Array<!proto.common.interop_payload.DynamicArg>}
+ */
+proto.common.interop_payload.InteropPayload.prototype.getDynamicArgsList = function() {
+  return /** @type{!Array<!proto.common.interop_payload.DynamicArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.common.interop_payload.DynamicArg, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.common.interop_payload.DynamicArg>} value
+ * @return {!proto.common.interop_payload.InteropPayload} returns this
+*/
+proto.common.interop_payload.InteropPayload.prototype.setDynamicArgsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.common.interop_payload.DynamicArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.common.interop_payload.DynamicArg}
+ */
+proto.common.interop_payload.InteropPayload.prototype.addDynamicArgs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.common.interop_payload.DynamicArg, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.interop_payload.InteropPayload} returns this
+ */
+proto.common.interop_payload.InteropPayload.prototype.clearDynamicArgsList = function() {
+  return this.setDynamicArgsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.interop_payload.DynamicArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.interop_payload.DynamicArg.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.interop_payload.DynamicArg} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.interop_payload.DynamicArg.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.interop_payload.DynamicArg}
+ */
+proto.common.interop_payload.DynamicArg.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.interop_payload.DynamicArg;
+  return proto.common.interop_payload.DynamicArg.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.interop_payload.DynamicArg} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.interop_payload.DynamicArg}
+ */
+proto.common.interop_payload.DynamicArg.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.interop_payload.DynamicArg.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.interop_payload.DynamicArg.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.interop_payload.DynamicArg} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.interop_payload.DynamicArg.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.common.interop_payload.DynamicArg.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.interop_payload.DynamicArg} returns this
+ */
+proto.common.interop_payload.DynamicArg.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string value = 2;
+ * @return {string}
+ */
+proto.common.interop_payload.DynamicArg.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.interop_payload.DynamicArg} returns this
+ */
+proto.common.interop_payload.DynamicArg.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 
 
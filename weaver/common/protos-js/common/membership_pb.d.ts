@@ -40,6 +40,10 @@ export class Member extends jspb.Message {
     getChainList(): Array<string>;
     setChainList(value: Array<string>): Member;
     addChain(value: string, index?: number): string;
+    clearCrlsList(): void;
+    getCrlsList(): Array<string>;
+    setCrlsList(value: Array<string>): Member;
+    addCrls(value: string, index?: number): string;
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Member.AsObject;
@@ -56,5 +60,6 @@ export namespace Member {
         value: string,
         type: string,
         chainList: Array<string>,
+        crlsList: Array<string>,
     }
 }
@@ -234,7 +234,7 @@ proto.common.membership.Membership.prototype.clearMembersMap = function() {
  * @private {!Array<number>}
  * @const
  */
-proto.common.membership.Member.repeatedFields_ = [3];
+proto.common.membership.Member.repeatedFields_ = [3,4];
 
 
 
@@ -269,7 +269,8 @@ proto.common.membership.Member.toObject = function(includeInstance, msg) {
   var f, obj = {
     value: jspb.Message.getFieldWithDefault(msg, 1, ""),
     type: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    chainList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
+    chainList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    crlsList: (f = jspb.Message.getRepeatedField(msg, 4)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -318,6 +319,10 @@ proto.common.membership.Member.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {string} */ (reader.readString());
       msg.addChain(value);
       break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCrls(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -368,6 +373,13 @@ proto.common.membership.Member.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getCrlsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      4,
+      f
+    );
+  }
 };
 
 
@@ -444,4 +456,41 @@ proto.common.membership.Member.prototype.clearChainList = function() {
 };
 
 
+/**
+ * repeated string crls = 4;
+ * @return {!Array<string>}
+ */
+proto.common.membership.Member.prototype.getCrlsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 4));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.common.membership.Member} returns this
+ */
+proto.common.membership.Member.prototype.setCrlsList = function(value) {
+  return jspb.Message.setField(this, 4, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.common.membership.Member} returns this
+ */
+proto.common.membership.Member.prototype.addCrls = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 4, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.membership.Member} returns this
+ */
+proto.common.membership.Member.prototype.clearCrlsList = function() {
+  return this.setCrlsList([]);
+};
+
+
 goog.object.extend(exports, proto.common.membership);
@@ -71,6 +71,58 @@ export namespace View {
     }
 }
 
+export class ViewEnvelope extends jspb.Message { 
+    getView(): Uint8Array | string;
+    getView_asU8(): Uint8Array;
+    getView_asB64(): string;
+    setView(value: Uint8Array | string): ViewEnvelope;
+    clearAttestationsList(): void;
+    getAttestationsList(): Array<RelayAttestation>;
+    setAttestationsList(value: Array<RelayAttestation>): ViewEnvelope;
+    addAttestations(value?: RelayAttestation, index?: number): RelayAttestation;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ViewEnvelope.AsObject;
+    static toObject(includeInstance: boolean, msg: ViewEnvelope): ViewEnvelope.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ViewEnvelope, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ViewEnvelope;
+    static deserializeBinaryFromReader(message: ViewEnvelope, reader: jspb.BinaryReader): ViewEnvelope;
+}
+
+export namespace ViewEnvelope {
+    export type AsObject = {
+        view: Uint8Array | string,
+        attestationsList: Array<RelayAttestation.AsObject>,
+    }
+}
+
+export class RelayAttestation extends jspb.Message { 
+    getAddress(): string;
+    setAddress(value: string): RelayAttestation;
+    getView(): Uint8Array | string;
+    getView_asU8(): Uint8Array;
+    getView_asB64(): string;
+    setView(value: Uint8Array | string): RelayAttestation;
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RelayAttestation.AsObject;
+    static toObject(includeInstance: boolean, msg: RelayAttestation): RelayAttestation.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RelayAttestation, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RelayAttestation;
+    static deserializeBinaryFromReader(message: RelayAttestation, reader: jspb.BinaryReader): RelayAttestation;
+}
+
+export namespace RelayAttestation {
+    export type AsObject = {
+        address: string,
+        view: Uint8Array | string,
+    }
+}
+
 export class ViewPayload extends jspb.Message { 
     getRequestId(): string;
     setRequestId(value: string): ViewPayload;
@@ -23,10 +23,12 @@ var global = (function() {
 
 goog.exportSymbol('proto.common.state.Meta', null, global);
 goog.exportSymbol('proto.common.state.Meta.Protocol', null, global);
+goog.exportSymbol('proto.common.state.RelayAttestation', null, global);
 goog.exportSymbol('proto.common.state.RequestState', null, global);
 goog.exportSymbol('proto.common.state.RequestState.STATUS', null, global);
 goog.exportSymbol('proto.common.state.RequestState.StateCase', null, global);
 goog.exportSymbol('proto.common.state.View', null, global);
+goog.exportSymbol('proto.common.state.ViewEnvelope', null, global);
 goog.exportSymbol('proto.common.state.ViewPayload', null, global);
 goog.exportSymbol('proto.common.state.ViewPayload.StateCase', null, global);
 /**
@@ -71,6 +73,48 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.common.state.View.displayName = 'proto.common.state.View';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.state.ViewEnvelope = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.common.state.ViewEnvelope.repeatedFields_, null);
+};
+goog.inherits(proto.common.state.ViewEnvelope, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.state.ViewEnvelope.displayName = 'proto.common.state.ViewEnvelope';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.common.state.RelayAttestation = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.common.state.RelayAttestation, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.common.state.RelayAttestation.displayName = 'proto.common.state.RelayAttestation';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -549,6 +593,404 @@ proto.common.state.View.prototype.setData = function(value) {
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.common.state.ViewEnvelope.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.state.ViewEnvelope.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.state.ViewEnvelope.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.state.ViewEnvelope} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.state.ViewEnvelope.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    view: msg.getView_asB64(),
+    attestationsList: jspb.Message.toObjectList(msg.getAttestationsList(),
+    proto.common.state.RelayAttestation.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.state.ViewEnvelope}
+ */
+proto.common.state.ViewEnvelope.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.state.ViewEnvelope;
+  return proto.common.state.ViewEnvelope.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.state.ViewEnvelope} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.state.ViewEnvelope}
+ */
+proto.common.state.ViewEnvelope.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setView(value);
+      break;
+    case 2:
+      var value = new proto.common.state.RelayAttestation;
+      reader.readMessage(value,proto.common.state.RelayAttestation.deserializeBinaryFromReader);
+      msg.addAttestations(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.state.ViewEnvelope.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.state.ViewEnvelope.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.state.ViewEnvelope} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.state.ViewEnvelope.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getView_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+  f = message.getAttestationsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.common.state.RelayAttestation.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional bytes view = 1;
+ * @return {!(string|Uint8Array)}
+ */
+proto.common.state.ViewEnvelope.prototype.getView = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * optional bytes view = 1;
+ * This is a type-conversion wrapper around `getView()`
+ * @return {string}
+ */
+proto.common.state.ViewEnvelope.prototype.getView_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getView()));
+};
+
+
+/**
+ * optional bytes view = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getView()`
+ * @return {!Uint8Array}
+ */
+proto.common.state.ViewEnvelope.prototype.getView_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getView()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.common.state.ViewEnvelope} returns this
+ */
+proto.common.state.ViewEnvelope.prototype.setView = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
+};
+
+
+/**
+ * repeated RelayAttestation attestations = 2;
+ * @return {!Array<!proto.common.state.RelayAttestation>}
+ */
+proto.common.state.ViewEnvelope.prototype.getAttestationsList = function() {
+  return /** @type{!Array<!proto.common.state.RelayAttestation>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.common.state.RelayAttestation, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.common.state.RelayAttestation>} value
+ * @return {!proto.common.state.ViewEnvelope} returns this
+*/
+proto.common.state.ViewEnvelope.prototype.setAttestationsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.common.state.RelayAttestation=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.common.state.RelayAttestation}
+ */
+proto.common.state.ViewEnvelope.prototype.addAttestations = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.common.state.RelayAttestation, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.common.state.ViewEnvelope} returns this
+ */
+proto.common.state.ViewEnvelope.prototype.clearAttestationsList = function() {
+  return this.setAttestationsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.common.state.RelayAttestation.prototype.toObject = function(opt_includeInstance) {
+  return proto.common.state.RelayAttestation.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.common.state.RelayAttestation} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.state.RelayAttestation.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    address: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    view: msg.getView_asB64()
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.common.state.RelayAttestation}
+ */
+proto.common.state.RelayAttestation.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.common.state.RelayAttestation;
+  return proto.common.state.RelayAttestation.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.common.state.RelayAttestation} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.common.state.RelayAttestation}
+ */
+proto.common.state.RelayAttestation.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setView(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.common.state.RelayAttestation.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.common.state.RelayAttestation.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.common.state.RelayAttestation} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.common.state.RelayAttestation.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getView_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string address = 1;
+ * @return {string}
+ */
+proto.common.state.RelayAttestation.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.common.state.RelayAttestation} returns this
+ */
+proto.common.state.RelayAttestation.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes view = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.common.state.RelayAttestation.prototype.getView = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes view = 2;
+ * This is a type-conversion wrapper around `getView()`
+ * @return {string}
+ */
+proto.common.state.RelayAttestation.prototype.getView_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getView()));
+};
+
+
+/**
+ * optional bytes view = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getView()`
+ * @return {!Uint8Array}
+ */
+proto.common.state.RelayAttestation.prototype.getView_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getView()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.common.state.RelayAttestation} returns this
+ */
+proto.common.state.RelayAttestation.prototype.setView = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+
 /**
  * Oneof group definitions for this message. Each group defines the field
  * numbers belonging to that group. When of these fields' value is set, all
@@ -9,9 +9,12 @@
 package main
 
 import (
+	"bytes"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 	log "github.com/sirupsen/logrus"
@@ -21,6 +24,12 @@ import (
 
 const accessControlObjectType = "accessControl"
 
+// anyPrincipal is the principal of rules that apply to any authenticated member of the security domain
+const anyPrincipal = "*"
+
+// certificatePrincipalSeparator separates the issuer and the subject distinguished names in a "certificate" principal
+const certificatePrincipalSeparator = "|"
+
 // CreateAccessControlPolicy cc is used to store a AccessControlPolicy in the ledger
 func (s *SmartContract) CreateAccessControlPolicy(ctx contractapi.TransactionContextInterface, accessControlPolicyJSON string) error {
 	// Check if the caller has network admin privileges
@@ -138,49 +147,174 @@ func (s *SmartContract) DeleteAccessControlPolicy(ctx contractapi.TransactionCon
 	return nil
 }
 
+// AccessCheckResult reports the outcome of an access control check, along with the rule that decided it
+type AccessCheckResult struct {
+	Permitted bool         `json:"permitted"`
+	Rule      *common.Rule `json:"rule,omitempty"`
+	Reason    string       `json:"reason"`
+}
+
+// DryRunAccessCheck cc reports whether the AccessControlPolicy recorded for the requesting network permits a
+// request for the given view address from the holder of the given certificate, and which rule decided it.
+// The requester's membership in the requesting network is not verified.
+func (s *SmartContract) DryRunAccessCheck(ctx contractapi.TransactionContextInterface, requestingNetwork string, viewAddress string, certPEM string) (string, error) {
+	_, err := parseFabricViewAddress(viewAddress)
+	if err != nil {
+		return "", logThenErrorf("Invalid view address: %s", err)
+	}
+	query := &common.Query{
+		RequestingNetwork: requestingNetwork,
+		Certificate:       certPEM,
+	}
+	result, err := checkAccessToCC(s, ctx, viewAddress, query)
+	if err != nil {
+		return "", err
+	}
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", logThenErrorf("Marshal error: %s", err)
+	}
+	return string(resultJSON), nil
+}
+
 // verifyAccessToCC looks up the Access Control State for the external network
 // and verifies that the requester has the required permission to call the specified CC function.
+// It assumes that the requester's membership in the external network has already been verified.
 func verifyAccessToCC(s *SmartContract, ctx contractapi.TransactionContextInterface, viewAddress *FabricViewAddress, viewAddressString string, query *common.Query) error {
+	result, err := checkAccessToCC(s, ctx, viewAddressString, query)
+	if err != nil {
+		return err
+	}
+	if result.Permitted {
+		log.Infof("Access Control Policy PERMITS the request '%s' from '%s:%s': %s", viewAddressString, query.RequestingNetwork, query.Certificate, result.Reason)
+		return nil
+	}
+	log.Infof("Access Control Policy decision for the request '%s': %s", viewAddressString, result.Reason)
+
+	var errorMessage string
+	if (query.Certificate != "") {
+        errorMessage = fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate)
+	} else if (query.RequestingOrg != "") {
+        errorMessage = fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.RequestingOrg)
+	} else {
+		errorMessage = fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from a foreign entity", viewAddressString)
+	}
+	log.Error(errorMessage)
+	return errors.New(errorMessage)
+
+}
+
+// checkAccessToCC evaluates the Access Control State for the external network against a request for the given
+// view address. Of the rules whose resource and principal match the request, the one with the most specific
+// resource decides, and a deny rule takes precedence over an equally specific allow rule.
+// Rules grant only read access, as a view is served by endorsing (but never committing) a call to the
+// CC function; rules with 'read' unset hence deny access like deny rules.
+func checkAccessToCC(s *SmartContract, ctx contractapi.TransactionContextInterface, viewAddressString string, query *common.Query) (*AccessCheckResult, error) {
 	acpString, err := s.GetAccessControlPolicyBySecurityDomain(ctx, query.RequestingNetwork)
 	if err != nil {
 		errorMessage := fmt.Sprintf("Access control policy does not exist for network: %s", query.RequestingNetwork)
 		log.Error(errorMessage)
-		return errors.New(errorMessage)
+		return nil, errors.New(errorMessage)
 	}
 	acp, err := decodeAccessControlPolicy([]byte(acpString))
 	if err != nil {
 		errorMessage := fmt.Sprintf("Failed to unmarshal access control policy: %s", err.Error())
 		log.Error(errorMessage)
-		return errors.New(errorMessage)
+		return nil, errors.New(errorMessage)
 	}
 
+	// A requester certificate that cannot be parsed can only match the wildcard principal
+	requesterCert, _ := parseCert(query.Certificate)
+	// The Membership is looked up only if a "ca" or a wildcard rule needs to be checked
+	var membership *common.Membership
+	var decidingRule *common.Rule
+	decidingSpecificity := -1
 	for _, rule := range acp.Rules {
-		if rule.Resource == viewAddressString || (validPatternString(rule.Resource) && isPatternAndAddressMatch(rule.Resource, viewAddressString)) {
-			// TODO: Check if these will be the same format (Or convert to matching formats at some point)
-			// TODO: Need to use principalType and perform different validation for type "certificate" and "ca".
-			// Code below assumes that requestor's membership has already been authenticated earlier if the type is "ca"
-			if (rule.PrincipalType == "certificate" && query.Certificate == rule.Principal) {
-				// Break loop as cert is valid.
-				log.Infof("Access Control Policy PERMITS the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate)
-				return nil
+		specificity := getResourceSpecificity(rule.Resource, viewAddressString)
+		if specificity < 0 {
+			continue
+		}
+		if decidingRule != nil && (specificity < decidingSpecificity || (specificity == decidingSpecificity && (isDenyRule(decidingRule) || !isDenyRule(rule)))) {
+			// The rule cannot take precedence over the deciding rule found so far
+			continue
+		}
+		if (rule.PrincipalType == "ca" || rule.Principal == anyPrincipal) && requesterCert != nil && membership == nil {
+			membershipString, err := s.GetMembershipBySecurityDomain(ctx, query.RequestingNetwork)
+			if err != nil {
+				return nil, logThenErrorf("Unable to look up membership for network %s: %s", query.RequestingNetwork, err.Error())
 			}
-			if (rule.PrincipalType == "ca" && query.RequestingOrg == rule.Principal) {
-				// Break loop as cert is valid.
-				log.Infof("Access Control Policy PERMITS the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.RequestingOrg)
-				return nil
+			membership, err = decodeMembership([]byte(membershipString))
+			if err != nil {
+				return nil, logThenErrorf("Failed to unmarshal membership: %s", err.Error())
 			}
 		}
+		if isRulePrincipalMatch(rule, query.Certificate, requesterCert, membership) {
+			decidingRule = rule
+			decidingSpecificity = specificity
+		}
+	}
 
+	if decidingRule == nil {
+		return &AccessCheckResult{Permitted: false, Reason: "no rule matches the request"}, nil
 	}
-	var errorMessage string
-	if (query.Certificate != "") {
-        errorMessage = fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate)
-	} else if (query.RequestingOrg != "") {
-        errorMessage = fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.RequestingOrg)
-	} else {
-		errorMessage = fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from a foreign entity", viewAddressString)
+	if isDenyRule(decidingRule) {
+		return &AccessCheckResult{Permitted: false, Rule: decidingRule, Reason: fmt.Sprintf("denied by rule for resource '%s'", decidingRule.Resource)}, nil
 	}
-	log.Error(errorMessage)
-	return errors.New(errorMessage)
+	return &AccessCheckResult{Permitted: true, Rule: decidingRule, Reason: fmt.Sprintf("permitted by rule for resource '%s'", decidingRule.Resource)}, nil
+}
 
+// getResourceSpecificity returns how specifically a rule resource matches a view address, or -1 if it does not
+// match: a pattern ranks by the length of its prefix, and an exact resource above any pattern matching the address.
+func getResourceSpecificity(resource string, viewAddressString string) int {
+	if resource == viewAddressString {
+		return len(resource) + 1
+	}
+	if validPatternString(resource) && isPatternAndAddressMatch(resource, viewAddressString) {
+		return len(strings.TrimSuffix(resource, "*"))
+	}
+	return -1
+}
+
+// isDenyRule checks whether a rule denies access, which rules not granting read access do as well
+func isDenyRule(rule *common.Rule) bool {
+	return rule.Deny || !rule.Read
+}
+
+// isRulePrincipalMatch checks whether the requester is a principal of the rule: "certificate" principals identify
+// the requester's certificate, "ca" principals are members of the requesting network (in the given Membership)
+// whose CA chain issued the requester's certificate, and the "*" principal matches any member of that network.
+func isRulePrincipalMatch(rule *common.Rule, certPEM string, cert *x509.Certificate, membership *common.Membership) bool {
+	if cert == nil {
+		return false
+	}
+	if rule.Principal == anyPrincipal {
+		if membership == nil {
+			return false
+		}
+		for memberId := range membership.Members {
+			if verifyMemberInSecurityDomain2(certPEM, cert, membership, memberId) == nil {
+				return true
+			}
+		}
+		return false
+	}
+	switch rule.PrincipalType {
+	case "certificate":
+		return isCertificatePrincipalMatch(rule.Principal, cert)
+	case "ca":
+		return membership != nil && verifyMemberInSecurityDomain2(certPEM, cert, membership, rule.Principal) == nil
+	default:
+		return false
+	}
+}
+
+// isCertificatePrincipalMatch checks whether a "certificate" principal identifies the holder of the given certificate.
+// The principal may be the PEM certificate itself, or the certificate's issuer and subject distinguished names
+// joined by '|'; neither a subject nor a public key alone identifies a certificate, as any CA can reuse them.
+func isCertificatePrincipalMatch(principal string, cert *x509.Certificate) bool {
+	if principalCert, err := parseCert(principal); err == nil {
+		return bytes.Equal(principalCert.Raw, cert.Raw)
+	}
+	issuer, subject, found := strings.Cut(principal, certificatePrincipalSeparator)
+	return found && issuer == cert.Issuer.String() && subject == cert.Subject.String()
 }
@@ -127,7 +127,13 @@ func TestVerifyAccessToCC(t *testing.T) {
 	ctx, chaincodeStub := wtest.PrepMockStub()
 	interopcc := SmartContract{}
 
-	// data for tests
+	// data for tests: a requester certificate issued by the CA of member 'Org1MSP' of the requesting network
+	certChain, _, err := generateCertChain(2)
+	require.NoError(t, err)
+	otherCertChain, _, err := generateCertChain(2)
+	require.NoError(t, err)
+	requesterCert, err := parseCert(certChain[1])
+	require.NoError(t, err)
 	validAddressStruct := FabricViewAddress{
 		Channel:  "mychannel",
 		Contract: "interop",
@@ -140,114 +146,233 @@ func TestVerifyAccessToCC(t *testing.T) {
 		Address:            "localhost:9080/network1/mychannel:interop:Read:a",
 		RequestingRelay:    "network1-relay",
 		RequestingNetwork:  "network1",
-		Certificate:        "cert",
+		Certificate:        certChain[1],
 		RequestorSignature: "sig",
 		Nonce:              "",
 		RequestId:          "1234",
 		RequestingOrg:      "Org1MSP",
 	}
+	membership := common.Membership{
+		SecurityDomain: "network1",
+		Members: map[string]*common.Member{
+			"Org1MSP": {Value: certChain[0], Type: "ca"},
+			"Org2MSP": {Value: otherCertChain[0], Type: "ca"},
+		},
+	}
+	membershipBytes, err := json.Marshal(&membership)
+	require.NoError(t, err)
 
 	var rule = common.Rule{
-		Principal:     "cert",
+		Principal:     certChain[1],
 		PrincipalType: "certificate",
 		Resource:      "mychannel:interop:Read:a",
 		Read:          true,
 	}
-	var ruleArray = []*common.Rule{&rule}
 	var accessControlAsset = common.AccessControlPolicy{
 		SecurityDomain: "network1",
-		Rules:          ruleArray,
+		Rules:          []*common.Rule{&rule},
 	}
-	accessControlBytes, err := json.Marshal(&accessControlAsset)
-	require.NoError(t, err)
+	// the ledger holds the access control policy and the membership of the requesting network
+	var accessControlBytes []byte
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + attributes[0], nil
+	})
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		if key == membershipObjectType + "network1" {
+			return membershipBytes, nil
+		}
+		return accessControlBytes, nil
+	})
+	// checks the request against a policy with the given rules
+	setPolicyRules := func(rules ...*common.Rule) {
+		accessControlAsset.Rules = rules
+		accessControlBytes, err = json.Marshal(&accessControlAsset)
+		require.NoError(t, err)
+	}
+	verifyAccessWithRules := func(rules ...*common.Rule) error {
+		setPolicyRules(rules...)
+		return verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
+	}
+	deniedError := fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate)
 
 	// Test: Happy case
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
+	err = verifyAccessWithRules(&rule)
 	require.NoError(t, err)
-	newRule := common.Rule{
-		Principal:     "cert",
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     certChain[1],
 		PrincipalType: "certificate",
 		Resource:      "mychannel:interop:Read:*",
 		Read:          true,
-	}
-	accessControlAsset.Rules = []*common.Rule{&newRule}
-	accessControlBytes, err = json.Marshal(&accessControlAsset)
+	})
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
+
+	// Test: certificate principals match the issuer and the subject of the requester's certificate together
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     requesterCert.Issuer.String() + "|" + requesterCert.Subject.String(),
+		PrincipalType: "certificate",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
 	require.NoError(t, err)
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     requesterCert.Subject.String(),
+		PrincipalType: "certificate",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
+	require.EqualError(t, err, deniedError)
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     "CN=someca|" + requesterCert.Subject.String(),
+		PrincipalType: "certificate",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
+	require.EqualError(t, err, deniedError)
 
-	newRule = common.Rule{
+	// Test: ca principals match members whose CA issued the requester's certificate
+	err = verifyAccessWithRules(&common.Rule{
 		Principal:     "Org1MSP",
 		PrincipalType: "ca",
 		Resource:      "mychannel:interop:Read:*",
 		Read:          true,
+	})
+	require.NoError(t, err)
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     "Org2MSP",
+		PrincipalType: "ca",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
+	require.EqualError(t, err, deniedError)
+
+	// Test: the wildcard principal matches any member of the requesting network
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     "*",
+		PrincipalType: "*",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
+	require.NoError(t, err)
+	membership.Members = map[string]*common.Member{
+		"Org2MSP": {Value: otherCertChain[0], Type: "ca"},
 	}
-	accessControlAsset.Rules = []*common.Rule{&newRule}
-	accessControlBytes, err = json.Marshal(&accessControlAsset)
+	membershipBytes, err = json.Marshal(&membership)
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     "*",
+		PrincipalType: "*",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
+	require.EqualError(t, err, deniedError)
+	membership.Members["Org1MSP"] = &common.Member{Value: certChain[0], Type: "ca"}
+	membershipBytes, err = json.Marshal(&membership)
+	require.NoError(t, err)
+
+	// Test: rules not granting read access deny it
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     "Org1MSP",
+		PrincipalType: "ca",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          false,
+	})
+	require.EqualError(t, err, deniedError)
+	err = verifyAccessWithRules(
+		&common.Rule{Principal: "Org1MSP", PrincipalType: "ca", Resource: "mychannel:interop:*", Read: true},
+		&common.Rule{Principal: "Org1MSP", PrincipalType: "ca", Resource: "mychannel:interop:Read:*", Read: false},
+	)
+	require.EqualError(t, err, deniedError)
+	err = verifyAccessWithRules(
+		&common.Rule{Principal: "Org1MSP", PrincipalType: "ca", Resource: "mychannel:interop:Read:*", Read: true},
+		&common.Rule{Principal: certChain[1], PrincipalType: "certificate", Resource: "mychannel:interop:Read:*", Read: false},
+	)
+	require.EqualError(t, err, deniedError)
+
+	// Test: deny rules take precedence over equally specific allow rules
+	allowOrg1Rule := &common.Rule{Principal: "Org1MSP", PrincipalType: "ca", Resource: "mychannel:interop:*", Read: true}
+	denyCertRule := &common.Rule{Principal: certChain[1], PrincipalType: "certificate", Resource: "mychannel:interop:*", Deny: true}
+	err = verifyAccessWithRules(allowOrg1Rule, denyCertRule)
+	require.EqualError(t, err, deniedError)
+	err = verifyAccessWithRules(denyCertRule, allowOrg1Rule)
+	require.EqualError(t, err, deniedError)
+
+	// Test: deny rules apply only to matching principals
+	denyOtherCertRule := &common.Rule{Principal: otherCertChain[1], PrincipalType: "certificate", Resource: "mychannel:interop:*", Deny: true}
+	err = verifyAccessWithRules(allowOrg1Rule, denyOtherCertRule)
+	require.NoError(t, err)
+
+	// Test: more specific rules take precedence over less specific ones
+	denyFuncRule := &common.Rule{Principal: "*", PrincipalType: "*", Resource: "mychannel:interop:Read:*", Deny: true}
+	err = verifyAccessWithRules(allowOrg1Rule, denyFuncRule)
+	require.EqualError(t, err, deniedError)
+	allowExactRule := &common.Rule{Principal: "Org1MSP", PrincipalType: "ca", Resource: viewAddressString, Read: true}
+	err = verifyAccessWithRules(denyFuncRule, allowExactRule)
+	require.NoError(t, err)
+
+	// Test: dry runs report the deciding rule
+	setPolicyRules(allowOrg1Rule, denyFuncRule)
+	resultJSON, err := interopcc.DryRunAccessCheck(ctx, "network1", viewAddressString, certChain[1])
+	require.NoError(t, err)
+	result := AccessCheckResult{}
+	require.NoError(t, json.Unmarshal([]byte(resultJSON), &result))
+	require.False(t, result.Permitted)
+	require.Equal(t, denyFuncRule.Resource, result.Rule.Resource)
+	require.True(t, result.Rule.Deny)
+	resultJSON, err = interopcc.DryRunAccessCheck(ctx, "network1", "mychannel:interop:Write:a", certChain[1])
+	require.NoError(t, err)
+	result = AccessCheckResult{}
+	require.NoError(t, json.Unmarshal([]byte(resultJSON), &result))
+	require.True(t, result.Permitted)
+	require.Equal(t, allowOrg1Rule.Resource, result.Rule.Resource)
+	resultJSON, err = interopcc.DryRunAccessCheck(ctx, "network1", "otherchannel:interop:Read:a", certChain[1])
 	require.NoError(t, err)
+	require.Equal(t, `{"permitted":false,"reason":"no rule matches the request"}`, resultJSON)
+	_, err = interopcc.DryRunAccessCheck(ctx, "network1", "mychannel", certChain[1])
+	require.Error(t, err)
 
 	// Test: Invalid Cert
-	invalidPrincipalRule := common.Rule{
+	err = verifyAccessWithRules(&common.Rule{
 		Principal:     "asdfasdf",
 		PrincipalType: "certificate",
 		Resource:      "mychannel:interop:Read:*",
 		Read:          true,
-	}
-	accessControlAsset.Rules = []*common.Rule{&invalidPrincipalRule}
-	accessControlBytes, err = json.Marshal(&accessControlAsset)
-	require.NoError(t, err)
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
-	require.EqualError(t, err, fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate))
+	})
+	require.EqualError(t, err, deniedError)
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     otherCertChain[1],
+		PrincipalType: "certificate",
+		Resource:      "mychannel:interop:Read:*",
+		Read:          true,
+	})
+	require.EqualError(t, err, deniedError)
 
 	// Test: Invalid CA
-	invalidPrincipalRule = common.Rule{
+	err = verifyAccessWithRules(&common.Rule{
 		Principal:     "asdfasdf",
 		PrincipalType: "ca",
 		Resource:      "mychannel:interop:Read:*",
 		Read:          true,
-	}
-	accessControlAsset.Rules = []*common.Rule{&invalidPrincipalRule}
-	accessControlBytes, err = json.Marshal(&accessControlAsset)
-	require.NoError(t, err)
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
-	require.EqualError(t, err, fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate))
+	})
+	require.EqualError(t, err, deniedError)
 
 	// Test: No rule for requested resource
-	differentResourceRule := common.Rule{
+	err = verifyAccessWithRules(&common.Rule{
 		Principal:     "Org1MSP",
 		PrincipalType: "ca",
 		Resource:      "mychannel:interop:ReadMe:*",
 		Read:          true,
-	}
-	accessControlAsset.Rules = []*common.Rule{&differentResourceRule}
-	accessControlBytes, err = json.Marshal(&accessControlAsset)
-	require.NoError(t, err)
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
-	require.EqualError(t, err, fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate))
-
-	differentResourceRule = common.Rule{
-		Principal:     "cert",
+	})
+	require.EqualError(t, err, deniedError)
+	err = verifyAccessWithRules(&common.Rule{
+		Principal:     certChain[1],
 		PrincipalType: "certificate",
 		Resource:      "mychannel:interop:ReadMe:*",
 		Read:          true,
-	}
-	accessControlAsset.Rules = []*common.Rule{&differentResourceRule}
-	accessControlBytes, err = json.Marshal(&accessControlAsset)
-	require.NoError(t, err)
-	chaincodeStub.GetStateReturns(accessControlBytes, nil)
-	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
-	require.EqualError(t, err, fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request '%s' from '%s:%s'", viewAddressString, query.RequestingNetwork, query.Certificate))
+	})
+	require.EqualError(t, err, deniedError)
 
 	// Test: No Rule for ID
-	chaincodeStub.GetStateReturns(nil, nil)
+	accessControlBytes = nil
 	err = verifyAccessToCC(&interopcc, ctx, &validAddressStruct, viewAddressString, &query)
 	require.EqualError(t, err, fmt.Sprintf("Access control policy does not exist for network: %s", query.RequestingNetwork))
 }
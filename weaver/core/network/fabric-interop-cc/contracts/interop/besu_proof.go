@@ -0,0 +1,320 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// besu_proof contains the verifier for proofs returned by Besu (Ethereum) networks.
+// A Besu view carries a block header signed by the network's validators (IBFT 2.0/QBFT commit seals),
+// a Merkle-Patricia proof of a transaction receipt against the header's receiptsRoot, and the index of
+// the log in that receipt which carries the interop payload.
+// Header hashing, signature recovery, RLP and trie proofs are delegated to go-ethereum.
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
+)
+
+// Membership type of a Besu validator, whose value is the validator's 20-byte address in hex.
+const besuValidatorMemberType = "address"
+
+// Signature of the event that carries the interop payload of a view; only a log of this event, emitted by the
+// contract named in the view address, is accepted as the source of the payload.
+const besuInteropPayloadEventSignature = "InteropPayload(bytes)"
+
+var besuInteropPayloadEventTopic = crypto.Keccak256Hash([]byte(besuInteropPayloadEventSignature))
+
+// parseBesuViewContract returns the address of the contract named in the view segment of a Besu view address,
+// formatted as <network-id>:<contract-address>:<function>[:<arguments>].
+func parseBesuViewContract(viewAddress string) (ethcommon.Address, error) {
+	besuArgs := strings.Split(viewAddress, ":")
+	if len(besuArgs) < 3 {
+		return ethcommon.Address{}, fmt.Errorf("View segment not formatted correctly %s", viewAddress)
+	}
+	contract, err := parseFixedHexData(besuArgs[1], ethcommon.AddressLength)
+	if err != nil {
+		return ethcommon.Address{}, fmt.Errorf("Invalid contract address %s in view segment: %s", besuArgs[1], err.Error())
+	}
+	return ethcommon.BytesToAddress(contract), nil
+}
+
+// parseHexData decodes a '0x' prefixed hex string.
+func parseHexData(value string) ([]byte, error) {
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "0x"), "0X")
+	if len(value)%2 == 1 {
+		value = "0" + value
+	}
+	return hex.DecodeString(value)
+}
+
+// parseFixedHexData decodes a '0x' prefixed hex string that must be exactly 'size' bytes long.
+func parseFixedHexData(value string, size int) ([]byte, error) {
+	data, err := parseHexData(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != size {
+		return nil, fmt.Errorf("expected %d bytes, got %d", size, len(data))
+	}
+	return data, nil
+}
+
+// parseQuantity decodes a '0x' prefixed hex (or plain decimal) quantity into its minimal big-endian bytes.
+func parseQuantity(value string) ([]byte, error) {
+	quantity := new(big.Int)
+	var ok bool
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		digits := value[2:]
+		if digits == "" {
+			digits = "0"
+		}
+		_, ok = quantity.SetString(digits, 16)
+	} else if value == "" {
+		ok = true
+	} else {
+		_, ok = quantity.SetString(value, 10)
+	}
+	if !ok || quantity.Sign() < 0 {
+		return nil, fmt.Errorf("Invalid quantity: %s", value)
+	}
+	return quantity.Bytes(), nil
+}
+
+// besuHeaderFields decodes the JSON-RPC style fields of a block header into the go-ethereum header
+// structure. Fields introduced by later forks (London, Shanghai, Cancun) are left unset when empty.
+type besuHeaderFields struct {
+	header *types.Header
+	err    error
+}
+
+func (f *besuHeaderFields) hash(name, value string) ethcommon.Hash {
+	data := f.fixed(name, value, ethcommon.HashLength)
+	return ethcommon.BytesToHash(data)
+}
+
+func (f *besuHeaderFields) optionalHash(name, value string) *ethcommon.Hash {
+	if value == "" {
+		return nil
+	}
+	hash := f.hash(name, value)
+	return &hash
+}
+
+// fixed always returns 'size' bytes so that the result can be converted to a fixed-size array.
+func (f *besuHeaderFields) fixed(name, value string, size int) []byte {
+	if f.err != nil {
+		return make([]byte, size)
+	}
+	data, err := parseFixedHexData(value, size)
+	if err != nil {
+		f.err = fmt.Errorf("Invalid block header field %s: %s", name, err.Error())
+		return make([]byte, size)
+	}
+	return data
+}
+
+func (f *besuHeaderFields) bigInt(name, value string) *big.Int {
+	if f.err != nil {
+		return nil
+	}
+	data, err := parseQuantity(value)
+	if err != nil {
+		f.err = fmt.Errorf("Invalid block header field %s: %s", name, err.Error())
+		return nil
+	}
+	return new(big.Int).SetBytes(data)
+}
+
+func (f *besuHeaderFields) uint64(name, value string) uint64 {
+	quantity := f.bigInt(name, value)
+	if f.err != nil {
+		return 0
+	}
+	if !quantity.IsUint64() {
+		f.err = fmt.Errorf("Invalid block header field %s: %s overflows uint64", name, value)
+		return 0
+	}
+	return quantity.Uint64()
+}
+
+func (f *besuHeaderFields) optionalBigInt(name, value string) *big.Int {
+	if value == "" {
+		return nil
+	}
+	return f.bigInt(name, value)
+}
+
+func (f *besuHeaderFields) optionalUint64(name, value string) *uint64 {
+	if value == "" {
+		return nil
+	}
+	quantity := f.uint64(name, value)
+	return &quantity
+}
+
+// besuHeaderHash computes the hash that validators sign over the header. The header's extraData is
+// expected to be the one committed to by the validators' seals, i.e. with the commit seals removed.
+func besuHeaderHash(header *besu.BlockHeader) ([]byte, error) {
+	if header == nil {
+		return nil, fmt.Errorf("Block header is missing")
+	}
+	f := &besuHeaderFields{}
+	ethHeader := &types.Header{
+		ParentHash:       f.hash("parentHash", header.ParentHash),
+		UncleHash:        f.hash("sha3Uncles", header.Sha3Uncles),
+		Coinbase:         ethcommon.BytesToAddress(f.fixed("miner", header.Miner, ethcommon.AddressLength)),
+		Root:             f.hash("stateRoot", header.StateRoot),
+		TxHash:           f.hash("transactionsRoot", header.TransactionsRoot),
+		ReceiptHash:      f.hash("receiptsRoot", header.ReceiptsRoot),
+		Bloom:            types.BytesToBloom(f.fixed("logsBloom", header.LogsBloom, types.BloomByteLength)),
+		Difficulty:       f.bigInt("difficulty", header.Difficulty),
+		Number:           f.bigInt("number", header.Number),
+		GasLimit:         f.uint64("gasLimit", header.GasLimit),
+		GasUsed:          f.uint64("gasUsed", header.GasUsed),
+		Time:             f.uint64("timestamp", header.Timestamp),
+		MixDigest:        f.hash("mixHash", header.MixHash),
+		Nonce:            types.BlockNonce(f.fixed("nonce", header.Nonce, 8)),
+		BaseFee:          f.optionalBigInt("baseFeePerGas", header.BaseFeePerGas),
+		WithdrawalsHash:  f.optionalHash("withdrawalsRoot", header.WithdrawalsRoot),
+		BlobGasUsed:      f.optionalUint64("blobGasUsed", header.BlobGasUsed),
+		ExcessBlobGas:    f.optionalUint64("excessBlobGas", header.ExcessBlobGas),
+		ParentBeaconRoot: f.optionalHash("parentBeaconBlockRoot", header.ParentBeaconBlockRoot),
+	}
+	extra, err := parseHexData(header.ExtraData)
+	if err != nil && f.err == nil {
+		f.err = fmt.Errorf("Invalid block header field extraData: %s", err.Error())
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	ethHeader.Extra = extra
+	return ethHeader.Hash().Bytes(), nil
+}
+
+// recoverSecp256k1Address recovers the Ethereum address (lower-case hex with '0x' prefix) that
+// produced a 65-byte [R || S || V] secp256k1 signature over the given hash.
+func recoverSecp256k1Address(hash []byte, signature []byte) (string, error) {
+	if len(signature) != crypto.SignatureLength {
+		return "", fmt.Errorf("Invalid signature length %d", len(signature))
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(crypto.PubkeyToAddress(*pubKey).Hex()), nil
+}
+
+// verifyBesuProof verifies a Besu view against a set of validators, given as a map from lower-case
+// hex address (with '0x' prefix) to member ID. It checks that
+// 1. every signature over the block header is from a distinct known validator,
+// 2. the receipt proof is valid against the header's receiptsRoot, and
+// 3. the log at the given index in the receipt is an 'InteropPayload' event emitted by the given contract, and
+// carries the view's interop payload.
+// It returns the member IDs of the validators that signed the header.
+func verifyBesuProof(besuView *besu.BesuView, validators map[string]string, contract ethcommon.Address) ([]string, error) {
+	// 1. Check the validator signatures over the header
+	headerHash, err := besuHeaderHash(besuView.BlockHeader)
+	if err != nil {
+		return nil, err
+	}
+	signerList := []string{}
+	for _, signature := range besuView.ValidatorSignatures {
+		signerAddress, err := recoverSecp256k1Address(headerHash, signature)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to recover validator from signature: %s", err.Error())
+		}
+		memberId, ok := validators[signerAddress]
+		if !ok {
+			return nil, fmt.Errorf("Block header signed by unknown validator: %s", signerAddress)
+		}
+		if Contains(signerList, memberId) {
+			return nil, fmt.Errorf("Duplicate signature from validator: %s", memberId)
+		}
+		signerList = append(signerList, memberId)
+	}
+
+	// 2. Check the receipt proof against the receiptsRoot
+	receiptsRoot, err := parseFixedHexData(besuView.BlockHeader.ReceiptsRoot, ethcommon.HashLength)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid block header field receiptsRoot: %s", err.Error())
+	}
+	var proof [][]byte
+	if err := rlp.DecodeBytes(besuView.MerkleProof, &proof); err != nil {
+		return nil, fmt.Errorf("Merkle proof must be an RLP list of trie nodes")
+	}
+	proofDb := memorydb.New()
+	for _, node := range proof {
+		if err := proofDb.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	receiptKey, err := rlp.EncodeToBytes(uint64(besuView.ReceiptIndex))
+	if err != nil {
+		return nil, err
+	}
+	receiptBytes, err := trie.VerifyProof(ethcommon.BytesToHash(receiptsRoot), receiptKey, proofDb)
+	if err != nil {
+		return nil, fmt.Errorf("Receipt proof verification failed: %s", err.Error())
+	}
+	if receiptBytes == nil {
+		return nil, fmt.Errorf("Receipt proof verification failed: receipt %d not present in trie", besuView.ReceiptIndex)
+	}
+
+	// 3. Check that the log is an interop payload event of the contract, and carries the interop payload
+	receiptLog, err := besuReceiptLog(receiptBytes, besuView.LogIndex)
+	if err != nil {
+		return nil, err
+	}
+	if receiptLog.Address != contract {
+		return nil, fmt.Errorf("Receipt log emitted by %s; expected contract %s", strings.ToLower(receiptLog.Address.Hex()), strings.ToLower(contract.Hex()))
+	}
+	if len(receiptLog.Topics) == 0 || receiptLog.Topics[0] != besuInteropPayloadEventTopic {
+		return nil, fmt.Errorf("Receipt log is not an %s event", besuInteropPayloadEventSignature)
+	}
+	if !bytes.Equal(receiptLog.Data, besuView.InteropPayload) && !bytes.Equal(abiDecodeBytes(receiptLog.Data), besuView.InteropPayload) {
+		return nil, fmt.Errorf("Interop payload does not match receipt log data")
+	}
+	return signerList, nil
+}
+
+// besuReceiptLog extracts a log from the consensus encoding of an (optionally EIP-2718 typed) receipt.
+func besuReceiptLog(receiptBytes []byte, logIndex uint32) (*types.Log, error) {
+	var receipt types.Receipt
+	if err := receipt.UnmarshalBinary(receiptBytes); err != nil {
+		return nil, fmt.Errorf("Unable to decode receipt: %s", err.Error())
+	}
+	if int(logIndex) >= len(receipt.Logs) {
+		return nil, fmt.Errorf("Log index %d out of range; receipt has %d logs", logIndex, len(receipt.Logs))
+	}
+	return receipt.Logs[logIndex], nil
+}
+
+// abiDecodeBytes decodes log data that is the ABI encoding of a single 'bytes' value,
+// returning nil if the data is not in that form.
+func abiDecodeBytes(data []byte) []byte {
+	if len(data) < 64 || new(big.Int).SetBytes(data[:32]).Cmp(big.NewInt(32)) != 0 {
+		return nil
+	}
+	length := new(big.Int).SetBytes(data[32:64])
+	if !length.IsInt64() || length.Int64() > int64(len(data)-64) {
+		return nil
+	}
+	return data[64 : 64+length.Int64()]
+}
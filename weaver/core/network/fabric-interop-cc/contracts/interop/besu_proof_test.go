@@ -0,0 +1,312 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
+	"github.com/stretchr/testify/require"
+)
+
+// Block 1 of Ethereum mainnet (pre-London header)
+var mainnetBlock1Header = &besu.BlockHeader{
+	ParentHash:       "0xd4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+	Sha3Uncles:       "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+	Miner:            "0x05a56e2d52c817161883f50c441c3228cfe54d9f",
+	StateRoot:        "0xd67e4d450343046425ae4271474353857ab860dbc0a1dde64b41b5cd3a532bf3",
+	TransactionsRoot: "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	ReceiptsRoot:     "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	LogsBloom:        "0x" + strings.Repeat("00", 256),
+	Difficulty:       "0x3ff800000",
+	Number:           "0x1",
+	GasLimit:         "0x1388",
+	GasUsed:          "0x0",
+	Timestamp:        "0x55ba4224",
+	ExtraData:        "0x476574682f76312e302e302f6c696e75782f676f312e342e32",
+	MixHash:          "0x969b900de27b6ac6a67742365dd65f55a0526c41fd18e1b16f1a1215c2e66f59",
+	Nonce:            "0x539bd4979fef1ec4",
+}
+
+// Post-Cancun header served by a geth node (go-ethereum ethtest 'headblock.json' fixture)
+func cancunHeader() *besu.BlockHeader {
+	return &besu.BlockHeader{
+		ParentHash:            "0x96a73007443980c5e0985dfbb45279aa496dadea16918ad42c65c0bf8122ec39",
+		Sha3Uncles:            "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+		Miner:                 "0x0000000000000000000000000000000000000000",
+		StateRoot:             "0xea4c1f4d9fa8664c22574c5b2f948a78c4b1a753cebc1861e7fb5b1aa21c5a94",
+		TransactionsRoot:      "0xecda39025fc4c609ce778d75eed0aa53b65ce1e3d1373b34bad8578cc31e5b48",
+		ReceiptsRoot:          "0x056b23fbba480696b65fe5a59b8f2148a1299103c4f57df839233af2cf4ca2d2",
+		LogsBloom:             "0x" + strings.Repeat("00", 256),
+		Difficulty:            "0x0",
+		Number:                "0x1f4",
+		GasLimit:              "0x47e7c40",
+		GasUsed:               "0x5208",
+		Timestamp:             "0x1388",
+		ExtraData:             "0x",
+		MixHash:               "0x0000000000000000000000000000000000000000000000000000000000000000",
+		Nonce:                 "0x0000000000000000",
+		BaseFeePerGas:         "0x7",
+		WithdrawalsRoot:       "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+		BlobGasUsed:           "0x0",
+		ExcessBlobGas:         "0x0",
+		ParentBeaconBlockRoot: "0xf653da50cdff4733f13f7a5e338290e883bdf04adf3f112709728063ea965d6c",
+	}
+}
+
+// besuTestKey derives a deterministic secp256k1 validator key from a seed.
+func besuTestKey(t *testing.T, seed int64) *ecdsa.PrivateKey {
+	key, err := crypto.ToECDSA(ethcommon.LeftPadBytes(big.NewInt(seed).Bytes(), 32))
+	require.NoError(t, err)
+	return key
+}
+
+func testAddress(key *ecdsa.PrivateKey) string {
+	return strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+}
+
+func TestBesuHeaderHash(t *testing.T) {
+	hash, err := besuHeaderHash(mainnetBlock1Header)
+	require.NoError(t, err)
+	require.Equal(t, "88e96d4537bea4d9c05d12549907b32561d3bf31f45aae734cdc119f13406cb6", hex.EncodeToString(hash))
+
+	hash, err = besuHeaderHash(cancunHeader())
+	require.NoError(t, err)
+	require.Equal(t, "36a166f0dcd160fc5e5c61c9a7c2d7f236d9175bf27f43aaa2150e291f092ef7", hex.EncodeToString(hash))
+
+	// Dropping a post-London field changes the hash
+	header := cancunHeader()
+	header.ParentBeaconBlockRoot = ""
+	hash, err = besuHeaderHash(header)
+	require.NoError(t, err)
+	require.NotEqual(t, "36a166f0dcd160fc5e5c61c9a7c2d7f236d9175bf27f43aaa2150e291f092ef7", hex.EncodeToString(hash))
+
+	header = cancunHeader()
+	header.StateRoot = "0x1234"
+	_, err = besuHeaderHash(header)
+	require.EqualError(t, err, "Invalid block header field stateRoot: expected 32 bytes, got 2")
+	_, err = besuHeaderHash(nil)
+	require.EqualError(t, err, "Block header is missing")
+}
+
+func TestRecoverSecp256k1Address(t *testing.T) {
+	// Well-known address for the private key 1
+	require.Equal(t, "0x7e5f4552091a69125d5dfcb7b8c2659029395bdf", testAddress(besuTestKey(t, 1)))
+
+	key := besuTestKey(t, 0x1234567890)
+	hash := crypto.Keccak256([]byte("header"))
+	signature, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	address, err := recoverSecp256k1Address(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, testAddress(key), address)
+
+	// Ethereum style recovery id (27/28)
+	signature[64] += 27
+	address, err = recoverSecp256k1Address(hash, signature)
+	require.NoError(t, err)
+	require.Equal(t, testAddress(key), address)
+
+	// Signature over a different hash recovers a different address
+	address, err = recoverSecp256k1Address(crypto.Keccak256([]byte("other")), signature)
+	require.NoError(t, err)
+	require.NotEqual(t, testAddress(key), address)
+
+	_, err = recoverSecp256k1Address(hash, signature[:64])
+	require.EqualError(t, err, "Invalid signature length 64")
+	signature[64] = 5
+	_, err = recoverSecp256k1Address(hash, signature)
+	require.Error(t, err)
+}
+
+// Contract named in the test view addresses, which emits the interop payload events
+var besuTestContract = ethcommon.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+
+// besuTestReceipt builds a typed (EIP-1559) receipt with two logs of the contract, the second carrying the payload
+// ABI-encoded as a single 'bytes' value.
+func besuTestReceipt(payload []byte) *types.Receipt {
+	return besuTestReceiptOfEvent(besuTestContract, besuInteropPayloadEventTopic, payload)
+}
+
+// besuTestReceiptOfEvent builds a receipt like besuTestReceipt, whose logs are emitted by the given address with
+// the given event topic.
+func besuTestReceiptOfEvent(address ethcommon.Address, topic ethcommon.Hash, payload []byte) *types.Receipt {
+	abiPayload := make([]byte, 64+(len(payload)+31)/32*32)
+	abiPayload[31] = 32
+	big.NewInt(int64(len(payload))).FillBytes(abiPayload[32:64])
+	copy(abiPayload[64:], payload)
+	topics := []ethcommon.Hash{topic}
+	receipt := &types.Receipt{
+		Type:              types.DynamicFeeTxType,
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs: []*types.Log{
+			{Address: address, Topics: topics, Data: []byte("unrelated")},
+			{Address: address, Topics: topics, Data: abiPayload},
+		},
+	}
+	receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+	return receipt
+}
+
+// besuTestReceiptProof returns the receipts root of a block holding the given receipts and the
+// RLP encoded proof of the receipt at the given index.
+func besuTestReceiptProof(t *testing.T, receipts types.Receipts, index uint64) (ethcommon.Hash, []byte) {
+	receiptTrie := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	for i := range receipts {
+		key, err := rlp.EncodeToBytes(uint64(i))
+		require.NoError(t, err)
+		var value bytes.Buffer
+		receipts.EncodeIndex(i, &value)
+		require.NoError(t, receiptTrie.Update(key, value.Bytes()))
+	}
+	// the trie must agree with the receipts root go-ethereum derives for a block
+	root := types.DeriveSha(receipts, trie.NewStackTrie(nil))
+	require.Equal(t, root, receiptTrie.Hash())
+
+	key, err := rlp.EncodeToBytes(index)
+	require.NoError(t, err)
+	proofDb := memorydb.New()
+	require.NoError(t, receiptTrie.Prove(key, proofDb))
+	proof := [][]byte{}
+	it := proofDb.NewIterator(nil, nil)
+	for it.Next() {
+		proof = append(proof, ethcommon.CopyBytes(it.Value()))
+	}
+	it.Release()
+	encodedProof, err := rlp.EncodeToBytes(proof)
+	require.NoError(t, err)
+	return root, encodedProof
+}
+
+func besuTestView(t *testing.T, payload []byte, signers []*ecdsa.PrivateKey) *besu.BesuView {
+	return besuTestViewOfReceipt(t, besuTestReceipt(payload), payload, signers)
+}
+
+// besuTestViewOfReceipt builds a view whose proof is of the given receipt, at index 3 of a block's receipts
+func besuTestViewOfReceipt(t *testing.T, receipt *types.Receipt, payload []byte, signers []*ecdsa.PrivateKey) *besu.BesuView {
+	receipts := types.Receipts{}
+	for i := 0; i < 20; i++ {
+		receipts = append(receipts, besuTestReceipt([]byte{byte(i)}))
+	}
+	receipts[3] = receipt
+	receiptsRoot, proof := besuTestReceiptProof(t, receipts, 3)
+
+	header := cancunHeader()
+	header.ReceiptsRoot = receiptsRoot.Hex()
+	headerHash, err := besuHeaderHash(header)
+	require.NoError(t, err)
+	signatures := [][]byte{}
+	for _, signer := range signers {
+		signature, err := crypto.Sign(headerHash, signer)
+		require.NoError(t, err)
+		signatures = append(signatures, signature)
+	}
+	return &besu.BesuView{
+		InteropPayload:      payload,
+		BlockHeader:         header,
+		MerkleProof:         proof,
+		ReceiptIndex:        3,
+		LogIndex:            1,
+		ValidatorSignatures: signatures,
+	}
+}
+
+func TestVerifyBesuProof(t *testing.T) {
+	validatorKeys := []*ecdsa.PrivateKey{besuTestKey(t, 101), besuTestKey(t, 102), besuTestKey(t, 103)}
+	validators := map[string]string{}
+	for i, key := range validatorKeys {
+		validators[testAddress(key)] = []string{"validator1", "validator2", "validator3"}[i]
+	}
+	payload := []byte("interop payload bytes")
+
+	// Happy path
+	besuView := besuTestView(t, payload, validatorKeys[:2])
+	signers, err := verifyBesuProof(besuView, validators, besuTestContract)
+	require.NoError(t, err)
+	require.Equal(t, []string{"validator1", "validator2"}, signers)
+
+	// Unknown validator
+	unknownKey := besuTestKey(t, 999)
+	besuView = besuTestView(t, payload, []*ecdsa.PrivateKey{validatorKeys[0], unknownKey})
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Block header signed by unknown validator: "+testAddress(unknownKey))
+
+	// Duplicate signature
+	besuView = besuTestView(t, payload, []*ecdsa.PrivateKey{validatorKeys[0], validatorKeys[0]})
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Duplicate signature from validator: validator1")
+
+	// Tampered header invalidates the signatures
+	besuView = besuTestView(t, payload, validatorKeys)
+	besuView.BlockHeader.Number = "0x1f5"
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.Error(t, err)
+
+	// Log carrying the payload emitted by another contract, or by the contract but not as an interop payload event
+	otherContract := ethcommon.BytesToAddress(bytes.Repeat([]byte{0x11}, 20))
+	besuView = besuTestViewOfReceipt(t, besuTestReceiptOfEvent(otherContract, besuInteropPayloadEventTopic, payload), payload, validatorKeys)
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Receipt log emitted by 0x1111111111111111111111111111111111111111; expected contract 0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	besuView = besuTestViewOfReceipt(t, besuTestReceiptOfEvent(besuTestContract, crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), payload), payload, validatorKeys)
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Receipt log is not an InteropPayload(bytes) event")
+
+	// Payload that is not in the receipt
+	besuView = besuTestView(t, payload, validatorKeys)
+	besuView.InteropPayload = []byte("forged payload")
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Interop payload does not match receipt log data")
+
+	// Wrong receipt and log indices
+	besuView = besuTestView(t, payload, validatorKeys)
+	besuView.ReceiptIndex = 4
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.Error(t, err)
+	besuView.ReceiptIndex = 3
+	besuView.LogIndex = 2
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Log index 2 out of range; receipt has 2 logs")
+	besuView.LogIndex = 1
+	besuView.ReceiptIndex = 25
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.Error(t, err)
+
+	// Proof that does not match the receiptsRoot
+	besuView = besuTestView(t, payload, validatorKeys)
+	_, otherProof := besuTestReceiptProof(t, types.Receipts{besuTestReceipt(payload)}, 0)
+	besuView.MerkleProof = otherProof
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.Error(t, err)
+	besuView.MerkleProof = []byte("not rlp")
+	_, err = verifyBesuProof(besuView, validators, besuTestContract)
+	require.EqualError(t, err, "Merkle proof must be an RLP list of trie nodes")
+}
+
+func TestParseBesuViewContract(t *testing.T) {
+	contract, err := parseBesuViewContract("1337:0x5FbDB2315678afecb367f032d93F642f64180aa3:get(string):key")
+	require.NoError(t, err)
+	require.Equal(t, besuTestContract, contract)
+
+	_, err = parseBesuViewContract("1337:0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	require.EqualError(t, err, "View segment not formatted correctly 1337:0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	_, err = parseBesuViewContract("1337:0x5FbDB2:get(string)")
+	require.EqualError(t, err, "Invalid contract address 0x5FbDB2 in view segment: expected 20 bytes, got 3")
+}
@@ -8,9 +8,14 @@
 package main
 
 import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/hmac"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/sha512"
 	"crypto/x509"
@@ -20,10 +25,13 @@ import (
 	"errors"
 	"fmt"
 	"hash"
+	"io"
 	"math/big"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/hkdf"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
@@ -32,6 +40,8 @@ import (
 const (
 	rootCertsKey = "root_certs"
 	intCertsKey  = "intermediate_certs"
+	// Context bound to the keys used in the hybrid encryption of confidential payloads
+	hybridEncryptionInfo = "weaver-confidential-payload"
 )
 
 // ECDSASignature represents an ECDSA signature
@@ -112,7 +122,7 @@ func verifyCertificateChain(cert *x509.Certificate, certPEMs []string) error {
 				return errors.New(errMsg)
 			}
 			if i == len(certPEMs)-1 && cert != nil {
-				err := validateCertificateUsingCA(cert, caCert, i == 1)
+				err := validateCertificateUsingCA(cert, caCert, false)		// The root CA was checked with its first link
 				if err != nil {
 					return errors.New("Certificate link invalid for endorser")
 				}
@@ -255,6 +265,76 @@ func parseCert(certString string) (*x509.Certificate, error) {
 	return cert, err
 }
 
+// parseCRL parses a certificate revocation list in PEM format, or a base64 encoding of a DER or PEM CRL
+func parseCRL(crlString string) (*x509.RevocationList, error) {
+	crlBytes := []byte(crlString)
+	if decodedBytes, err := base64.StdEncoding.DecodeString(strings.TrimSpace(crlString)); err == nil {
+		crlBytes = decodedBytes
+	}
+	if crlBlock, _ := pem.Decode(crlBytes); crlBlock != nil {
+		crlBytes = crlBlock.Bytes
+	}
+	return x509.ParseRevocationList(crlBytes)
+}
+
+// verifyCertificateNotRevoked checks that neither the certificate nor any intermediate CA certificate among the given
+// CA certificates is listed in the CRLs issued by its issuer. Such CRLs must be signed by one of the given CA
+// certificates to be trusted. Their next update is not checked, as endorsers must agree on the result and a CRL past it
+// still lists every revocation it was issued with; keeping the recorded CRLs current is left to membership updates.
+func verifyCertificateNotRevoked(cert *x509.Certificate, crls []string, caCertPEMs []string) error {
+	if len(crls) == 0 {
+		return nil
+	}
+	caCerts := []*x509.Certificate{}
+	checkedCerts := []*x509.Certificate{cert}
+	for _, caCertPEM := range caCertPEMs {
+		caCert, err := parseCert(caCertPEM)
+		if err == nil {
+			caCerts = append(caCerts, caCert)
+			if !bytes.Equal(caCert.RawIssuer, caCert.RawSubject) {		// Root CAs cannot be revoked
+				checkedCerts = append(checkedCerts, caCert)
+			}
+		}
+	}
+	for _, crlString := range crls {
+		crl, err := parseCRL(crlString)
+		if err != nil {
+			return fmt.Errorf("Unable to parse CRL: %s", err.Error())
+		}
+		issuedCerts := []*x509.Certificate{}
+		for _, checkedCert := range checkedCerts {
+			if bytes.Equal(crl.RawIssuer, checkedCert.RawIssuer) {
+				issuedCerts = append(issuedCerts, checkedCert)
+			}
+		}
+		if len(issuedCerts) == 0 {
+			continue
+		}
+		isCRLSigned := false
+		for _, caCert := range caCerts {
+			if crl.CheckSignatureFrom(caCert) == nil {
+				isCRLSigned = true
+				break
+			}
+		}
+		if !isCRLSigned {
+			return fmt.Errorf("CRL issued by %s is not signed by a known CA", crl.Issuer.String())
+		}
+		for _, revokedCert := range crl.RevokedCertificates {
+			for _, issuedCert := range issuedCerts {
+				if revokedCert.SerialNumber.Cmp(issuedCert.SerialNumber) != 0 {
+					continue
+				}
+				if issuedCert == cert {
+					return fmt.Errorf("Certificate with serial number %s has been revoked", cert.SerialNumber.String())
+				}
+				return fmt.Errorf("CA certificate %s with serial number %s has been revoked", issuedCert.Subject.String(), issuedCert.SerialNumber.String())
+			}
+		}
+	}
+	return nil
+}
+
 func isCertificateWithinExpiry(cert *x509.Certificate) error {
 	if cert == nil {
 		return errors.New("Cert is nil")
@@ -268,14 +348,14 @@ func isCertificateWithinExpiry(cert *x509.Certificate) error {
 }
 
 func encryptWithCert(message []byte, cert *x509.Certificate) ([]byte, error) {
-	// Check if the public key in the cert is an ECDSA public key
-	pubKey := getECDSAPublicKeyFromCertificate(cert)
-	if pubKey != nil {
+	switch pubKey := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
 		return encryptWithECDSAPublicKey(message, pubKey)
-	} else if (cert.RawSubjectPublicKeyInfo != nil && len(cert.RawSubjectPublicKeyInfo) == 44) {	// ed25519 public key
-		// We expect the key to be 44 bytes, but only the last 32 bytes (multiple of 8) comprise the public key
-		return encryptWithEd25519PublicKey(message, cert.RawSubjectPublicKeyInfo[12:])
-	} else {
+	case ed25519.PublicKey:
+		return encryptWithEd25519PublicKey(message, pubKey)
+	case *rsa.PublicKey:
+		return encryptWithRSAPublicKey(message, pubKey)
+	default:
 		return []byte(""), errors.New("Missing or unsupported public key type for encryption")
 	}
 }
@@ -292,8 +372,109 @@ func encryptWithECDSAPublicKey(message []byte, pubKey *ecdsa.PublicKey) ([]byte,
 	return []byte(""), errors.New("Missing or invalid ECDSA public key")
 }
 
+// Hybrid encryption for an Ed25519 key: the message is sealed with AES-256-GCM under a key derived (using HKDF-SHA256)
+// from an X25519 exchange between an ephemeral key and the X25519 form of the Ed25519 key. The output is the ephemeral
+// public key (32 bytes), followed by the GCM nonce (12 bytes) and the ciphertext.
 func encryptWithEd25519PublicKey(message []byte, pubKey []byte) ([]byte, error) {
-	return []byte(""), nil
+	x25519PubKeyBytes, err := convertEd25519PublicKeyToX25519(pubKey)
+	if err != nil {
+		return []byte(""), err
+	}
+	x25519PubKey, err := ecdh.X25519().NewPublicKey(x25519PubKeyBytes)
+	if err != nil {
+		return []byte(""), err
+	}
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return []byte(""), err
+	}
+	sharedSecret, err := ephemeralKey.ECDH(x25519PubKey)
+	if err != nil {
+		return []byte(""), err
+	}
+	ephemeralPubKeyBytes := ephemeralKey.PublicKey().Bytes()
+	kdf := hkdf.New(sha256.New, sharedSecret, append(append([]byte{}, ephemeralPubKeyBytes...), x25519PubKeyBytes...), []byte(hybridEncryptionInfo))
+	key := make([]byte, 32)
+	_, err = io.ReadFull(kdf, key)
+	if err != nil {
+		return []byte(""), err
+	}
+	sealedMessage, err := sealWithAEAD(message, key)
+	if err != nil {
+		return []byte(""), err
+	}
+	return append(ephemeralPubKeyBytes, sealedMessage...), nil
+}
+
+// Hybrid encryption for an RSA key: the message is sealed with AES-256-GCM under a random key, which is wrapped using
+// RSA-OAEP (SHA-256). The output is the wrapped key (as long as the key's modulus), followed by the GCM nonce (12 bytes)
+// and the ciphertext.
+func encryptWithRSAPublicKey(message []byte, pubKey *rsa.PublicKey) ([]byte, error) {
+	key, err := generateSecureRandomKey(32)
+	if err != nil {
+		return []byte(""), err
+	}
+	wrappedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, pubKey, key, []byte(hybridEncryptionInfo))
+	if err != nil {
+		return []byte(""), err
+	}
+	sealedMessage, err := sealWithAEAD(message, key)
+	if err != nil {
+		return []byte(""), err
+	}
+	return append(wrappedKey, sealedMessage...), nil
+}
+
+// Converts an Ed25519 public key (a point on the Edwards curve) to the X25519 public key (the Montgomery u-coordinate)
+// of the same key pair, using u = (1 + y) / (1 - y) mod p
+func convertEd25519PublicKeyToX25519(pubKey []byte) ([]byte, error) {
+	if len(pubKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("Invalid Ed25519 public key length: %d", len(pubKey))
+	}
+	p := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	// The key encodes y in little-endian order, with the sign of x in the most significant bit
+	yBytes := make([]byte, len(pubKey))
+	for i := range pubKey {
+		yBytes[len(pubKey)-1-i] = pubKey[i]
+	}
+	yBytes[0] &= 0x7f
+	y := new(big.Int).SetBytes(yBytes)
+
+	denominator := new(big.Int).Sub(big.NewInt(1), y)
+	denominator.Mod(denominator, p)
+	if denominator.Sign() == 0 {
+		return nil, errors.New("Invalid Ed25519 public key")
+	}
+	u := new(big.Int).Add(big.NewInt(1), y)
+	u.Mul(u, new(big.Int).ModInverse(denominator, p))
+	u.Mod(u, p)
+
+	uBytes := u.FillBytes(make([]byte, 32))
+	for i, j := 0, len(uBytes)-1; i < j; i, j = i+1, j-1 {
+		uBytes[i], uBytes[j] = uBytes[j], uBytes[i]
+	}
+	return uBytes, nil
+}
+
+// Seals the message with AES-256-GCM under the given key and a random nonce, which precedes the ciphertext in the output
+func sealWithAEAD(message, key []byte) ([]byte, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := generateSecureRandomKey(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, message, nil), nil
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
 }
 
 func generateSecureRandomKey(length int) ([]byte, error) {
@@ -314,6 +495,21 @@ func generateHMAC(data, key []byte) ([]byte, error) {
 	return hmacHash.Sum(nil), nil
 }
 
+// Checks the hash (an HMAC keyed with the random key) in a confidential payload against the decrypted payload contents
+func verifyConfidentialPayloadHash(confidentialPayload *common.ConfidentialPayload, confidentialPayloadContents *common.ConfidentialPayloadContents) error {
+	if confidentialPayload.HashType != common.ConfidentialPayload_HMAC {
+		return fmt.Errorf("Unsupported hash type in interop view payload: %+v", confidentialPayload.HashType)
+	}
+	payloadHMAC, err := generateHMAC(confidentialPayloadContents.Payload, confidentialPayloadContents.Random)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(confidentialPayload.Hash, payloadHMAC) {
+		return fmt.Errorf("View payload hash does not match hash of data submitted by client")
+	}
+	return nil
+}
+
 func generateConfidentialInteropPayloadAndHash(message []byte, cert string) ([]byte, error) {
 	// Generate a 16-byte random key for the HMAC
 	hashKey, err := generateSecureRandomKey(16)
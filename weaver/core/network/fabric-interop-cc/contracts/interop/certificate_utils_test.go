@@ -9,6 +9,7 @@ package main
 import (
 	"bytes"
 	"crypto"
+	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
@@ -16,10 +17,12 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/sha256"
+	"crypto/sha512"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/big"
 	"testing"
@@ -29,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"golang.org/x/crypto/hkdf"
 )
 
 func TestVerifyCertificateChain(t *testing.T) {
@@ -245,6 +249,80 @@ func TestConfidentialInteropPayload(t *testing.T) {
 	require.Equal(t, confPayload.Hash, fmac)
 }
 
+func TestHybridEncryption(t *testing.T) {
+	message := []byte("random-message")
+
+	// Ed25519 key: X25519 key agreement and AES-256-GCM
+	ed25519PubKey, ed25519PrivKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519Cert := createSelfSignedCert(t, ed25519PubKey, ed25519PrivKey)
+	encBytes, err := encryptWithCert(message, ed25519Cert)
+	require.NoError(t, err)
+	decBytes, err := decryptWithEd25519PrivKey(ed25519PrivKey, encBytes)
+	require.NoError(t, err)
+	require.Equal(t, message, decBytes)
+	// Tampered ciphertexts are rejected
+	encBytes[len(encBytes)-1] ^= 1
+	_, err = decryptWithEd25519PrivKey(ed25519PrivKey, encBytes)
+	require.Error(t, err)
+
+	// RSA key: RSA-OAEP key wrap and AES-256-GCM
+	rsaPrivKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaCert := createSelfSignedCert(t, &rsaPrivKey.PublicKey, rsaPrivKey)
+	encBytes, err = encryptWithCert(message, rsaCert)
+	require.NoError(t, err)
+	decBytes, err = decryptWithRSAPrivKey(rsaPrivKey, encBytes)
+	require.NoError(t, err)
+	require.Equal(t, message, decBytes)
+}
+
+func TestConfidentialInteropPayloadEd25519(t *testing.T) {
+	ed25519PubKey, ed25519PrivKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: createSelfSignedCert(t, ed25519PubKey, ed25519PrivKey).Raw})
+
+	// Generate confidential view with random data
+	viewContents := []byte("random-message")
+	confBytes, err := generateConfidentialInteropPayloadAndHash(viewContents, string(certPEM))
+	require.NoError(t, err)
+	var confPayload common.ConfidentialPayload
+	err = proto.Unmarshal(confBytes, &confPayload)
+	require.NoError(t, err)
+	require.Equal(t, common.ConfidentialPayload_HMAC, confPayload.HashType)
+
+	// Decrypt and validate payload
+	decPayload, err := decryptWithEd25519PrivKey(ed25519PrivKey, confPayload.EncryptedPayload)
+	require.NoError(t, err)
+	var confPayloadContents common.ConfidentialPayloadContents
+	err = proto.Unmarshal(decPayload, &confPayloadContents)
+	require.NoError(t, err)
+	require.Equal(t, viewContents, confPayloadContents.Payload)
+
+	// Authenticate hash
+	require.NoError(t, verifyConfidentialPayloadHash(&confPayload, &confPayloadContents))
+	confPayloadContents.Payload = []byte("other-message")
+	err = verifyConfidentialPayloadHash(&confPayload, &confPayloadContents)
+	require.EqualError(t, err, "View payload hash does not match hash of data submitted by client")
+	confPayload.HashType = common.ConfidentialPayload_HashType(99)
+	err = verifyConfidentialPayloadHash(&confPayload, &confPayloadContents)
+	require.EqualError(t, err, "Unsupported hash type in interop view payload: 99")
+}
+
+func createSelfSignedCert(t *testing.T, pubKey crypto.PublicKey, privKey crypto.Signer) *x509.Certificate {
+	template := x509.Certificate{
+		Subject:      pkix.Name{CommonName: "example-a.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		SerialNumber: big.NewInt(1337),
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, &template, pubKey, privKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certBytes)
+	require.NoError(t, err)
+	return cert
+}
+
 func generateCertFromTemplate(template x509.Certificate, keyType string) ([]byte, error) {
 	random := rand.Reader
 	switch keyType {
@@ -350,3 +428,44 @@ func decryptDataWithPrivKey(privKeyECDSA *ecdsa.PrivateKey, data []byte) ([]byte
 	// Decrypt response and match
 	return privKey.Decrypt(data, nil, nil)
 }
+
+func decryptWithEd25519PrivKey(privKey ed25519.PrivateKey, data []byte) ([]byte, error) {
+	// The X25519 private key is the (clamped) scalar derived from the Ed25519 seed
+	digest := sha512.Sum512(privKey.Seed())
+	x25519PrivKey, err := ecdh.X25519().NewPrivateKey(digest[:32])
+	if err != nil {
+		return nil, err
+	}
+	ephemeralPubKey, err := ecdh.X25519().NewPublicKey(data[:32])
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := x25519PrivKey.ECDH(ephemeralPubKey)
+	if err != nil {
+		return nil, err
+	}
+	kdf := hkdf.New(sha256.New, sharedSecret, append(append([]byte{}, data[:32]...), x25519PrivKey.PublicKey().Bytes()...), []byte(hybridEncryptionInfo))
+	key := make([]byte, 32)
+	_, err = io.ReadFull(kdf, key)
+	if err != nil {
+		return nil, err
+	}
+	return openWithAEAD(data[32:], key)
+}
+
+func decryptWithRSAPrivKey(privKey *rsa.PrivateKey, data []byte) ([]byte, error) {
+	key, err := rsa.DecryptOAEP(sha256.New(), nil, privKey, data[:privKey.Size()], []byte(hybridEncryptionInfo))
+	if err != nil {
+		return nil, err
+	}
+	return openWithAEAD(data[privKey.Size():], key)
+}
+
+func openWithAEAD(sealedData, key []byte) ([]byte, error) {
+	aead, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonceSize := aead.NonceSize()
+	return aead.Open(nil, sealedData[:nonceSize], sealedData[nonceSize:], nil)
+}
@@ -0,0 +1,198 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// confidentialitypolicycc contains all the code related to the ConfidentialityPolicy struct, including CRUD operations
+// and the evaluation of the policy for a view request
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	log "github.com/sirupsen/logrus"
+)
+
+const confidentialityPolicyObjectType = "confidentialityPolicy"
+
+// CreateConfidentialityPolicy cc is used to store a ConfidentialityPolicy in the ledger
+func (s *SmartContract) CreateConfidentialityPolicy(ctx contractapi.TransactionContextInterface, confidentialityPolicyJSON string) error {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	confidentialityPolicy, err := decodeConfidentialityPolicy([]byte(confidentialityPolicyJSON))
+	if err != nil {
+		errorMessage := fmt.Sprintf("Unmarshal error: %s", err)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+	confidentialityPolicyKey, err := ctx.GetStub().CreateCompositeKey(confidentialityPolicyObjectType, []string{confidentialityPolicy.SecurityDomain})
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	cp, err := ctx.GetStub().GetState(confidentialityPolicyKey)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	if cp != nil {
+		errorMessage := fmt.Sprintf("ConfidentialityPolicy already exists for securityDomain: %s", confidentialityPolicy.SecurityDomain)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+
+	confidentialityPolicyBytes, err := json.Marshal(confidentialityPolicy)
+	if err != nil {
+		errorMessage := fmt.Sprintf("Marshal error: %s", err)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+	return ctx.GetStub().PutState(confidentialityPolicyKey, confidentialityPolicyBytes)
+}
+
+// UpdateConfidentialityPolicy cc is used to update an existing ConfidentialityPolicy in the ledger
+func (s *SmartContract) UpdateConfidentialityPolicy(ctx contractapi.TransactionContextInterface, confidentialityPolicyJSON string) error {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	confidentialityPolicy, err := decodeConfidentialityPolicy([]byte(confidentialityPolicyJSON))
+	if err != nil {
+		errorMessage := fmt.Sprintf("Unmarshal error: %s", err)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+	confidentialityPolicyKey, err := ctx.GetStub().CreateCompositeKey(confidentialityPolicyObjectType, []string{confidentialityPolicy.SecurityDomain})
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	_, err = s.GetConfidentialityPolicyBySecurityDomain(ctx, confidentialityPolicy.SecurityDomain)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+
+	confidentialityPolicyBytes, err := json.Marshal(confidentialityPolicy)
+	if err != nil {
+		errorMessage := fmt.Sprintf("Marshal error: %s", err)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+	return ctx.GetStub().PutState(confidentialityPolicyKey, confidentialityPolicyBytes)
+}
+
+// GetConfidentialityPolicyBySecurityDomain cc gets the ConfidentialityPolicy for the provided securityDomain
+func (s *SmartContract) GetConfidentialityPolicyBySecurityDomain(ctx contractapi.TransactionContextInterface, securityDomain string) (string, error) {
+	confidentialityPolicyKey, err := ctx.GetStub().CreateCompositeKey(confidentialityPolicyObjectType, []string{securityDomain})
+	if err != nil {
+		log.Error(err.Error())
+		return "", err
+	}
+	bytes, err := ctx.GetStub().GetState(confidentialityPolicyKey)
+	if err != nil {
+		log.Error(err.Error())
+		return "", err
+	}
+	if bytes == nil {
+		errorMessage := fmt.Sprintf("Confidentiality Policy with securityDomain: %s does not exist", securityDomain)
+		log.Error(errorMessage)
+		return "", errors.New(errorMessage)
+	}
+	return string(bytes), nil
+}
+
+// DeleteConfidentialityPolicy cc is used to delete an existing ConfidentialityPolicy in the ledger
+func (s *SmartContract) DeleteConfidentialityPolicy(ctx contractapi.TransactionContextInterface, securityDomain string) error {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	confidentialityPolicyKey, err := ctx.GetStub().CreateCompositeKey(confidentialityPolicyObjectType, []string{securityDomain})
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	bytes, err := ctx.GetStub().GetState(confidentialityPolicyKey)
+	if err != nil {
+		log.Error(err.Error())
+		return err
+	}
+	if bytes == nil {
+		errorMessage := fmt.Sprintf("Confidentiality Policy with securityDomain: %s does not exist", securityDomain)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+	err = ctx.GetStub().DelState(confidentialityPolicyKey)
+	if err != nil {
+		errorMessage := fmt.Sprintf("failed to delete asset %s: %v", confidentialityPolicyKey, err)
+		log.Error(errorMessage)
+		return errors.New(errorMessage)
+	}
+
+	return nil
+}
+
+// isViewConfidential determines whether the view for the given address must be encrypted for a requester from the
+// given network. Of the rules in the ConfidentialityPolicy recorded for that network that match the address, the one
+// with the most specific resource decides, and among equally specific rules, one requiring encryption prevails.
+// If there is no such rule, the (deprecated) network-wide flag recorded by InitLedger decides.
+// A requester can always ask for encryption, irrespective of the policy.
+func isViewConfidential(ctx contractapi.TransactionContextInterface, query *common.Query, viewAddressString string) (bool, error) {
+	if query.Confidential {
+		return true, nil
+	}
+
+	confidentialityPolicyKey, err := ctx.GetStub().CreateCompositeKey(confidentialityPolicyObjectType, []string{query.RequestingNetwork})
+	if err != nil {
+		return false, err
+	}
+	confidentialityPolicyBytes, err := ctx.GetStub().GetState(confidentialityPolicyKey)
+	if err != nil {
+		return false, err
+	}
+	if confidentialityPolicyBytes != nil {
+		confidentialityPolicy, err := decodeConfidentialityPolicy(confidentialityPolicyBytes)
+		if err != nil {
+			return false, fmt.Errorf("Failed to unmarshal confidentiality policy: %s", err.Error())
+		}
+		var decidingRule *common.ConfidentialityRule
+		decidingSpecificity := -1
+		for _, rule := range confidentialityPolicy.Rules {
+			specificity := getResourceSpecificity(rule.Resource, viewAddressString)
+			if specificity < 0 || specificity < decidingSpecificity {
+				continue
+			}
+			if specificity > decidingSpecificity || rule.Confidential {
+				decidingRule = rule
+				decidingSpecificity = specificity
+			}
+		}
+		if decidingRule != nil {
+			return decidingRule.Confidential, nil
+		}
+	}
+
+	confFlag, err := ctx.GetStub().GetState(e2eConfidentialityKey)
+	if err != nil {
+		return false, err
+	}
+	return string(confFlag) == "true", nil
+}
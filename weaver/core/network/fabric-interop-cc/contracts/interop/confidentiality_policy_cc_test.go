@@ -0,0 +1,168 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+var confidentialityPolicyAsset = common.ConfidentialityPolicy{
+	SecurityDomain: "network2",
+	Rules: []*common.ConfidentialityRule{
+		{Resource: "mychannel:bonds:*", Confidential: true},
+		{Resource: "mychannel:bonds:GetReferenceRate:*", Confidential: false},
+	},
+}
+
+func TestGetConfidentialityPolicyBySecurityDomain(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	// Case when no confidentiality policy is found
+	cpString, err := interopcc.GetConfidentialityPolicyBySecurityDomain(ctx, "network2")
+	require.EqualError(t, err, "Confidentiality Policy with securityDomain: network2 does not exist")
+	require.Equal(t, "", cpString)
+
+	// Case when confidentiality policy is found
+	value, err := json.Marshal(&confidentialityPolicyAsset)
+	require.NoError(t, err)
+	chaincodeStub.GetStateReturns(value, nil)
+	cpString, err = interopcc.GetConfidentialityPolicyBySecurityDomain(ctx, "network2")
+	require.NoError(t, err)
+	require.Equal(t, string(value), cpString)
+}
+
+func TestCreateConfidentialityPolicy(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	confidentialityPolicyBytes, err := json.Marshal(&confidentialityPolicyAsset)
+	require.NoError(t, err)
+	// Case when caller is not an admin
+	err = interopcc.CreateConfidentialityPolicy(ctx, string(confidentialityPolicyBytes))
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	// Set caller to be admin now
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+	err = interopcc.CreateConfidentialityPolicy(ctx, string(confidentialityPolicyBytes))
+	require.NoError(t, err)
+	// Invalid Input check
+	err = interopcc.CreateConfidentialityPolicy(ctx, `{"securityDomain":"network2","rules":[{"resource":"*","encrypt":true}]}`)
+	require.EqualError(t, err, `Unmarshal error: json: unknown field "encrypt"`)
+	// ConfidentialityPolicy already exists
+	chaincodeStub.GetStateReturns([]byte{}, nil)
+	err = interopcc.CreateConfidentialityPolicy(ctx, string(confidentialityPolicyBytes))
+	require.EqualError(t, err, "ConfidentialityPolicy already exists for securityDomain: network2")
+}
+
+func TestUpdateConfidentialityPolicy(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	confidentialityPolicyBytes, err := json.Marshal(&confidentialityPolicyAsset)
+	require.NoError(t, err)
+	// Case when caller is not an admin
+	err = interopcc.UpdateConfidentialityPolicy(ctx, string(confidentialityPolicyBytes))
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	// Set caller to be admin now
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+	// Case when no confidentiality policy is found
+	err = interopcc.UpdateConfidentialityPolicy(ctx, string(confidentialityPolicyBytes))
+	require.EqualError(t, err, "Confidentiality Policy with securityDomain: network2 does not exist")
+	// ConfidentialityPolicy exists
+	chaincodeStub.GetStateReturns(confidentialityPolicyBytes, nil)
+	err = interopcc.UpdateConfidentialityPolicy(ctx, string(confidentialityPolicyBytes))
+	require.NoError(t, err)
+}
+
+func TestDeleteConfidentialityPolicy(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	// Case when a policy exists
+	chaincodeStub.GetStateReturns([]byte{}, nil)
+	// Case when caller is not an admin
+	err := interopcc.DeleteConfidentialityPolicy(ctx, "network2")
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	// Set caller to be admin now
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+	err = interopcc.DeleteConfidentialityPolicy(ctx, "network2")
+	require.NoError(t, err)
+
+	// Case when no confidentiality policy is found
+	chaincodeStub.GetStateReturns(nil, nil)
+	err = interopcc.DeleteConfidentialityPolicy(ctx, "network2")
+	require.EqualError(t, err, "Confidentiality Policy with securityDomain: network2 does not exist")
+
+	// Handle GetState Error
+	chaincodeStub.GetStateReturns(nil, fmt.Errorf("unable to retrieve asset"))
+	err = interopcc.DeleteConfidentialityPolicy(ctx, "network2")
+	require.EqualError(t, err, "unable to retrieve asset")
+}
+
+func TestIsViewConfidential(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+
+	confidentialityPolicyBytes, err := json.Marshal(&confidentialityPolicyAsset)
+	require.NoError(t, err)
+	ledger := map[string][]byte{}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + attributes[0], nil
+	})
+	isConfidential := func(requestingNetwork, viewAddress string, requested bool) bool {
+		confidential, err := isViewConfidential(ctx, &common.Query{RequestingNetwork: requestingNetwork, Confidential: requested}, viewAddress)
+		require.NoError(t, err)
+		return confidential
+	}
+
+	// Without a policy, the network-wide flag and the requester decide
+	require.False(t, isConfidential("network2", "mychannel:bonds:GetBond:b01", false))
+	require.True(t, isConfidential("network2", "mychannel:bonds:GetBond:b01", true))
+	ledger[e2eConfidentialityKey] = []byte("true")
+	require.True(t, isConfidential("network2", "mychannel:bonds:GetBond:b01", false))
+
+	// The most specific matching rule of the requesting network's policy decides
+	ledger[e2eConfidentialityKey] = []byte("false")
+	ledger[confidentialityPolicyObjectType+"network2"] = confidentialityPolicyBytes
+	require.True(t, isConfidential("network2", "mychannel:bonds:GetBond:b01", false))
+	require.False(t, isConfidential("network2", "mychannel:bonds:GetReferenceRate:r01", false))
+	require.False(t, isConfidential("network3", "mychannel:bonds:GetBond:b01", false))
+	// A rule overrides the network-wide flag, though the requester can still ask for encryption
+	ledger[e2eConfidentialityKey] = []byte("true")
+	require.False(t, isConfidential("network2", "mychannel:bonds:GetReferenceRate:r01", false))
+	require.True(t, isConfidential("network2", "mychannel:bonds:GetReferenceRate:r01", true))
+	// The network-wide flag decides for views that no rule covers
+	require.True(t, isConfidential("network2", "mychannel:refdata:GetCurrencies", false))
+
+	// Among equally specific rules, the one requiring encryption prevails
+	ledger[e2eConfidentialityKey] = []byte("false")
+	conflictingPolicyBytes, err := json.Marshal(&common.ConfidentialityPolicy{
+		SecurityDomain: "network2",
+		Rules: []*common.ConfidentialityRule{
+			{Resource: "mychannel:bonds:*", Confidential: true},
+			{Resource: "mychannel:bonds:*", Confidential: false},
+		},
+	})
+	require.NoError(t, err)
+	ledger[confidentialityPolicyObjectType+"network2"] = conflictingPolicyBytes
+	require.True(t, isConfidential("network2", "mychannel:bonds:GetBond:b01", false))
+}
@@ -77,3 +77,25 @@ func decodeAccessControlPolicy(jsonBytes []byte) (*common.AccessControlPolicy, e
 	}
 	return &decodeObj, nil
 }
+
+func decodeConfidentialityPolicy(jsonBytes []byte) (*common.ConfidentialityPolicy, error) {
+	var decodeObj common.ConfidentialityPolicy
+	dec := json.NewDecoder(strings.NewReader(string(jsonBytes)))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&decodeObj)
+	if err != nil {
+		return nil, err
+	}
+	return &decodeObj, nil
+}
+
+func decodeViewConstraints(jsonBytes []byte) ([]ViewConstraint, error) {
+	var decodeObj []ViewConstraint
+	dec := json.NewDecoder(strings.NewReader(string(jsonBytes)))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&decodeObj)
+	if err != nil {
+		return nil, err
+	}
+	return decodeObj, nil
+}
@@ -10,7 +10,7 @@ package main
 
 import (
 	"encoding/base64"
-	"errors"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -39,10 +39,12 @@ func (s *SmartContract) HandleExternalRequest(ctx contractapi.TransactionContext
 	if err != nil {
 		return "", logThenErrorf("Unable to unmarshal query: %s", err.Error())
 	}
-	resp, err := handleRequest(s, ctx, query, query.Address)
+	resp, err := handleRequest(s, ctx, query, query.Address, []*common.DynamicArg{})
 	return resp, err
 }
 
+// HandleEventRequest chaincode processes requests that external networks have subscribed to, triggered by local events.
+// The payload of the triggering event supplies the values of the placeholders in the query address.
 func (s *SmartContract) HandleEventRequest(ctx contractapi.TransactionContextInterface, b64QueryBytes string, dynamicQueryArg string) (string, error) {
 	queryBytes, err := base64.StdEncoding.DecodeString(b64QueryBytes)
 	if err != nil {
@@ -54,22 +56,110 @@ func (s *SmartContract) HandleEventRequest(ctx contractapi.TransactionContextInt
 		return "", logThenErrorf("Unable to unmarshal query: %s", err.Error())
 	}
 
-	queryAddress := query.Address
-	dynamicArgCount := strings.Count(query.Address, ":?")
-	// TODO: this is a stopgap logic and will be reviewed and possibly changed later
-	if dynamicArgCount > 1 {
-		return "", logThenErrorf("Expected 1 dynamic argument in the event query address, but found %d", dynamicArgCount)
-	} else if dynamicArgCount == 1 {
-		queryAddress = strings.Replace(query.Address, ":?", ":"+dynamicQueryArg, 1)
-		fmt.Println("There is 1 dynamic argument in the event query address, queryArg: ", dynamicQueryArg)
-	} else {
-		fmt.Println("There are no dynamic arguments in the event query address, queryArg: ", dynamicQueryArg)
+	queryAddress, dynamicArgs, err := substituteDynamicArgs(query.Address, dynamicQueryArg)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
 	}
 
-	resp, err := handleRequest(s, ctx, query, queryAddress)
+	resp, err := handleRequest(s, ctx, query, queryAddress, dynamicArgs)
 	return resp, err
 }
 
+// substituteDynamicArgs replaces the placeholders in the arguments of an event query address with values from the
+// payload of the triggering event, and returns the substituted arguments in address order. Placeholders are only
+// recognised in the chaincode arguments of the view segment, never in its channel, contract or function.
+// Positional placeholders are arguments starting with '?', the rest of the argument being kept as a suffix: a single
+// positional placeholder is replaced by the whole payload, and several positional placeholders are replaced, in order,
+// by the elements of a payload that is a JSON array. Named ('?{<name>}') placeholders are replaced by the fields of a
+// payload that is a JSON object. JSON string values are substituted as is, and other values in their JSON encoding.
+// A substituted value cannot contain an address separator, so that it cannot add arguments to the query.
+func substituteDynamicArgs(address string, eventPayload string) (string, []*common.DynamicArg, error) {
+	addressSegments := strings.Split(address, "/")
+	if len(addressSegments) != 3 {
+		return "", nil, fmt.Errorf("Invalid Address. Address should have three segments. %s", address)
+	}
+	viewSegments := strings.Split(addressSegments[2], ":")
+	if len(viewSegments) < 3 {
+		return "", nil, fmt.Errorf("View segment not formatted correctly %s", addressSegments[2])
+	}
+	args := viewSegments[3:]
+	positionalCount, namedCount := 0, 0
+	for _, arg := range args {
+		if _, isNamed := getDynamicArgName(arg); isNamed {
+			namedCount++
+		} else if strings.HasPrefix(arg, "?") {
+			positionalCount++
+		}
+	}
+	if positionalCount == 0 && namedCount == 0 {
+		return address, []*common.DynamicArg{}, nil
+	}
+	if positionalCount > 0 && namedCount > 0 {
+		return "", nil, fmt.Errorf("Event query address cannot mix positional and named dynamic arguments")
+	}
+
+	var positionalValues []json.RawMessage
+	var namedValues map[string]json.RawMessage
+	if positionalCount > 1 {
+		err := json.Unmarshal([]byte(eventPayload), &positionalValues)
+		if err != nil {
+			return "", nil, fmt.Errorf("Expected a JSON array of %d dynamic arguments in the event payload: %s", positionalCount, err)
+		}
+		if len(positionalValues) != positionalCount {
+			return "", nil, fmt.Errorf("Expected %d dynamic arguments in the event payload, but found %d", positionalCount, len(positionalValues))
+		}
+	} else if namedCount > 0 {
+		err := json.Unmarshal([]byte(eventPayload), &namedValues)
+		if err != nil {
+			return "", nil, fmt.Errorf("Expected a JSON object of dynamic arguments in the event payload: %s", err)
+		}
+	}
+
+	dynamicArgs := []*common.DynamicArg{}
+	for i, arg := range args {
+		name, isNamed := getDynamicArgName(arg)
+		if !isNamed && !strings.HasPrefix(arg, "?") {
+			continue
+		}
+		var value string
+		if isNamed {
+			rawValue, exists := namedValues[name]
+			if !exists {
+				return "", nil, fmt.Errorf("Dynamic argument %s not found in the event payload", name)
+			}
+			value = getJSONArgValue(rawValue)
+		} else if positionalCount > 1 {
+			value = getJSONArgValue(positionalValues[len(dynamicArgs)]) + arg[1:]
+		} else {
+			value = eventPayload + arg[1:]
+		}
+		if strings.ContainsAny(value, ":/") {
+			return "", nil, fmt.Errorf("Dynamic argument value %s cannot contain ':' or '/'", value)
+		}
+		args[i] = value
+		dynamicArgs = append(dynamicArgs, &common.DynamicArg{Name: name, Value: value})
+	}
+	addressSegments[2] = strings.Join(viewSegments, ":")
+	return strings.Join(addressSegments, "/"), dynamicArgs, nil
+}
+
+// getDynamicArgName returns the name of a named ('?{<name>}') placeholder, and whether the argument is one
+func getDynamicArgName(arg string) (string, bool) {
+	if len(arg) > 3 && strings.HasPrefix(arg, "?{") && strings.HasSuffix(arg, "}") {
+		return arg[2 : len(arg)-1], true
+	}
+	return "", false
+}
+
+// getJSONArgValue returns a JSON string value as is, and any other JSON value in its JSON encoding
+func getJSONArgValue(rawValue json.RawMessage) string {
+	var value string
+	if err := json.Unmarshal(rawValue, &value); err == nil {
+		return value
+	}
+	return string(rawValue)
+}
+
 // This function handleRequest handle requests that originate in external requests and have come through relays.
 //
 // The flow coordinates the following:
@@ -77,7 +167,10 @@ func (s *SmartContract) HandleEventRequest(ctx contractapi.TransactionContextInt
 // 2. Checks that the certificate of the requester is valid according to the network's Membership
 // 3. Checks the access control policy for the requester and view address is met
 // 4. Calls application chaincode
-func handleRequest(s *SmartContract, ctx contractapi.TransactionContextInterface, query common.Query, queryAddress string) (string, error) {
+// 5. Encrypts the response if necessary
+//
+// The values substituted for the placeholders of an event query address, if any, are recorded in the response.
+func handleRequest(s *SmartContract, ctx contractapi.TransactionContextInterface, query common.Query, queryAddress string, dynamicArgs []*common.DynamicArg) (string, error) {
 	// Ensure that this function cannot be called by a client without relay permissions
 	relayAccessCheck, err := wutils.IsClientRelay(ctx.GetStub())
 	if err != nil {
@@ -128,35 +221,10 @@ func handleRequest(s *SmartContract, ctx contractapi.TransactionContextInterface
 	byteArgs := strArrToBytesArr(arr)
 
 	localCCId, err := wutils.GetLocalChaincodeID(ctx.GetStub())
-	payload := []byte("")
-	confidential := false
+	var payload []byte
 	if localCCId == viewAddress.Contract {
-		// Interop call to InteropCC itself.
-		resp := ""
-		if viewAddress.CCFunc == "GetHTLCHash" {
-			if len(viewAddress.Args) > 1 {
-				return "", logThenErrorf("Recieved more arguments than required 1 argument.")
-			}
-			resp, err = s.GetHTLCHash(ctx, viewAddress.Args[0])
-		} else if viewAddress.CCFunc == "GetHTLCHashByContractId" {
-			if len(viewAddress.Args) > 1 {
-				return "", logThenErrorf("Recieved more arguments than required 1 argument.")
-			}
-			resp, err = s.GetHTLCHashByContractId(ctx, viewAddress.Args[0])
-		} else if viewAddress.CCFunc == "GetHTLCHashPreImage" {
-			if len(viewAddress.Args) > 1 {
-				return "", logThenErrorf("Recieved more arguments than required 1 argument.")
-			}
-			resp, err = s.GetHTLCHashPreImage(ctx, viewAddress.Args[0])
-		} else if viewAddress.CCFunc == "GetHTLCHashPreImageByContractId" {
-			if len(viewAddress.Args) > 1 {
-				return "", logThenErrorf("Recieved more arguments than required 1 argument.")
-			}
-			resp, err = s.GetHTLCHashPreImageByContractId(ctx, viewAddress.Args[0])
-		} else {
-			errorMessage := fmt.Sprintf("Given function %s can not be invoked in Interop Chaincode.", viewAddress.CCFunc)
-			err = errors.New(errorMessage)
-		}
+		// Interop call to InteropCC itself, restricted to its registered read-only functions
+		resp, err := handleInteropQuery(s, ctx, &query, viewAddress)
 		if err != nil {
 			log.Error(err)
 			return "", err
@@ -168,22 +236,21 @@ func handleRequest(s *SmartContract, ctx contractapi.TransactionContextInterface
 		if pbResp.Status != shim.OK {
 			return "", logThenErrorf("Application chaincode invoke error: %s", string(pbResp.GetMessage()))
 		}
-		// 5. Encrypt payload if necessary
-		confFlag, err := ctx.GetStub().GetState(e2eConfidentialityKey)
+		payload = pbResp.Payload
+	}
+
+	// 5. Encrypt payload if necessary, whether it comes from an application chaincode or from InteropCC itself
+	confidential, err := isViewConfidential(ctx, &query, address.ViewSegment)
+	if err != nil {
+		log.Error(err)
+		return "", err
+	}
+	if confidential {
+		// Generate encrypted payload and corroborating hash (HMAC)
+		// Use already authenticated certificate as the source of the public key for encryption
+		payload, err = generateConfidentialInteropPayloadAndHash(payload, query.Certificate)
 		if err != nil {
-			log.Error(err)
-			return "", err
-		}
-		if query.Confidential || string(confFlag) == "true" {
-			confidential = true
-			// Generate encrypted payload and corroborating hash (HMAC)
-			// Use already authenticated certificate as the source of the public key for encryption
-			payload, err = generateConfidentialInteropPayloadAndHash(pbResp.Payload, query.Certificate)
-			if err != nil {
-				return "", logThenErrorf(err.Error())
-			}
-		} else {
-			payload = pbResp.Payload
+			return "", logThenErrorf(err.Error())
 		}
 	}
 
@@ -193,6 +260,7 @@ func handleRequest(s *SmartContract, ctx contractapi.TransactionContextInterface
 		Confidential:         confidential,
 		RequestorCertificate: query.Certificate,
 		Nonce:                query.Nonce,
+		DynamicArgs:          dynamicArgs,
 	}
 	interopPayloadBytes, err := protoV2.Marshal(&interopPayloadStruct)
 	if err != nil {
@@ -130,6 +130,8 @@ func TestHandleExternalRequest(t *testing.T) {
 	testHandleExternalRequestNoMembership(t, &query, validCertificate, signature, pbResp)
 	// Happy case. ECDSA Cert and Valid Signature
 	testHandleExternalRequestECDSAHappyCase(t, &query, validCertificate, key, signature, pbResp, &accessControlAsset, &membershipAsset)
+	// Confidential query of the interop chaincode itself
+	testHandleExternalRequestConfidentialInteropQuery(t, &query, validCertificate, key, &membershipAsset)
 	// ed25519 Cert and Signature
 	testHandleExternalRequestED25519Signature(t, &query, pbResp, &accessControlAsset, &membershipAsset, template)
 	// Test event requests
@@ -219,12 +221,79 @@ func testHandleEventRequestInvalidDynamicQueryArgs(t *testing.T, query *common.Q
 	b64QueryBytes := base64.StdEncoding.EncodeToString(queryBytes)
 
 	_, err = interopcc.HandleEventRequest(ctx, string(b64QueryBytes), "a")
-	require.EqualError(t, err, fmt.Sprintf("Expected 1 dynamic argument in the event query address, but found 2"))
+	require.EqualError(t, err, "Expected a JSON array of 2 dynamic arguments in the event payload: invalid character 'a' looking for beginning of value")
+	_, err = interopcc.HandleEventRequest(ctx, string(b64QueryBytes), `["a"]`)
+	require.EqualError(t, err, "Expected 2 dynamic arguments in the event payload, but found 1")
 
 	// restore the value of query.Address
 	query.Address = queryAddress
 }
 
+func testHandleExternalRequestConfidentialInteropQuery(t *testing.T, query *common.Query, validCertificate string, validPrivateKey *ecdsa.PrivateKey, membership *common.Membership) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+	chaincodeStub.GetCreatorReturns([]byte(getRelayCreator()), nil)
+	interopCCId := "interopcc"
+	wtest.SetMockStubCCId(chaincodeStub, interopCCId)
+
+	// query a read-only function of the interop chaincode itself, asking for a confidential response
+	queryAddress := query.Address
+	queryConfidential := query.Confidential
+	querySignature := query.RequestorSignature
+	query.Address = "localhost:9080/network1/mychannel:interopcc:GetExpiryGraceWindow"
+	query.Confidential = true
+	query.Certificate = validCertificate
+	hashed, err := computeSHA2Hash([]byte(query.Address+query.Nonce), validPrivateKey.PublicKey.Params().BitSize)
+	require.NoError(t, err)
+	signature, err := ecdsa.SignASN1(rand.Reader, validPrivateKey, hashed)
+	require.NoError(t, err)
+	query.RequestorSignature = base64.StdEncoding.EncodeToString(signature)
+	queryBytes, err := protoV2.Marshal(query)
+	require.NoError(t, err)
+	b64QueryBytes := base64.StdEncoding.EncodeToString(queryBytes)
+
+	accessControl := common.AccessControlPolicy{
+		SecurityDomain: "2345",
+		Rules: []*common.Rule{{
+			Principal:     validCertificate,
+			PrincipalType: "certificate",
+			Read:          true,
+			Resource:      "mychannel:interopcc:GetExpiryGraceWindow",
+		}},
+	}
+	membershipBytes, err := json.Marshal(membership)
+	require.NoError(t, err)
+	accessControlBytes, err := json.Marshal(&accessControl)
+	require.NoError(t, err)
+	chaincodeStub.GetStateReturnsOnCall(0, membershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, accessControlBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(2, []byte("300"), nil)
+
+	interopResponse, err := interopcc.HandleExternalRequest(ctx, string(b64QueryBytes))
+	require.NoError(t, err)
+	require.Equal(t, 0, chaincodeStub.InvokeChaincodeCallCount())
+	var interopPayloadResp common.InteropPayload
+	err = protoV2.Unmarshal([]byte(interopResponse), &interopPayloadResp)
+	require.NoError(t, err)
+	require.True(t, interopPayloadResp.Confidential)
+	require.Equal(t, query.Address, interopPayloadResp.Address)
+	require.NotEqual(t, []byte("300"), interopPayloadResp.Payload)
+	var confPayload common.ConfidentialPayload
+	err = protoV2.Unmarshal(interopPayloadResp.Payload, &confPayload)
+	require.NoError(t, err)
+	decConfPayload, err := decryptDataWithPrivKey(validPrivateKey, confPayload.EncryptedPayload)
+	require.NoError(t, err)
+	var confPayloadContents common.ConfidentialPayloadContents
+	err = protoV2.Unmarshal(decConfPayload, &confPayloadContents)
+	require.NoError(t, err)
+	require.Equal(t, []byte("300"), confPayloadContents.Payload)
+
+	// restore the values of the query
+	query.Address = queryAddress
+	query.Confidential = queryConfidential
+	query.RequestorSignature = querySignature
+}
+
 func testHandleExternalRequestECDSAHappyCase(t *testing.T, query *common.Query, validCertificate string, validPrivateKey *ecdsa.PrivateKey, signature []byte, pbResp pb.Response, accessControl *common.AccessControlPolicy, membership *common.Membership) {
 	ctx, chaincodeStub := wtest.PrepMockStub()
 	interopcc := SmartContract{}
@@ -271,8 +340,8 @@ func testHandleExternalRequestECDSAHappyCase(t *testing.T, query *common.Query,
 	queryBytes, err = protoV2.Marshal(query)
 	require.NoError(t, err)
 	b64QueryBytes = base64.StdEncoding.EncodeToString(queryBytes)
-	chaincodeStub.GetStateReturnsOnCall(3, membershipBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(4, accessControlBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(4, membershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(5, accessControlBytes, nil)
 	chaincodeStub.InvokeChaincodeReturns(pbResp)
 	interopResponse, err = interopcc.HandleExternalRequest(ctx, string(b64QueryBytes))
 	err = protoV2.Unmarshal([]byte(interopResponse), &interopPayloadResp)
@@ -355,9 +424,14 @@ func testHandleEventRequestECDSAHappyCase(t *testing.T, query *common.Query, val
 	require.NoError(t, err)
 	b64QueryBytes = base64.StdEncoding.EncodeToString(queryBytes)
 
+	// the substituted value is recorded in the response
+	interopPayload.DynamicArgs = []*common.DynamicArg{{Name: "", Value: "a"}}
+	interopPayloadBytes, err = protoV2.Marshal(&interopPayload)
+	require.NoError(t, err)
+
 	// mock all the calls to the chaincode stub
-	chaincodeStub.GetStateReturnsOnCall(3, membershipBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(4, accessControlBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(4, membershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(5, accessControlBytes, nil)
 	chaincodeStub.InvokeChaincodeReturns(pbResp)
 
 	interopResponse, err = interopcc.HandleEventRequest(ctx, string(b64QueryBytes), "a")
@@ -373,8 +447,8 @@ func testHandleEventRequestECDSAHappyCase(t *testing.T, query *common.Query, val
 	queryBytes, err = protoV2.Marshal(query)
 	require.NoError(t, err)
 	b64QueryBytes = base64.StdEncoding.EncodeToString(queryBytes)
-	chaincodeStub.GetStateReturnsOnCall(6, membershipBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(7, accessControlBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(8, membershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(9, accessControlBytes, nil)
 	chaincodeStub.InvokeChaincodeReturns(pbResp)
 	interopResponse, err = interopcc.HandleEventRequest(ctx, string(b64QueryBytes), "a")
 	require.NoError(t, err)
@@ -484,3 +558,55 @@ func testHandleExternalRequestNoAccessControlPolicy(t *testing.T, query *common.
 	_, err = interopcc.HandleExternalRequest(ctx, string(b64QueryBytes))
 	require.EqualError(t, err, fmt.Sprintf("CC Access Denied: Access control policy does not exist for network: %s", query.RequestingNetwork))
 }
+
+func TestSubstituteDynamicArgs(t *testing.T) {
+	viewPrefix := "localhost:9080/network1/mychannel:simpleasset:ReadAsset"
+
+	// No placeholders
+	address, dynamicArgs, err := substituteDynamicArgs(viewPrefix+":a", `{"id":"a"}`)
+	require.NoError(t, err)
+	require.Equal(t, viewPrefix+":a", address)
+	require.Empty(t, dynamicArgs)
+
+	// A single positional placeholder is replaced by the whole event payload
+	address, dynamicArgs, err = substituteDynamicArgs(viewPrefix+":?", "a01")
+	require.NoError(t, err)
+	require.Equal(t, viewPrefix+":a01", address)
+	require.Equal(t, []*common.DynamicArg{{Name: "", Value: "a01"}}, dynamicArgs)
+
+	// The rest of a positional placeholder is kept as a suffix
+	address, dynamicArgs, err = substituteDynamicArgs(viewPrefix+":?-bond", "a01")
+	require.NoError(t, err)
+	require.Equal(t, viewPrefix+":a01-bond", address)
+	require.Equal(t, []*common.DynamicArg{{Name: "", Value: "a01-bond"}}, dynamicArgs)
+
+	// Several positional placeholders are replaced by the elements of a JSON array, in order
+	address, dynamicArgs, err = substituteDynamicArgs(viewPrefix+":?:fixed:?", `["bond", 7]`)
+	require.NoError(t, err)
+	require.Equal(t, viewPrefix+":bond:fixed:7", address)
+	require.Equal(t, []*common.DynamicArg{{Name: "", Value: "bond"}, {Name: "", Value: "7"}}, dynamicArgs)
+
+	// Named placeholders are replaced by the fields of a JSON object
+	address, dynamicArgs, err = substituteDynamicArgs(viewPrefix+":?{assetType}:?{id}:?{owner}", `{"owner":"Bob","assetType":"bond","id":"a01","extra":true}`)
+	require.NoError(t, err)
+	require.Equal(t, viewPrefix+":bond:a01:Bob", address)
+	require.Equal(t, []*common.DynamicArg{{Name: "assetType", Value: "bond"}, {Name: "id", Value: "a01"}, {Name: "owner", Value: "Bob"}}, dynamicArgs)
+
+	// Failure cases
+	_, _, err = substituteDynamicArgs(viewPrefix+":?:?{id}", `{"id":"a01"}`)
+	require.EqualError(t, err, "Event query address cannot mix positional and named dynamic arguments")
+	_, _, err = substituteDynamicArgs(viewPrefix+":?{assetType}:?{id}", `{"id":"a01"}`)
+	require.EqualError(t, err, "Dynamic argument assetType not found in the event payload")
+	_, _, err = substituteDynamicArgs(viewPrefix+":?{id}", `["a01"]`)
+	require.ErrorContains(t, err, "Expected a JSON object of dynamic arguments in the event payload")
+	_, _, err = substituteDynamicArgs(viewPrefix+":?", "a01:DeleteAsset")
+	require.EqualError(t, err, "Dynamic argument value a01:DeleteAsset cannot contain ':' or '/'")
+	_, _, err = substituteDynamicArgs(viewPrefix+":?{id}", `{"id":"a01/network2"}`)
+	require.EqualError(t, err, "Dynamic argument value a01/network2 cannot contain ':' or '/'")
+
+	// Placeholders outside the chaincode arguments are not substituted
+	address, dynamicArgs, err = substituteDynamicArgs("localhost:9080/network1/mychannel:simpleasset:?", "DeleteAsset")
+	require.NoError(t, err)
+	require.Equal(t, "localhost:9080/network1/mychannel:simpleasset:?", address)
+	require.Empty(t, dynamicArgs)
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// history contains the queries of the past versions of the memberships and policies recorded for security domains,
+// which let the proofs verified at some point be audited against the records then in force
+package main
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// historyRecordTypes maps the record types accepted by the history queries to the object types of their ledger keys
+var historyRecordTypes = map[string]string{
+	"membership":            membershipObjectType,
+	"verificationPolicy":    verificationPolicyObjectType,
+	"accessControlPolicy":   accessControlObjectType,
+	"confidentialityPolicy": confidentialityPolicyObjectType,
+}
+
+// RecordVersion is a version of a membership or policy record, as written (or deleted) by a committed transaction.
+// The timestamp is the one set by the client that created the transaction, in RFC 3339 format.
+type RecordVersion struct {
+	TxId      string          `json:"txId"`
+	Timestamp string          `json:"timestamp"`
+	IsDelete  bool            `json:"isDelete"`
+	Value     json.RawMessage `json:"value,omitempty"`
+}
+
+// getRecordVersions returns the versions of the record of the given type for the given security domain, in the order
+// returned by the peer's history database (the most recent first)
+func getRecordVersions(ctx contractapi.TransactionContextInterface, recordType string, securityDomain string) ([]RecordVersion, error) {
+	objectType, exists := historyRecordTypes[recordType]
+	if !exists {
+		return nil, logThenErrorf("Unsupported record type: %s", recordType)
+	}
+	recordKey, err := ctx.GetStub().CreateCompositeKey(objectType, []string{securityDomain})
+	if err != nil {
+		return nil, logThenErrorf("%s", err.Error())
+	}
+	historyIterator, err := ctx.GetStub().GetHistoryForKey(recordKey)
+	if err != nil {
+		return nil, logThenErrorf("Unable to get history for %s record of securityDomain %s: %s", recordType, securityDomain, err.Error())
+	}
+	defer historyIterator.Close()
+
+	versions := []RecordVersion{}
+	for historyIterator.HasNext() {
+		modification, err := historyIterator.Next()
+		if err != nil {
+			return nil, logThenErrorf("%s", err.Error())
+		}
+		version := RecordVersion{
+			TxId:      modification.TxId,
+			Timestamp: modification.Timestamp.AsTime().Format(time.RFC3339Nano),
+			IsDelete:  modification.IsDelete,
+		}
+		if !modification.IsDelete {
+			version.Value = json.RawMessage(modification.Value)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// GetRecordHistory cc gets every version of the record of the given type (one of 'membership', 'verificationPolicy',
+// 'accessControlPolicy' and 'confidentialityPolicy') for the provided securityDomain, the most recent first.
+// The local membership is recorded under the securityDomain 'local-security-domain'.
+// This requires the history database to be enabled on the peer.
+func (s *SmartContract) GetRecordHistory(ctx contractapi.TransactionContextInterface, recordType string, securityDomain string) (string, error) {
+	versions, err := getRecordVersions(ctx, recordType, securityDomain)
+	if err != nil {
+		return "", err
+	}
+	versionsJSON, err := json.Marshal(versions)
+	if err != nil {
+		return "", logThenErrorf("Marshal error: %s", err)
+	}
+	return string(versionsJSON), nil
+}
+
+// GetRecordAtTime cc gets the version of the record of the given type for the provided securityDomain that was active
+// at the given transaction timestamp (in RFC 3339 format), i.e., the most recent version whose transaction timestamp
+// is not later. As transaction timestamps are set by clients, this is only as accurate as their clocks.
+func (s *SmartContract) GetRecordAtTime(ctx contractapi.TransactionContextInterface, recordType string, securityDomain string, timestamp string) (string, error) {
+	activeTime, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return "", logThenErrorf("Invalid timestamp: %s", err)
+	}
+	versions, err := getRecordVersions(ctx, recordType, securityDomain)
+	if err != nil {
+		return "", err
+	}
+	for _, version := range versions {
+		versionTime, err := time.Parse(time.RFC3339Nano, version.Timestamp)
+		if err != nil {
+			return "", logThenErrorf("Invalid timestamp: %s", err)
+		}
+		if versionTime.After(activeTime) {
+			continue
+		}
+		if version.IsDelete {
+			break
+		}
+		versionJSON, err := json.Marshal(version)
+		if err != nil {
+			return "", logThenErrorf("Marshal error: %s", err)
+		}
+		return string(versionJSON), nil
+	}
+	return "", logThenErrorf("No %s record for securityDomain: %s was active at %s", recordType, securityDomain, timestamp)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils/mocks"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func createHistoryQueryIterator(modifications []*queryresult.KeyModification) *mocks.HistoryQueryIterator {
+	iterator := &mocks.HistoryQueryIterator{}
+	next := 0
+	iterator.HasNextCalls(func() bool {
+		return next < len(modifications)
+	})
+	iterator.NextCalls(func() (*queryresult.KeyModification, error) {
+		next++
+		return modifications[next-1], nil
+	})
+	return iterator
+}
+
+func TestRecordHistory(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	firstTime := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	secondTime := firstTime.Add(24 * time.Hour)
+	deleteTime := secondTime.Add(24 * time.Hour)
+	// Versions in the order returned by the history database, the most recent first
+	modifications := []*queryresult.KeyModification{
+		{TxId: "tx3", Timestamp: timestamppb.New(deleteTime), IsDelete: true},
+		{TxId: "tx2", Timestamp: timestamppb.New(secondTime), Value: []byte(`{"securityDomain":"network1","members":{"Org2MSP":{}}}`)},
+		{TxId: "tx1", Timestamp: timestamppb.New(firstTime), Value: []byte(`{"securityDomain":"network1","members":{"Org1MSP":{}}}`)},
+	}
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + attributes[0], nil
+	})
+	chaincodeStub.GetHistoryForKeyCalls(func(key string) (shim.HistoryQueryIteratorInterface, error) {
+		if key == membershipObjectType+"network1" {
+			return createHistoryQueryIterator(modifications), nil
+		}
+		return createHistoryQueryIterator(nil), nil
+	})
+
+	// Test failure with an unsupported record type
+	_, err := interopcc.GetRecordHistory(ctx, "requestNonce", "network1")
+	require.EqualError(t, err, "Unsupported record type: requestNonce")
+
+	// Test success listing all versions, including the deletion
+	history, err := interopcc.GetRecordHistory(ctx, "membership", "network1")
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"txId":"tx3","timestamp":"2024-03-03T10:00:00Z","isDelete":true},
+		{"txId":"tx2","timestamp":"2024-03-02T10:00:00Z","isDelete":false,"value":{"securityDomain":"network1","members":{"Org2MSP":{}}}},
+		{"txId":"tx1","timestamp":"2024-03-01T10:00:00Z","isDelete":false,"value":{"securityDomain":"network1","members":{"Org1MSP":{}}}}
+	]`, history)
+	history, err = interopcc.GetRecordHistory(ctx, "verificationPolicy", "network1")
+	require.NoError(t, err)
+	require.Equal(t, "[]", history)
+
+	// Test success looking up the version active at a given time
+	version, err := interopcc.GetRecordAtTime(ctx, "membership", "network1", firstTime.Format(time.RFC3339))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"txId":"tx1","timestamp":"2024-03-01T10:00:00Z","isDelete":false,"value":{"securityDomain":"network1","members":{"Org1MSP":{}}}}`, version)
+	version, err = interopcc.GetRecordAtTime(ctx, "membership", "network1", secondTime.Add(time.Hour).Format(time.RFC3339))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"txId":"tx2","timestamp":"2024-03-02T10:00:00Z","isDelete":false,"value":{"securityDomain":"network1","members":{"Org2MSP":{}}}}`, version)
+
+	// Test failure when no version was active, before the first one or after the deletion
+	beforeFirst := firstTime.Add(-time.Second).Format(time.RFC3339)
+	_, err = interopcc.GetRecordAtTime(ctx, "membership", "network1", beforeFirst)
+	require.EqualError(t, err, fmt.Sprintf("No membership record for securityDomain: network1 was active at %s", beforeFirst))
+	afterDelete := deleteTime.Format(time.RFC3339)
+	_, err = interopcc.GetRecordAtTime(ctx, "membership", "network1", afterDelete)
+	require.EqualError(t, err, fmt.Sprintf("No membership record for securityDomain: network1 was active at %s", afterDelete))
+
+	// Test failure with an invalid timestamp
+	_, err = interopcc.GetRecordAtTime(ctx, "membership", "network1", "yesterday")
+	require.ErrorContains(t, err, "Invalid timestamp: ")
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// interopQueries contains the registry of the read-only functions of the interop chaincode that remote networks can
+// query through external requests
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/assetexchange/v2"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// interopQuery is a read-only function of the interop chaincode, with the number of arguments it expects
+type interopQuery struct {
+	argCount int
+	query    func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error)
+}
+
+// interopQueries is the registry of the interop chaincode functions that can be queried by remote networks, keyed by
+// function name. Only read-only functions may be registered here; which of them a remote network can actually query is
+// governed by the access control policy recorded for that network.
+//
+// As such queries do not come through the application chaincode that locked an asset, the lock queries by asset
+// agreement take the ID of that application chaincode as their first argument, and the lock queries by contractId do
+// not check the calling chaincode.
+var interopQueries = map[string]interopQuery{
+	"GetHTLCHash": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		return s.GetHTLCHash(ctx, args[0])
+	}},
+	"GetHTLCHashByContractId": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		return s.GetHTLCHashByContractId(ctx, args[0])
+	}},
+	"GetHTLCHashPreImage": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		return s.GetHTLCHashPreImage(ctx, args[0])
+	}},
+	"GetHTLCHashPreImageByContractId": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		return s.GetHTLCHashPreImageByContractId(ctx, args[0])
+	}},
+	"IsAssetLocked": {2, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		isLocked, err := assetexchange.IsAssetLocked(ctx, args[0], args[1])
+		return strconv.FormatBool(isLocked), err
+	}},
+	"IsAssetLockedQueryUsingContractId": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		isLocked, err := assetexchange.IsAssetLockedQueryUsingContractId(ctx, args[0])
+		return strconv.FormatBool(isLocked), err
+	}},
+	"IsFungibleAssetLocked": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		isLocked, err := assetexchange.IsFungibleAssetLocked(ctx, args[0])
+		return strconv.FormatBool(isLocked), err
+	}},
+	"GetFungibleAssetLockStatus": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		lockStatus, err := assetexchange.GetFungibleAssetLockStatus(ctx, args[0])
+		if err != nil {
+			return "", err
+		}
+		lockStatusBytes, err := json.Marshal(lockStatus)
+		return string(lockStatusBytes), err
+	}},
+	"IsHybridAssetLocked": {2, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		isLocked, err := assetexchange.IsHybridAssetLocked(ctx, args[0], args[1])
+		return strconv.FormatBool(isLocked), err
+	}},
+	"GetAssetTimeToRelease": {5, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		timeToRelease, err := assetexchange.GetAssetTimeToRelease(ctx, args[0], args[1], args[2], args[3], args[4])
+		return strconv.FormatUint(timeToRelease, 10), err
+	}},
+	"GetFungibleAssetTimeToRelease": {5, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		numUnits, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("Invalid number of units: %s", args[2])
+		}
+		timeToRelease, err := assetexchange.GetFungibleAssetTimeToRelease(ctx, args[0], args[1], numUnits, args[3], args[4])
+		return strconv.FormatUint(timeToRelease, 10), err
+	}},
+	"GetHybridAssetTimeToRelease": {6, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		numUnits, err := strconv.ParseUint(args[3], 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("Invalid number of units: %s", args[3])
+		}
+		timeToRelease, err := assetexchange.GetHybridAssetTimeToRelease(ctx, args[0], args[1], args[2], numUnits, args[4], args[5])
+		return strconv.FormatUint(timeToRelease, 10), err
+	}},
+	"GetTotalFungibleLockedAssets": {2, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		totalNumUnits, err := assetexchange.GetTotalFungibleLockedAssets(ctx, args[0], args[1])
+		return strconv.FormatUint(totalNumUnits, 10), err
+	}},
+	"GetAllLockedAssets": {3, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		lockedAssets, err := assetexchange.GetAllLockedAssets(ctx, args[0], args[1], args[2])
+		if err != nil {
+			return "", err
+		}
+		return marshalLockedAssets(lockedAssets)
+	}},
+	"GetAllNonFungibleLockedAssets": {3, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		lockedAssets, err := assetexchange.GetAllNonFungibleLockedAssets(ctx, args[0], args[1], args[2])
+		if err != nil {
+			return "", err
+		}
+		return marshalLockedAssets(lockedAssets)
+	}},
+	"GetAllFungibleLockedAssets": {3, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		lockedAssets, err := assetexchange.GetAllFungibleLockedAssets(ctx, args[0], args[1], args[2])
+		if err != nil {
+			return "", err
+		}
+		return marshalLockedAssets(lockedAssets)
+	}},
+	"GetExpiryGraceWindow": {0, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		graceWindowSecs, err := wutils.GetExpiryGraceWindowSecs(ctx.GetStub())
+		return strconv.FormatUint(graceWindowSecs, 10), err
+	}},
+	"GetRelayedView": {1, func(s *SmartContract, ctx contractapi.TransactionContextInterface, args []string) (string, error) {
+		return s.GetRelayedView(ctx, args[0])
+	}},
+}
+
+// appChaincodeQueries is the registry of the read-only functions of application chaincodes that remote networks can
+// query through the interop chaincode, with the number of arguments they expect. Pledges are recorded by the
+// application chaincode itself, so the pledge status queries take its ID as their first argument and are forwarded to
+// it, provided the access control policy also permits the equivalent query of that application chaincode.
+var appChaincodeQueries = map[string]int{
+	"GetAssetPledgeStatus":      5,
+	"GetTokenAssetPledgeStatus": 5,
+	"GetAssetClaimStatus":       8,
+	"GetTokenAssetClaimStatus":  8,
+}
+
+// queryAppChaincode runs a read-only function of the application chaincode whose ID is the first of the given
+// arguments, passing it the remaining arguments, after checking that the requester may query that application
+// chaincode directly
+func queryAppChaincode(s *SmartContract, ctx contractapi.TransactionContextInterface, query *common.Query, channel string, function string, args []string) (string, error) {
+	appViewAddress := &FabricViewAddress{Channel: channel, Contract: args[0], CCFunc: function, Args: args[1:]}
+	appViewAddressString := strings.Join(append([]string{channel, args[0], function}, args[1:]...), ":")
+	err := verifyAccessToCC(s, ctx, appViewAddress, appViewAddressString, query)
+	if err != nil {
+		return "", err
+	}
+	ccArgs := strArrToBytesArr(append([]string{function}, args[1:]...))
+	pbResp := ctx.GetStub().InvokeChaincode(args[0], ccArgs, "")
+	if pbResp.Status != shim.OK {
+		return "", fmt.Errorf("Application chaincode query error: %s", pbResp.GetMessage())
+	}
+	return string(pbResp.Payload), nil
+}
+
+// handleInteropQuery runs a registered read-only function of the interop chaincode, or forwards a registered query
+// to an application chaincode, on behalf of a remote network
+func handleInteropQuery(s *SmartContract, ctx contractapi.TransactionContextInterface, query *common.Query, viewAddress *FabricViewAddress) (string, error) {
+	function, args := viewAddress.CCFunc, viewAddress.Args
+	if argCount, exists := appChaincodeQueries[function]; exists {
+		if len(args) != argCount {
+			return "", fmt.Errorf("Function %s expects %d arguments, but received %d", function, argCount, len(args))
+		}
+		return queryAppChaincode(s, ctx, query, viewAddress.Channel, function, args)
+	}
+	interopQuery, exists := interopQueries[function]
+	if !exists {
+		return "", fmt.Errorf("Given function %s can not be invoked in Interop Chaincode.", function)
+	}
+	if len(args) != interopQuery.argCount {
+		return "", fmt.Errorf("Function %s expects %d arguments, but received %d", function, interopQuery.argCount, len(args))
+	}
+	resp, err := interopQuery.query(s, ctx, args)
+	if err != nil {
+		return "", err
+	}
+	return resp, nil
+}
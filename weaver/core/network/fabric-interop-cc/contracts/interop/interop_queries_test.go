@@ -0,0 +1,125 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/assetexchange/v2"
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleInteropQuery(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopCCId := "interopcc"
+	wtest.SetMockStubCCId(chaincodeStub, interopCCId)
+	interopcc := SmartContract{}
+
+	appCCId := "mycc"
+	locker := "Alice"
+	recipient := "Bob"
+	currentTimeSecs := uint64(time.Now().Unix())
+	hashLock := assetexchange.HashLock{HashMechanism: common.HashMechanism_SHA256, HashBase64: assetexchange.GenerateSHA256HashInBase64Form("abcd")}
+	requesterCert, _ := createApprover(t, "remote-client", "ecdsa")
+	requesterCertPEM, err := base64.StdEncoding.DecodeString(requesterCert)
+	require.NoError(t, err)
+	query := common.Query{RequestingNetwork: "network2", Certificate: string(requesterCertPEM)}
+	handleQuery := func(function string, args []string) (string, error) {
+		viewAddress := FabricViewAddress{Channel: "mychannel", Contract: interopCCId, CCFunc: function, Args: args}
+		return handleInteropQuery(&interopcc, ctx, &query, &viewAddress)
+	}
+
+	// Test failure with a function that is not registered, such as one that writes to the ledger
+	_, err = handleQuery("LockAsset", []string{"agreement", "lockInfo"})
+	require.EqualError(t, err, "Given function LockAsset can not be invoked in Interop Chaincode.")
+
+	// Test failure with the wrong number of arguments
+	_, err = handleQuery("GetHTLCHashByContractId", []string{})
+	require.EqualError(t, err, "Function GetHTLCHashByContractId expects 1 arguments, but received 0")
+	_, err = handleQuery("IsFungibleAssetLocked", []string{"contract1", "extra"})
+	require.EqualError(t, err, "Function IsFungibleAssetLocked expects 1 arguments, but received 2")
+
+	// Test success querying a fungible asset lock made by an application chaincode
+	fungibleAssetLockVal := assetexchange.FungibleAssetLockValue{Type: "cbdc", NumUnits: 10, Locker: locker, Recipient: recipient,
+		LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs}
+	fungibleAssetLockValBytes, _ := json.Marshal(fungibleAssetLockVal)
+	chaincodeStub.GetStateReturnsOnCall(0, fungibleAssetLockValBytes, nil)
+	resp, err := handleQuery("IsFungibleAssetLocked", []string{"contract1"})
+	require.NoError(t, err)
+	require.Equal(t, "true", resp)
+
+	// Test success querying the expiry of a non-fungible asset lock, by the ID of the chaincode that made it
+	assetLockVal := assetexchange.AssetLockValue{Locker: locker, Recipient: recipient, LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs}
+	assetLockValBytes, _ := json.Marshal(assetLockVal)
+	chaincodeStub.GetStateReturnsOnCall(1, assetLockValBytes, nil)
+	resp, err = handleQuery("GetAssetTimeToRelease", []string{appCCId, "bond", "a01", recipient, locker})
+	require.NoError(t, err)
+	require.Equal(t, strconv.FormatUint(currentTimeSecs+defaultTimeLockSecs, 10), resp)
+	_, lockKeyAttributes := chaincodeStub.CreateCompositeKeyArgsForCall(chaincodeStub.CreateCompositeKeyCallCount() - 1)
+	require.Equal(t, appCCId, lockKeyAttributes[0])
+
+	// Test success querying a lock configuration parameter
+	chaincodeStub.GetStateReturnsOnCall(2, nil, nil)
+	resp, err = handleQuery("GetExpiryGraceWindow", []string{})
+	require.NoError(t, err)
+	require.Equal(t, "0", resp)
+
+	// Test failure querying the locks between two parties without the ID of the chaincode that made them
+	_, err = handleQuery("GetAllLockedAssets", []string{recipient, locker})
+	require.EqualError(t, err, "Function GetAllLockedAssets expects 3 arguments, but received 2")
+
+	// Test failure querying the status of a pledge, forwarded to the application chaincode that recorded it, when the
+	// access control policy does not permit querying that application chaincode
+	accessControl := common.AccessControlPolicy{
+		SecurityDomain: "network2",
+		Rules: []*common.Rule{{
+			Principal:     string(requesterCertPEM),
+			PrincipalType: "certificate",
+			Read:          true,
+			Resource:      "mychannel:interopcc:*",
+		}},
+	}
+	accessControlBytes, _ := json.Marshal(&accessControl)
+	chaincodeStub.GetStateReturnsOnCall(3, accessControlBytes, nil)
+	chaincodeStub.InvokeChaincodeReturns(shim.Success([]byte("pledgeStatus")))
+	_, err = handleQuery("GetAssetPledgeStatus", []string{appCCId, "pledge1", locker, "network2", recipient})
+	require.EqualError(t, err, fmt.Sprintf("Access Control Policy DOES NOT PERMIT the request 'mychannel:mycc:GetAssetPledgeStatus:pledge1:%s:network2:%s' from 'network2:%s'", locker, recipient, string(requesterCertPEM)))
+	require.Equal(t, 0, chaincodeStub.InvokeChaincodeCallCount())
+
+	// Test success querying the status of a pledge when the access control policy permits querying the application
+	// chaincode
+	accessControl.Rules = append(accessControl.Rules, &common.Rule{
+		Principal:     string(requesterCertPEM),
+		PrincipalType: "certificate",
+		Read:          true,
+		Resource:      "mychannel:mycc:GetAssetPledgeStatus:*",
+	})
+	accessControlBytes, _ = json.Marshal(&accessControl)
+	chaincodeStub.GetStateReturnsOnCall(4, accessControlBytes, nil)
+	resp, err = handleQuery("GetAssetPledgeStatus", []string{appCCId, "pledge1", locker, "network2", recipient})
+	require.NoError(t, err)
+	require.Equal(t, "pledgeStatus", resp)
+	invokedCCId, invokedArgs, _ := chaincodeStub.InvokeChaincodeArgsForCall(chaincodeStub.InvokeChaincodeCallCount() - 1)
+	require.Equal(t, appCCId, invokedCCId)
+	require.Equal(t, [][]byte{[]byte("GetAssetPledgeStatus"), []byte("pledge1"), []byte(locker), []byte("network2"), []byte(recipient)}, invokedArgs)
+	chaincodeStub.GetStateReturnsOnCall(5, accessControlBytes, nil)
+	chaincodeStub.InvokeChaincodeReturns(shim.Error("pledge not found"))
+	_, err = handleQuery("GetAssetPledgeStatus", []string{appCCId, "pledge1", locker, "network2", recipient})
+	require.EqualError(t, err, "Application chaincode query error: pledge not found")
+
+	// Test failure with the wrong number of arguments for a forwarded query
+	_, err = handleQuery("GetAssetClaimStatus", []string{appCCId, "pledge1"})
+	require.EqualError(t, err, "Function GetAssetClaimStatus expects 8 arguments, but received 2")
+}
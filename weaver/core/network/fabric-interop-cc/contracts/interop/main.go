@@ -35,6 +35,8 @@ func init() {
 
 // InitLedger initilises ledger with data. Need the application chaincode id so the handleExtnernalRequest flow can
 // call the application chaincode.
+// Passing more than one argument enables E2E confidentiality for all views served to any network; this is deprecated in
+// favour of ConfidentialityPolicy records, which take precedence for the views they cover.
 func (s *SmartContract) InitLedger(ctx contractapi.TransactionContextInterface) error {
 	var err error
 	var confFlag string
@@ -42,6 +44,7 @@ func (s *SmartContract) InitLedger(ctx contractapi.TransactionContextInterface)
 	_, args := ctx.GetStub().GetFunctionAndParameters()
 
 	if len(args) > 1 {
+		log.Warn("Enabling E2E confidentiality through InitLedger arguments is deprecated; record a ConfidentialityPolicy instead")
 		confFlag = "true"
 	} else {
 		confFlag = "false"
@@ -9,6 +9,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"errors"
 
@@ -244,6 +245,26 @@ func (s *SmartContract) IsFungibleAssetLocked(ctx contractapi.TransactionContext
 	return assetexchange.IsFungibleAssetLocked(ctx, contractId)
 }
 
+// GetFungibleAssetLockStatus cc is used to query the number of units of a fungible asset lock that are still locked,
+// and the number of those claimed so far
+func (s *SmartContract) GetFungibleAssetLockStatus(ctx contractapi.TransactionContextInterface, contractId string) (*assetexchange.FungibleAssetLockStatus, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return nil, logThenErrorf(err.Error())
+	}
+
+	// Verify that this call comes from the same chaincode the lock instruction came from
+	lockerChaincodeID, err := ctx.GetStub().GetState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return nil, logThenErrorf(err.Error())
+	}
+	if callerChaincodeID != string(lockerChaincodeID) {
+		return nil, logThenErrorf("Illegal access: GetFungibleAssetLockStatus being called from chaincode Id %s; expected %s", callerChaincodeID, string(lockerChaincodeID))
+	}
+
+	return assetexchange.GetFungibleAssetLockStatus(ctx, contractId)
+}
+
 // ClaimFungibleAsset cc is used to record claim of a fungible asset on the ledger
 func (s *SmartContract) ClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string, claimInfoBytesBase64 string) error {
 	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
@@ -274,6 +295,40 @@ func (s *SmartContract) ClaimFungibleAsset(ctx contractapi.TransactionContextInt
 	return nil
 }
 
+// PartialClaimFungibleAsset cc is used to record claim of some of the units of a fungible asset on the ledger,
+// returning the number of units that remain locked
+func (s *SmartContract) PartialClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string, numUnits uint64, claimInfoBytesBase64 string) (uint64, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	// Verify that this call comes from the same chaincode the lock instruction came from
+	lockerChaincodeID, err := ctx.GetStub().GetState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	if callerChaincodeID != string(lockerChaincodeID) {
+		return 0, logThenErrorf("Illegal access: PartialClaimFungibleAsset being called from chaincode Id %s; expected %s", callerChaincodeID, string(lockerChaincodeID))
+	}
+
+	// Start the asset claiming process
+	remainingUnits, err := assetexchange.PartialClaimFungibleAsset(ctx, contractId, numUnits, claimInfoBytesBase64)
+	if err != nil {
+		return 0, err
+	}
+
+	// The calling chaincode Id is retained while some units remain locked
+	if remainingUnits == 0 {
+		err = ctx.GetStub().DelState(generateContractIdMapCCKey(contractId))
+		if err != nil {
+			return 0, logThenErrorf("failed to delete the calling chaincode Id associated with the contract Id %s: %+v", contractId, err.Error())
+		}
+	}
+
+	return remainingUnits, nil
+}
+
 // UnlockFungibleAsset cc is used to record unlocking of a fungible asset on the ledger
 func (s *SmartContract) UnlockFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string) error {
 	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
@@ -304,6 +359,134 @@ func (s *SmartContract) UnlockFungibleAsset(ctx contractapi.TransactionContextIn
 	return nil
 }
 
+// LockHybridAsset cc is used to record locking of a number of units of a hybrid asset on the ledger
+func (s *SmartContract) LockHybridAsset(ctx contractapi.TransactionContextInterface, hybridAssetAgreementBytesBase64 string, lockInfoBytesBase64 string) (string, error) {
+	// First, verify that this call comes from another chaincode rather than directly from the client
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	interopChaincodeID, err := ctx.GetStub().GetState(wutils.GetInteropChaincodeIDKey())
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	if callerChaincodeID == string(interopChaincodeID) {
+		return "", logThenErrorf("Illegal access: LockHybridAsset being called directly by client")
+	}
+
+	// Start the locking process now
+	contractId, err := assetexchange.LockHybridAsset(ctx, callerChaincodeID, hybridAssetAgreementBytesBase64, lockInfoBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	// Associate lock with chaincode ID of caller.
+	err = ctx.GetStub().PutState(generateContractIdMapCCKey(contractId), []byte(callerChaincodeID))
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	return contractId, nil
+}
+
+// UnlockHybridAsset cc is used to record unlocking of a number of units of a hybrid asset on the ledger
+func (s *SmartContract) UnlockHybridAsset(ctx contractapi.TransactionContextInterface, hybridAssetAgreementBytesBase64 string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	contractId, err := assetexchange.UnlockHybridAsset(ctx, callerChaincodeID, hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.GetStub().DelState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return logThenErrorf("failed to delete the calling chaincode Id associated with the contract Id %s: %+v", contractId, err.Error())
+	}
+
+	return nil
+}
+
+// IsHybridAssetLocked cc is used to query the ledger and find out if a number of units of a hybrid asset are locked or not
+func (s *SmartContract) IsHybridAssetLocked(ctx contractapi.TransactionContextInterface, hybridAssetAgreementBytesBase64 string) (bool, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+
+	return assetexchange.IsHybridAssetLocked(ctx, callerChaincodeID, hybridAssetAgreementBytesBase64)
+}
+
+// ClaimHybridAsset cc is used to record claim of a number of units of a hybrid asset on the ledger
+func (s *SmartContract) ClaimHybridAsset(ctx contractapi.TransactionContextInterface, hybridAssetAgreementBytesBase64 string, claimInfoBytesBase64 string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	contractId, err := assetexchange.ClaimHybridAsset(ctx, callerChaincodeID, hybridAssetAgreementBytesBase64, claimInfoBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.GetStub().DelState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return logThenErrorf("failed to delete the calling chaincode Id associated with the contract Id %s: %+v", contractId, err.Error())
+	}
+
+	return nil
+}
+
+// ExtendLockExpiry cc is used by the locker to extend the expiry of a lock (on any type of asset), with the recipient's signature
+func (s *SmartContract) ExtendLockExpiry(ctx contractapi.TransactionContextInterface, contractId string, newExpiryTimeSecs uint64, recipientSignatureBase64 string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	// Verify that this call comes from the same chaincode the lock instruction came from
+	lockerChaincodeID, err := ctx.GetStub().GetState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+	if callerChaincodeID != string(lockerChaincodeID) {
+		return logThenErrorf("Illegal access: ExtendLockExpiry being called from chaincode Id %s; expected %s", callerChaincodeID, string(lockerChaincodeID))
+	}
+
+	return assetexchange.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs, recipientSignatureBase64)
+}
+
+// CancelLock cc is used by the locker to release a lock (on any type of asset) before its expiry, with the recipient's signature
+func (s *SmartContract) CancelLock(ctx contractapi.TransactionContextInterface, contractId string, recipientSignatureBase64 string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	// Verify that this call comes from the same chaincode the lock instruction came from
+	lockerChaincodeID, err := ctx.GetStub().GetState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+	if callerChaincodeID != string(lockerChaincodeID) {
+		return logThenErrorf("Illegal access: CancelLock being called from chaincode Id %s; expected %s", callerChaincodeID, string(lockerChaincodeID))
+	}
+
+	err = assetexchange.CancelLock(ctx, contractId, recipientSignatureBase64)
+	if err != nil {
+		return err
+	}
+
+	err = ctx.GetStub().DelState(generateContractIdMapCCKey(contractId))
+	if err != nil {
+		return logThenErrorf("failed to delete the calling chaincode Id associated with the contract Id %s: %+v", contractId, err.Error())
+	}
+
+	return nil
+}
+
 func (s *SmartContract) GetHTLCHash(ctx contractapi.TransactionContextInterface, assetAgreementBytesBase64 string) (string, error) {
 	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
 	if err != nil {
@@ -328,4 +511,340 @@ func (s *SmartContract) GetHTLCHashPreImageByContractId(ctx contractapi.Transact
 	return assetexchange.GetHTLCHashPreImageByContractId(ctx, contractId)
 }
 
+func (s *SmartContract) GetHybridHTLCHash(ctx contractapi.TransactionContextInterface, hybridAssetAgreementBytesBase64 string) (string, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	return assetexchange.GetHybridHTLCHash(ctx, callerChaincodeID, hybridAssetAgreementBytesBase64)
+}
+
+func (s *SmartContract) GetHybridHTLCHashPreImage(ctx contractapi.TransactionContextInterface, hybridAssetAgreementBytesBase64 string) (string, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	return assetexchange.GetHybridHTLCHashPreImage(ctx, callerChaincodeID, hybridAssetAgreementBytesBase64)
+}
+
+
+
+// LockAssetBatch cc is used to record locking of a list of assets on the ledger in a single transaction
+func (s *SmartContract) LockAssetBatch(ctx contractapi.TransactionContextInterface, assetAgreementsBytesBase64 []string, lockInfosBytesBase64 []string) ([]string, error) {
+	// First, verify that this call is legal
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return []string{}, logThenErrorf(err.Error())
+	}
+	interopChaincodeID, err := ctx.GetStub().GetState(wutils.GetInteropChaincodeIDKey())
+	if err != nil {
+		return []string{}, logThenErrorf(err.Error())
+	}
+	if callerChaincodeID == string(interopChaincodeID) {
+		return []string{}, logThenErrorf("Illegal access: LockAssetBatch being called directly by client")
+	}
+
+	// Start the locking process now
+	contractIds, err := assetexchange.LockAssetBatch(ctx, callerChaincodeID, assetAgreementsBytesBase64, lockInfosBytesBase64)
+	if err != nil {
+		return []string{}, err
+	}
+
+	// Associate locks with chaincode ID of caller.
+	for _, contractId := range contractIds {
+		err = ctx.GetStub().PutState(generateContractIdMapCCKey(contractId), []byte(callerChaincodeID))
+		if err != nil {
+			return []string{}, logThenErrorf(err.Error())
+		}
+	}
+
+	return contractIds, nil
+}
+
+// LockFungibleAssetBatch cc is used to record locking of a list of groups of fungible assets on the ledger in a single transaction
+func (s *SmartContract) LockFungibleAssetBatch(ctx contractapi.TransactionContextInterface, fungibleAssetAgreementsBytesBase64 []string, lockInfosBytesBase64 []string) ([]string, error) {
+	// First, verify that this call is legal
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return []string{}, logThenErrorf(err.Error())
+	}
+	interopChaincodeID, err := ctx.GetStub().GetState(wutils.GetInteropChaincodeIDKey())
+	if err != nil {
+		return []string{}, logThenErrorf(err.Error())
+	}
+	if callerChaincodeID == string(interopChaincodeID) {
+		return []string{}, logThenErrorf("Illegal access: LockFungibleAssetBatch being called directly by client")
+	}
+
+	// Start the locking process now
+	contractIds, err := assetexchange.LockFungibleAssetBatch(ctx, callerChaincodeID, fungibleAssetAgreementsBytesBase64, lockInfosBytesBase64)
+	if err != nil {
+		return []string{}, err
+	}
+
+	// Associate locks with chaincode ID of caller.
+	for _, contractId := range contractIds {
+		err = ctx.GetStub().PutState(generateContractIdMapCCKey(contractId), []byte(callerChaincodeID))
+		if err != nil {
+			return []string{}, logThenErrorf(err.Error())
+		}
+	}
+
+	return contractIds, nil
+}
+
+// ClaimAssetBatch cc is used to record claim of a list of assets on the ledger in a single transaction; either all are claimed or none
+func (s *SmartContract) ClaimAssetBatch(ctx contractapi.TransactionContextInterface, assetAgreementsBytesBase64 []string, claimInfosBytesBase64 []string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	contractIds, err := assetexchange.ClaimAssetBatch(ctx, callerChaincodeID, assetAgreementsBytesBase64, claimInfosBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	return deleteContractIdMapCCKeys(ctx, contractIds)
+}
+
+// ClaimFungibleAssetBatch cc is used to record claim of a list of groups of fungible assets on the ledger in a single transaction; either all are claimed or none
+func (s *SmartContract) ClaimFungibleAssetBatch(ctx contractapi.TransactionContextInterface, contractIds []string, claimInfosBytesBase64 []string) error {
+	err := verifyCallerOfContractIds(ctx, "ClaimFungibleAssetBatch", contractIds)
+	if err != nil {
+		return err
+	}
+
+	// Start the asset claiming process
+	err = assetexchange.ClaimFungibleAssetBatch(ctx, contractIds, claimInfosBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	return deleteContractIdMapCCKeys(ctx, contractIds)
+}
+
+// UnlockAssetBatch cc is used to record unlocking of a list of assets on the ledger in a single transaction; either all are unlocked or none
+func (s *SmartContract) UnlockAssetBatch(ctx contractapi.TransactionContextInterface, assetAgreementsBytesBase64 []string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	contractIds, err := assetexchange.UnlockAssetBatch(ctx, callerChaincodeID, assetAgreementsBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	return deleteContractIdMapCCKeys(ctx, contractIds)
+}
+
+// UnlockFungibleAssetBatch cc is used to record unlocking of a list of groups of fungible assets on the ledger in a single transaction; either all are unlocked or none
+func (s *SmartContract) UnlockFungibleAssetBatch(ctx contractapi.TransactionContextInterface, contractIds []string) error {
+	err := verifyCallerOfContractIds(ctx, "UnlockFungibleAssetBatch", contractIds)
+	if err != nil {
+		return err
+	}
 
+	// Start the asset unlocking process
+	err = assetexchange.UnlockFungibleAssetBatch(ctx, contractIds)
+	if err != nil {
+		return err
+	}
+
+	return deleteContractIdMapCCKeys(ctx, contractIds)
+}
+
+// function to verify that every contractId in a batch was locked by the calling chaincode
+func verifyCallerOfContractIds(ctx contractapi.TransactionContextInterface, funcName string, contractIds []string) error {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	for _, contractId := range contractIds {
+		lockerChaincodeID, err := ctx.GetStub().GetState(generateContractIdMapCCKey(contractId))
+		if err != nil {
+			return logThenErrorf(err.Error())
+		}
+		if callerChaincodeID != string(lockerChaincodeID) {
+			return logThenErrorf("Illegal access: %s being called from chaincode Id %s; expected %s", funcName, callerChaincodeID, string(lockerChaincodeID))
+		}
+	}
+
+	return nil
+}
+
+// function to delete the calling chaincode Ids associated with released locks
+func deleteContractIdMapCCKeys(ctx contractapi.TransactionContextInterface, contractIds []string) error {
+	for _, contractId := range contractIds {
+		err := ctx.GetStub().DelState(generateContractIdMapCCKey(contractId))
+		if err != nil {
+			return logThenErrorf("failed to delete the calling chaincode Id associated with the contract Id %s: %+v", contractId, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// SetExpiryGraceWindow cc is used by a network admin to record the grace window (in seconds) that must elapse
+// after a lock's expiry time before the asset can be unlocked
+func (s *SmartContract) SetExpiryGraceWindow(ctx contractapi.TransactionContextInterface, graceWindowSecs uint64) error {
+	return wutils.SetExpiryGraceWindowSecs(ctx, graceWindowSecs)
+}
+
+// GetExpiryGraceWindow cc is used to query the grace window (in seconds) recorded on the ledger
+func (s *SmartContract) GetExpiryGraceWindow(ctx contractapi.TransactionContextInterface) (uint64, error) {
+	return wutils.GetExpiryGraceWindowSecs(ctx.GetStub())
+}
+
+// GetAssetTimeToRelease cc is used to query the resolved expiry of a lock on a non-fungible asset
+func (s *SmartContract) GetAssetTimeToRelease(ctx contractapi.TransactionContextInterface, assetType, assetId, recipient, locker string) (uint64, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	return assetexchange.GetAssetTimeToRelease(ctx, callerChaincodeID, assetType, assetId, recipient, locker)
+}
+
+// GetFungibleAssetTimeToRelease cc is used to query the resolved expiry of a lock on a number of units of a fungible asset
+func (s *SmartContract) GetFungibleAssetTimeToRelease(ctx contractapi.TransactionContextInterface, assetType string, numUnits uint64, recipient, locker string) (uint64, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	return assetexchange.GetFungibleAssetTimeToRelease(ctx, callerChaincodeID, assetType, numUnits, recipient, locker)
+}
+
+// GetHybridAssetTimeToRelease cc is used to query the resolved expiry of a lock on a number of units of a hybrid asset
+func (s *SmartContract) GetHybridAssetTimeToRelease(ctx contractapi.TransactionContextInterface, assetType, assetId string, numUnits uint64, recipient, locker string) (uint64, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	return assetexchange.GetHybridAssetTimeToRelease(ctx, callerChaincodeID, assetType, assetId, numUnits, recipient, locker)
+}
+
+// GetAllAssetsLockedUntil cc is used to query the caller's locks that expire at or before the given time; it returns
+// the JSON encoding of the locks
+func (s *SmartContract) GetAllAssetsLockedUntil(ctx contractapi.TransactionContextInterface, lockExpiryTimeSecs uint64) (string, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	lockedAssets, err := assetexchange.GetAllAssetsLockedUntil(ctx, callerChaincodeID, lockExpiryTimeSecs)
+	if err != nil {
+		return "", err
+	}
+	return marshalLockedAssets(lockedAssets)
+}
+
+// GetTotalFungibleLockedAssets cc is used to query the total number of units of a fungible asset type that are locked
+func (s *SmartContract) GetTotalFungibleLockedAssets(ctx contractapi.TransactionContextInterface, assetType string) (uint64, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	return assetexchange.GetTotalFungibleLockedAssets(ctx, callerChaincodeID, assetType)
+}
+
+// GetAllLockedAssets cc is used to query the locks (on both non-fungible and fungible assets) made by 'locker' for
+// 'recipient' (a blank party stands for the caller); it returns the JSON encoding of the locks
+func (s *SmartContract) GetAllLockedAssets(ctx contractapi.TransactionContextInterface, recipient, locker string) (string, error) {
+	return getLockedAssetsByParties(ctx, assetexchange.GetAllLockedAssets, recipient, locker)
+}
+
+// GetAllNonFungibleLockedAssets cc is used to query the locks on non-fungible assets made by 'locker' for 'recipient'
+// (a blank party stands for the caller); it returns the JSON encoding of the locks
+func (s *SmartContract) GetAllNonFungibleLockedAssets(ctx contractapi.TransactionContextInterface, recipient, locker string) (string, error) {
+	return getLockedAssetsByParties(ctx, assetexchange.GetAllNonFungibleLockedAssets, recipient, locker)
+}
+
+// GetAllFungibleLockedAssets cc is used to query the locks on groups of fungible assets made by 'locker' for
+// 'recipient' (a blank party stands for the caller); it returns the JSON encoding of the locks
+func (s *SmartContract) GetAllFungibleLockedAssets(ctx contractapi.TransactionContextInterface, recipient, locker string) (string, error) {
+	return getLockedAssetsByParties(ctx, assetexchange.GetAllFungibleLockedAssets, recipient, locker)
+}
+
+// function to run a query of the locks made by the calling chaincode between two parties, returning their JSON encoding
+func getLockedAssetsByParties(ctx contractapi.TransactionContextInterface,
+	queryFunc func(contractapi.TransactionContextInterface, string, string, string) ([]assetexchange.LockedAsset, error),
+	recipient, locker string) (string, error) {
+	callerChaincodeID, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	lockedAssets, err := queryFunc(ctx, callerChaincodeID, recipient, locker)
+	if err != nil {
+		return "", err
+	}
+	return marshalLockedAssets(lockedAssets)
+}
+
+// function to return the JSON encoding of a list of locks
+func marshalLockedAssets(lockedAssets []assetexchange.LockedAsset) (string, error) {
+	lockedAssetsJSON, err := json.Marshal(lockedAssets)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	return string(lockedAssetsJSON), nil
+}
+
+// GetExpiredLocks cc is used to query a page of the locks whose expiry (plus the grace window) has elapsed, e.g., by a
+// locker wallet looking for assets it can take back; it returns the JSON encoding of the expired locks in the page
+// along with the bookmark to fetch the next page
+func (s *SmartContract) GetExpiredLocks(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string) (string, error) {
+	expiredLocksPage, err := assetexchange.GetExpiredLocks(ctx, pageSize, bookmark)
+	if err != nil {
+		return "", err
+	}
+	expiredLocksPageJSON, err := json.Marshal(expiredLocksPage)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	return string(expiredLocksPageJSON), nil
+}
+
+// ReportExpiredLocks cc is used by a network admin to report up to 'maxCount' locks whose expiry (plus the grace window)
+// has elapsed in a single transaction, so that their lockers can be notified to take the assets back; as a transaction
+// can only carry one event, an 'AssetLockEvents' event lists all the reported locks, and their contractIds are returned
+func (s *SmartContract) ReportExpiredLocks(ctx contractapi.TransactionContextInterface, maxCount uint32) ([]string, error) {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return []string{}, fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return []string{}, fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	expiredLocks, err := assetexchange.ReportExpiredLocks(ctx, maxCount)
+	if err != nil {
+		return []string{}, err
+	}
+
+	contractIds := []string{}
+	for _, expiredLock := range expiredLocks {
+		contractIds = append(contractIds, expiredLock.ContractId)
+	}
+
+	return contractIds, nil
+}
+
+// BackfillLockIndexes cc is used by a network admin to add the locks made by the given chaincode before the lock indexes
+// were maintained to the lock indexes, one page of lock contracts at a time; it returns the bookmark to backfill the
+// next page, which is empty once all pages are backfilled
+func (s *SmartContract) BackfillLockIndexes(ctx contractapi.TransactionContextInterface, chaincodeId string, pageSize int32, bookmark string) (string, error) {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return "", fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return "", fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	return assetexchange.BackfillLockIndexes(ctx, chaincodeId, pageSize, bookmark)
+}
@@ -7,9 +7,20 @@
 package main
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"sort"
+	"strings"
 	"testing"
 
 	"time"
@@ -17,9 +28,14 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/assetexchange/v2"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils/mocks"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
 	mspProtobuf "github.com/hyperledger/fabric-protos-go/msp"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
 )
 
@@ -85,10 +101,16 @@ func TestLockAsset(t *testing.T) {
 	// chaincodeStub.GetStateReturns should return nil to be able to lock the asset
 	chaincodeStub.GetStateReturnsOnCall(0, []byte("interopcc"), nil)
 	chaincodeStub.GetStateReturnsOnCall(1, nil, nil)
+	chaincodeStub.CreateCompositeKeyReturns("assetLockKey", nil)
+	chaincodeStub.SplitCompositeKeyReturns("AssetExchangeContract", []string{localCCId, assetType, assetId}, nil)
 	// Test success with asset agreement specified properly
-	_, err := interopcc.LockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	contractId, err := interopcc.LockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
 	require.NoError(t, err)
 	fmt.Println("Test success as expected since the agreement and lock information are specified properly")
+	// the lock event is left to the application chaincode, as the events set by a chaincode called through
+	// 'InvokeChaincode' are dropped
+	require.Equal(t, 0, chaincodeStub.SetEventCallCount())
+	require.NotEmpty(t, contractId)
 
 	assetLockVal := assetexchange.AssetLockValue{Locker: locker, Recipient: recipient}
 	assetLockValBytes, _ := json.Marshal(assetLockVal)
@@ -105,8 +127,8 @@ func TestLockAsset(t *testing.T) {
 		HashBase64: []byte(hashBase64),
 		// lock for next 5 mintues
 		ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs,
-		// TimeSpec of AssetLockHTLC_DURATION is not currently supported
-		TimeSpec: common.TimeSpec_DURATION,
+		// TimeSpec that is not one of EPOCH or DURATION
+		TimeSpec: common.TimeSpec(99),
 	}
 	lockInfoHTLCBytes, _ = proto.Marshal(lockInfoHTLC)
 	lockInfo = &common.AssetLock{
@@ -118,6 +140,31 @@ func TestLockAsset(t *testing.T) {
 	_, err = interopcc.LockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
 	require.Error(t, err)
 	log.Info(fmt.Println("Test failed as expected with error:", err))
+
+	// Test success with a DURATION lock, whose expiry is resolved from the transaction timestamp at lock time
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+	lockInfoHTLC = &common.AssetLockHTLC{
+		HashMechanism: common.HashMechanism_SHA256,
+		HashBase64: []byte(hashBase64),
+		// lock for next 5 mintues
+		ExpiryTimeSecs: defaultTimeLockSecs,
+		TimeSpec: common.TimeSpec_DURATION,
+	}
+	lockInfoHTLCBytes, _ = proto.Marshal(lockInfoHTLC)
+	lockInfo = &common.AssetLock{
+		LockMechanism: common.LockMechanism_HTLC,
+		LockInfo:      lockInfoHTLCBytes,
+	}
+	lockInfoBytes, _ = proto.Marshal(lockInfo)
+	_, err = interopcc.LockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.NoError(t, err)
+	// the asset lock is the first of the seven entries written by LockAsset (with its four lock index entries)
+	_, assetLockValBytes = chaincodeStub.PutStateArgsForCall(chaincodeStub.PutStateCallCount() - 7)
+	assetLockVal = assetexchange.AssetLockValue{}
+	json.Unmarshal(assetLockValBytes, &assetLockVal)
+	require.Equal(t, common.TimeSpec_EPOCH, assetLockVal.TimeSpec)
+	require.Equal(t, currentTimeSecs + defaultTimeLockSecs, assetLockVal.ExpiryTimeSecs)
+	fmt.Println("Test success as expected since the DURATION lock expiry is resolved to an EPOCH time")
 }
 
 func TestUnlockAsset(t *testing.T) {
@@ -168,6 +215,8 @@ func TestUnlockAsset(t *testing.T) {
 	assetLockVal := assetexchange.AssetLockValue{ContractId: contractId, Locker: locker, Recipient: recipient}
 	assetLockValBytes, _ := json.Marshal(assetLockVal)
 	chaincodeStub.GetStateReturns(assetLockValBytes, nil)
+	// no expiry grace window recorded on the ledger
+	chaincodeStub.GetStateReturnsOnCall(3, nil, nil)
 	// Test success with asset agreement specified properly
 	err = interopcc.UnlockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes))
 	require.NoError(t, err)
@@ -506,6 +555,94 @@ func TestHashSHA512(t *testing.T) {
 	log.Info(fmt.Println("Test success as expected since the hash mechanism is specified properly."))
 }
 
+func TestHashKeccak256AndSHA3_256(t *testing.T) {
+	// the hashes match the standard test vectors, so that a preimage can unlock HTLCs on Ethereum networks as well
+	keccak256Hash, _ := base64.StdEncoding.DecodeString(assetexchange.GenerateKeccak256HashInBase64Form("abc"))
+	require.Equal(t, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45", hex.EncodeToString(keccak256Hash))
+	sha3_256Hash, _ := base64.StdEncoding.DecodeString(assetexchange.GenerateSHA3_256HashInBase64Form("abc"))
+	require.Equal(t, "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532", hex.EncodeToString(sha3_256Hash))
+
+	preimage := "abcd"
+	preimageBase64 := base64.StdEncoding.EncodeToString([]byte(preimage))
+	for hashMechanism, hashBase64 := range map[common.HashMechanism]string{
+		common.HashMechanism_KECCAK256: assetexchange.GenerateKeccak256HashInBase64Form(preimage),
+		common.HashMechanism_SHA3_256:  assetexchange.GenerateSHA3_256HashInBase64Form(preimage),
+	} {
+		ctx, chaincodeStub := wtest.PrepMockStub()
+		localCCId := "mycc"
+		wtest.SetMockStubCCId(chaincodeStub, localCCId)
+		interopcc := SmartContract{}
+
+		assetType := "bond"
+		assetId := "A001"
+		recipient := getTxCreatorECertBase64()
+		locker := "Alice"
+		currentTimeSecs := uint64(time.Now().Unix())
+		chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+
+		assetAgreement := &common.AssetExchangeAgreement{
+			AssetType: assetType,
+			Id:        assetId,
+			Recipient: recipient,
+			Locker:    locker,
+		}
+		assetAgreementBytes, _ := proto.Marshal(assetAgreement)
+		_, contractId, _ := assetexchange.GenerateAssetLockKeyAndContractId(ctx, localCCId, assetAgreement)
+
+		hashLock := assetexchange.HashLock{HashMechanism: hashMechanism, HashBase64: hashBase64}
+		assetLockVal := assetexchange.AssetLockValue{ContractId: contractId, Locker: locker, Recipient: recipient, LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs}
+		assetLockValBytes, _ := json.Marshal(assetLockVal)
+
+		// the hash mechanism is reported by GetHTLCHash
+		chaincodeStub.GetStateReturnsOnCall(0, assetLockValBytes, nil)
+		retVal, err := interopcc.GetHTLCHash(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+		require.NoError(t, err)
+		lockInfoVal := assetexchange.HashLock{}
+		require.NoError(t, json.Unmarshal([]byte(retVal), &lockInfoVal))
+		require.Equal(t, hashMechanism, lockInfoVal.HashMechanism)
+		require.Equal(t, hashBase64, lockInfoVal.HashBase64)
+
+		// Test failure with a wrong preimage
+		chaincodeStub.GetStateReturnsOnCall(1, assetLockValBytes, nil)
+		wrongClaimInfoHTLC := &common.AssetClaimHTLC{
+			HashMechanism:      hashMechanism,
+			HashPreimageBase64: []byte(base64.StdEncoding.EncodeToString([]byte("abce"))),
+		}
+		wrongClaimInfoHTLCBytes, _ := proto.Marshal(wrongClaimInfoHTLC)
+		wrongClaimInfoBytes, _ := proto.Marshal(&common.AssetClaim{LockMechanism: common.LockMechanism_HTLC, ClaimInfo: wrongClaimInfoHTLCBytes})
+		err = interopcc.ClaimAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(wrongClaimInfoBytes))
+		require.EqualError(t, err, "cannot claim asset associated with contractId " + contractId + " as the hash preimage is not matching")
+
+		// Test success with the right preimage
+		chaincodeStub.GetStateReturnsOnCall(2, assetLockValBytes, nil)
+		claimInfoHTLC := &common.AssetClaimHTLC{
+			HashMechanism:      hashMechanism,
+			HashPreimageBase64: []byte(preimageBase64),
+		}
+		claimInfoHTLCBytes, _ := proto.Marshal(claimInfoHTLC)
+		claimInfoBytes, _ := proto.Marshal(&common.AssetClaim{LockMechanism: common.LockMechanism_HTLC, ClaimInfo: claimInfoHTLCBytes})
+		err = interopcc.ClaimAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(claimInfoBytes))
+		require.NoError(t, err)
+	}
+
+	// Test failure locking with an unsupported hash mechanism
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	wtest.SetMockStubCCId(chaincodeStub, "mycc")
+	interopcc := SmartContract{}
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	chaincodeStub.GetStateReturnsOnCall(0, []byte("interopcc"), nil)
+	lockInfoHTLCBytes, _ := proto.Marshal(&common.AssetLockHTLC{
+		HashMechanism:  common.HashMechanism(9),
+		HashBase64:     []byte(assetexchange.GenerateSHA256HashInBase64Form(preimage)),
+		ExpiryTimeSecs: uint64(time.Now().Unix()) + defaultTimeLockSecs,
+		TimeSpec:       common.TimeSpec_EPOCH,
+	})
+	lockInfoBytes, _ := proto.Marshal(&common.AssetLock{LockMechanism: common.LockMechanism_HTLC, LockInfo: lockInfoHTLCBytes})
+	assetAgreementBytes, _ := proto.Marshal(&common.AssetExchangeAgreement{AssetType: "bond", Id: "A001", Recipient: "Bob", Locker: getTxCreatorECertBase64()})
+	_, err := interopcc.LockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.EqualError(t, err, "hashMechanism 9 is not supported currently")
+}
+
 func TestGetHTLCHash(t *testing.T) {
 	ctx, chaincodeStub := wtest.PrepMockStub()
 	localCCId := "mycc"
@@ -687,6 +824,8 @@ func TestUnlockAssetUsingContractId(t *testing.T) {
 	assetLockVal = assetexchange.AssetLockValue{ContractId: contractId, Locker: locker, Recipient: recipient, LockInfo: lockInfoVal, ExpiryTimeSecs: currentTimeSecs - defaultTimeLockSecs}
 	assetLockValBytes, _ = json.Marshal(assetLockVal)
 	chaincodeStub.GetStateReturnsOnCall(19, assetLockValBytes, nil)
+	// no expiry grace window recorded on the ledger
+	chaincodeStub.GetStateReturnsOnCall(20, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(0, fmt.Errorf("unable to delete asset with key %s from world state", assetLockKey))
 	err = interopcc.UnlockAssetUsingContractId(ctx, contractId)
 	require.Error(t, err)
@@ -695,9 +834,10 @@ func TestUnlockAssetUsingContractId(t *testing.T) {
 	fmt.Printf("Test failed as expected with error: %s\n", err)
 
 	// Test failure with DelState failing on contractId
-	chaincodeStub.GetStateReturnsOnCall(20, []byte(localCCId), nil)
-	chaincodeStub.GetStateReturnsOnCall(21, assetLockKeyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(22, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(21, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(22, assetLockKeyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(23, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(24, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(1, nil)
 	chaincodeStub.DelStateReturnsOnCall(2, fmt.Errorf("unable to delete contractId from world state"))
 	err = interopcc.UnlockAssetUsingContractId(ctx, contractId)
@@ -707,9 +847,10 @@ func TestUnlockAssetUsingContractId(t *testing.T) {
 	fmt.Printf("Test failed as expected with error: %s\n", err)
 
 	// Test success with asset being unlocked using contractId
-	chaincodeStub.GetStateReturnsOnCall(23, []byte(localCCId), nil)
-	chaincodeStub.GetStateReturnsOnCall(24, assetLockKeyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(25, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(25, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(26, assetLockKeyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(27, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(28, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(3, nil)
 	chaincodeStub.DelStateReturnsOnCall(4, nil)
 	err = interopcc.UnlockAssetUsingContractId(ctx, contractId)
@@ -1001,8 +1142,8 @@ func TestLockFungibleAsset(t *testing.T) {
 		HashBase64: []byte(hashBase64),
 		// lock for next 5 mintues
 		ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs,
-		// TimeSpec of AssetLockHTLC_DURATION is not currently supported
-		TimeSpec: common.TimeSpec_DURATION,
+		// TimeSpec that is not one of EPOCH or DURATION
+		TimeSpec: common.TimeSpec(99),
 	}
 	lockInfoHTLCBytes, _ := proto.Marshal(lockInfoHTLC)
 	lockInfo := &common.AssetLock{
@@ -1012,7 +1153,7 @@ func TestLockFungibleAsset(t *testing.T) {
 	lockInfoBytes, _ := proto.Marshal(lockInfo)
 	_, err := interopcc.LockFungibleAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
 	require.Error(t, err)
-	require.EqualError(t, err, "only EPOCH time is supported at present")
+	require.EqualError(t, err, "unsupported time spec: 99")
 	fmt.Printf("Test failed as expected with error: %s\n", err)
 
 	// Test failure with GetState(contractId) fail to read the world state
@@ -1521,6 +1662,8 @@ func TestUnlockFungibleAsset(t *testing.T) {
 	assetLockValBytes, _ = json.Marshal(assetLockVal)
 	chaincodeStub.GetStateReturnsOnCall(6, []byte(localCCId), nil)
 	chaincodeStub.GetStateReturnsOnCall(7, assetLockValBytes, nil)
+	// no expiry grace window recorded on the ledger
+	chaincodeStub.GetStateReturnsOnCall(8, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(0, fmt.Errorf("unable to delete contractId from world state"))
 	err = interopcc.UnlockFungibleAsset(ctx, contractId)
 	require.Error(t, err)
@@ -1529,8 +1672,9 @@ func TestUnlockFungibleAsset(t *testing.T) {
 	fmt.Printf("Test failed as expected with error: %s\n", err)
 
 	// Test success with fungible asset being unlocked using contractId
-	chaincodeStub.GetStateReturnsOnCall(8, []byte(localCCId), nil)
-	chaincodeStub.GetStateReturnsOnCall(9, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(9, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(10, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(11, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(1, nil)
 	err = interopcc.UnlockFungibleAsset(ctx, contractId)
 	require.NoError(t, err)
@@ -1601,6 +1745,8 @@ func TestUnlockFungibleAssetUsingContractId(t *testing.T) {
 	chaincodeStub.GetStateReturnsOnCall(9, []byte(localCCId), nil)
 	chaincodeStub.GetStateReturnsOnCall(10, nil, nil)
 	chaincodeStub.GetStateReturnsOnCall(11, assetLockValBytes, nil)
+	// no expiry grace window recorded on the ledger
+	chaincodeStub.GetStateReturnsOnCall(12, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(0, fmt.Errorf("unable to delete contractId from world state"))
 	err = interopcc.UnlockAssetUsingContractId(ctx, contractId)
 	require.Error(t, err)
@@ -1609,11 +1755,1034 @@ func TestUnlockFungibleAssetUsingContractId(t *testing.T) {
 	fmt.Printf("Test failed as expected with error: %s\n", err)
 
 	// Test success with asset being unlocked using contractId
-	chaincodeStub.GetStateReturnsOnCall(12, []byte(localCCId), nil)
-	chaincodeStub.GetStateReturnsOnCall(13, nil, nil)
-	chaincodeStub.GetStateReturnsOnCall(14, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(13, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(14, nil, nil)
+	chaincodeStub.GetStateReturnsOnCall(15, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(16, nil, nil)
 	chaincodeStub.DelStateReturnsOnCall(1, nil)
 	err = interopcc.UnlockAssetUsingContractId(ctx, contractId)
 	require.NoError(t, err)
 	fmt.Printf("Test success as expected since a valid contractId is specified.\n")
 }
+
+func TestExpiryGraceWindow(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	// Case when caller is not an admin
+	err := interopcc.SetExpiryGraceWindow(ctx, 60)
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	// Set caller to be admin now
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+	err = interopcc.SetExpiryGraceWindow(ctx, 60)
+	require.NoError(t, err)
+	key, val := chaincodeStub.PutStateArgsForCall(0)
+	require.Equal(t, "expiryGraceWindowSecs", key)
+	require.Equal(t, "60", string(val))
+
+	// Grace window defaults to 0 when not recorded on the ledger
+	graceWindowSecs, err := interopcc.GetExpiryGraceWindow(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), graceWindowSecs)
+
+	assetType := "cbdc"
+	numUnits := uint64(10)
+	locker := getTxCreatorECertBase64()
+	recipient := "Bob"
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+
+	assetAgreement := &common.FungibleAssetExchangeAgreement{
+		AssetType: assetType,
+		NumUnits:  numUnits,
+		Locker:    locker,
+		Recipient: recipient,
+	}
+	contractId := assetexchange.GenerateFungibleAssetLockContractId(ctx, localCCId, assetAgreement)
+	hashLock := assetexchange.HashLock{HashMechanism: common.HashMechanism_SHA256, HashBase64: assetexchange.GenerateSHA256HashInBase64Form("abcd")}
+	// lock expired a few seconds ago
+	assetLockVal := assetexchange.FungibleAssetLockValue{Type: assetType, NumUnits: numUnits, Locker: locker, Recipient: recipient,
+		LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs - 10}
+	assetLockValBytes, _ := json.Marshal(assetLockVal)
+
+	// Test failure for unlock exercised within the grace window
+	chaincodeStub.GetStateReturnsOnCall(1, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(2, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(3, []byte("3600"), nil)
+	err = interopcc.UnlockFungibleAsset(ctx, contractId)
+	require.EqualError(t, err, "cannot unlock asset associated with the contractId "+contractId+" as the expiry time is not yet elapsed")
+	fmt.Printf("Test failed as expected with error: %s\n", err)
+
+	// Test success for unlock exercised after the grace window
+	chaincodeStub.GetStateReturnsOnCall(4, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(5, assetLockValBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(6, []byte("5"), nil)
+	err = interopcc.UnlockFungibleAsset(ctx, contractId)
+	require.NoError(t, err)
+	fmt.Printf("Test success as expected since the grace window has elapsed.\n")
+}
+
+func TestUnsupportedLockTimeSpec(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	assetAgreement := &common.FungibleAssetExchangeAgreement{
+		AssetType: "cbdc",
+		NumUnits:  10,
+		Locker:    "",
+		Recipient: "Bob",
+	}
+	assetAgreementBytes, _ := proto.Marshal(assetAgreement)
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	lockInfoHTLC := &common.AssetLockHTLC{
+		HashMechanism:  common.HashMechanism_SHA256,
+		HashBase64:     []byte(assetexchange.GenerateSHA256HashInBase64Form("abcd")),
+		ExpiryTimeSecs: 100,
+		TimeSpec:       common.TimeSpec(2),
+	}
+	lockInfoHTLCBytes, _ := proto.Marshal(lockInfoHTLC)
+	lockInfoBytes, _ := proto.Marshal(&common.AssetLock{LockMechanism: common.LockMechanism_HTLC, LockInfo: lockInfoHTLCBytes})
+
+	// Test failure with the time spec number reserved for the declined block height expiry
+	chaincodeStub.GetStateReturnsOnCall(0, []byte("interopcc"), nil)
+	_, err := interopcc.LockFungibleAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.EqualError(t, err, "unsupported time spec: 2")
+	fmt.Printf("Test failed as expected with error: %s\n", err)
+}
+
+// function that creates a certificate for a signature lock approver, returning it in base64 PEM form along with the signing key
+func createApprover(t *testing.T, commonName string, keyType string) (string, interface{}) {
+	template := x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		SerialNumber: big.NewInt(1337),
+	}
+	var certBytes []byte
+	var key interface{}
+	var err error
+	if keyType == "ed25519" {
+		var privKey *ed25519.PrivateKey
+		certBytes, privKey, err = createED25519CertAndKeyFromTemplate(template)
+		key = *privKey
+	} else {
+		certBytes, key, err = createECDSACertAndKeyFromTemplate(template)
+	}
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes})
+	return base64.StdEncoding.EncodeToString(certPEM), key
+}
+
+// function that signs the claim message of a contractId (see 'GenerateClaimSignatureLockMessage') on behalf of a
+// signature lock approver
+func signContractId(t *testing.T, contractId string, key interface{}) []byte {
+	message := assetexchange.GenerateClaimSignatureLockMessage(contractId)
+	if privKey, isEd25519 := key.(ed25519.PrivateKey); isEd25519 {
+		return ed25519.Sign(privKey, message)
+	}
+	messageHash := sha256.Sum256(message)
+	signature, err := ecdsa.SignASN1(rand.Reader, key.(*ecdsa.PrivateKey), messageHash[:])
+	require.NoError(t, err)
+	return signature
+}
+
+func TestSignatureLock(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	assetType := "cbdc"
+	numUnits := uint64(10)
+	locker := "Alice"
+	recipient := getTxCreatorECertBase64()
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+
+	// 2-of-3 approvers, using both ECDSA and Ed25519 keys
+	arbiter, arbiterKey := createApprover(t, "arbiter.example.com", "ecdsa")
+	escrow, escrowKey := createApprover(t, "escrow.example.com", "ed25519")
+	auditor, _ := createApprover(t, "auditor.example.com", "ecdsa")
+	outsider, outsiderKey := createApprover(t, "outsider.example.com", "ecdsa")
+	approvers := []string{arbiter, escrow, auditor}
+
+	assetAgreement := &common.FungibleAssetExchangeAgreement{
+		AssetType: assetType,
+		NumUnits:  numUnits,
+		Locker:    getTxCreatorECertBase64(),
+		Recipient: "Bob",
+	}
+	assetAgreementBytes, _ := proto.Marshal(assetAgreement)
+
+	// Test failure with a threshold that cannot be met by the approvers
+	lockInfoSignature := &common.AssetLockSignature{
+		Approvers: approvers,
+		Threshold: 4,
+		// lock for next 5 mintues
+		ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs,
+		TimeSpec: common.TimeSpec_EPOCH,
+	}
+	lockInfoSignatureBytes, _ := proto.Marshal(lockInfoSignature)
+	lockInfo := &common.AssetLock{
+		LockMechanism: common.LockMechanism_SIGNATURE,
+		LockInfo:      lockInfoSignatureBytes,
+	}
+	lockInfoBytes, _ := proto.Marshal(lockInfo)
+	chaincodeStub.GetStateReturnsOnCall(0, []byte("interopcc"), nil)
+	_, err := interopcc.LockFungibleAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.EqualError(t, err, "invalid threshold 4 for 3 approvers in the signature lock")
+	fmt.Printf("Test failed as expected with error: %s\n", err)
+
+	// Test success with a valid signature lock
+	lockInfoSignature.Threshold = 2
+	lockInfoSignatureBytes, _ = proto.Marshal(lockInfoSignature)
+	lockInfo.LockInfo = lockInfoSignatureBytes
+	lockInfoBytes, _ = proto.Marshal(lockInfo)
+	chaincodeStub.GetStateReturnsOnCall(1, []byte("interopcc"), nil)
+	chaincodeStub.GetStateReturnsOnCall(2, nil, nil)
+	_, err = interopcc.LockFungibleAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.NoError(t, err)
+	_, assetLockValBytes := chaincodeStub.PutStateArgsForCall(0)
+	assetLockVal := assetexchange.FungibleAssetLockValue{}
+	json.Unmarshal(assetLockValBytes, &assetLockVal)
+	lockInfoBytes, _ = json.Marshal(assetLockVal.LockInfo)
+	signatureLock := assetexchange.SignatureLock{}
+	json.Unmarshal(lockInfoBytes, &signatureLock)
+	require.Equal(t, assetexchange.SignatureLock{Approvers: approvers, Threshold: 2}, signatureLock)
+	fmt.Println("Test success as expected since the signature lock is specified properly")
+
+	// Claims are made against a lock held for the transaction creator
+	claimAgreement := &common.FungibleAssetExchangeAgreement{
+		AssetType: assetType,
+		NumUnits:  numUnits,
+		Locker:    locker,
+		Recipient: recipient,
+	}
+	contractId := assetexchange.GenerateFungibleAssetLockContractId(ctx, localCCId, claimAgreement)
+	assetLockVal = assetexchange.FungibleAssetLockValue{Type: assetType, NumUnits: numUnits, Locker: locker, Recipient: recipient,
+		LockInfo: signatureLock, ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs}
+	assetLockValBytes, _ = json.Marshal(assetLockVal)
+	createClaimInfo := func(signatures ...*common.ApproverSignature) string {
+		claimInfoSignatureBytes, _ := proto.Marshal(&common.AssetClaimSignature{Signatures: signatures})
+		claimInfo := &common.AssetClaim{
+			LockMechanism: common.LockMechanism_SIGNATURE,
+			ClaimInfo:     claimInfoSignatureBytes,
+		}
+		claimInfoBytes, _ := proto.Marshal(claimInfo)
+		return base64.StdEncoding.EncodeToString(claimInfoBytes)
+	}
+	arbiterSignature := &common.ApproverSignature{Approver: arbiter, Signature: signContractId(t, contractId, arbiterKey)}
+
+	// Test failure with a single approval, a repeated approval, an approval from a non-approver, a forged approval, and
+	// an approval signing the bare contractId instead of its claim message
+	bareContractIdSignature, _ := base64.StdEncoding.DecodeString(signLockAmendment(t, []byte(contractId), escrowKey))
+	chaincodeStub.GetStateReturnsOnCall(3, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(4, assetLockValBytes, nil)
+	err = interopcc.ClaimFungibleAsset(ctx, contractId, createClaimInfo(
+		arbiterSignature,
+		arbiterSignature,
+		&common.ApproverSignature{Approver: outsider, Signature: signContractId(t, contractId, outsiderKey)},
+		&common.ApproverSignature{Approver: auditor, Signature: signContractId(t, contractId, outsiderKey)},
+		&common.ApproverSignature{Approver: escrow, Signature: bareContractIdSignature},
+	))
+	require.EqualError(t, err, "cannot claim asset associated with contractId "+contractId+" as it is not signed by enough approvers")
+	fmt.Printf("Test failed as expected with error: %s\n", err)
+
+	// Test failure with a hash preimage claim against a signature lock
+	claimInfoHTLCBytes, _ := proto.Marshal(&common.AssetClaimHTLC{HashPreimageBase64: []byte(base64.StdEncoding.EncodeToString([]byte("abcd")))})
+	claimInfoHTLC := &common.AssetClaim{
+		LockMechanism: common.LockMechanism_HTLC,
+		ClaimInfo:     claimInfoHTLCBytes,
+	}
+	claimInfoHTLCBase64Bytes, _ := proto.Marshal(claimInfoHTLC)
+	chaincodeStub.GetStateReturnsOnCall(5, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(6, assetLockValBytes, nil)
+	err = interopcc.ClaimFungibleAsset(ctx, contractId, base64.StdEncoding.EncodeToString(claimInfoHTLCBase64Bytes))
+	require.EqualError(t, err, "cannot claim asset associated with contractId "+contractId+" as the hash preimage is not matching")
+	fmt.Printf("Test failed as expected with error: %s\n", err)
+
+	// Test success with approvals from 2 of the 3 approvers
+	chaincodeStub.GetStateReturnsOnCall(7, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(8, assetLockValBytes, nil)
+	err = interopcc.ClaimFungibleAsset(ctx, contractId, createClaimInfo(
+		arbiterSignature,
+		&common.ApproverSignature{Approver: escrow, Signature: signContractId(t, contractId, escrowKey)},
+	))
+	require.NoError(t, err)
+	fmt.Printf("Test success as expected since the claim is signed by enough approvers.\n")
+}
+
+func TestLockAssetBatch(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	recipient := "Bob"
+	locker := getTxCreatorECertBase64()
+	hashBase64 := assetexchange.GenerateSHA256HashInBase64Form("abcd")
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	// distinct assets must map to distinct lock keys
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return fmt.Sprintf("%s%v", objectType, attributes), nil
+	})
+
+	lockInfoHTLC := &common.AssetLockHTLC{
+		HashMechanism:  common.HashMechanism_SHA256,
+		HashBase64:     []byte(hashBase64),
+		ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs,
+		TimeSpec:       common.TimeSpec_EPOCH,
+	}
+	lockInfoHTLCBytes, _ := proto.Marshal(lockInfoHTLC)
+	lockInfo := &common.AssetLock{
+		LockMechanism: common.LockMechanism_HTLC,
+		LockInfo:      lockInfoHTLCBytes,
+	}
+	lockInfoBytes, _ := proto.Marshal(lockInfo)
+	lockInfoBytesBase64 := base64.StdEncoding.EncodeToString(lockInfoBytes)
+
+	assetAgreementsBytesBase64 := []string{}
+	for _, assetId := range []string{"A001", "A002"} {
+		assetAgreement := &common.AssetExchangeAgreement{
+			AssetType: "bond",
+			Id:        assetId,
+			Recipient: recipient,
+			Locker:    locker,
+		}
+		assetAgreementBytes, _ := proto.Marshal(assetAgreement)
+		assetAgreementsBytesBase64 = append(assetAgreementsBytesBase64, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+	}
+
+	// Test failure with mismatched numbers of asset agreements and lock infos
+	chaincodeStub.GetStateReturnsOnCall(0, []byte("interopcc"), nil)
+	_, err := interopcc.LockAssetBatch(ctx, assetAgreementsBytesBase64, []string{lockInfoBytesBase64})
+	require.EqualError(t, err, "number of asset agreements (2) and lock infos (1) do not match")
+
+	// Test failure with the same asset appearing twice in the batch
+	chaincodeStub.GetStateReturnsOnCall(1, []byte("interopcc"), nil)
+	chaincodeStub.GetStateReturnsOnCall(2, nil, nil)
+	chaincodeStub.GetStateReturnsOnCall(3, nil, nil)
+	_, err = interopcc.LockAssetBatch(ctx, []string{assetAgreementsBytesBase64[0], assetAgreementsBytesBase64[0]}, []string{lockInfoBytesBase64, lockInfoBytesBase64})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "appears more than once in the batch")
+
+	// Test failure when one of the assets in the batch is already locked
+	assetLockVal := assetexchange.AssetLockValue{Locker: locker, Recipient: recipient}
+	assetLockValBytes, _ := json.Marshal(assetLockVal)
+	chaincodeStub.GetStateReturnsOnCall(4, []byte("interopcc"), nil)
+	chaincodeStub.GetStateReturnsOnCall(5, nil, nil)
+	chaincodeStub.GetStateReturnsOnCall(6, assetLockValBytes, nil)
+	_, err = interopcc.LockAssetBatch(ctx, assetAgreementsBytesBase64, []string{lockInfoBytesBase64, lockInfoBytesBase64})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to lock asset 1 of the batch")
+
+	// Test success with distinct unlocked assets
+	chaincodeStub.GetStateReturnsOnCall(7, []byte("interopcc"), nil)
+	chaincodeStub.GetStateReturnsOnCall(8, nil, nil)
+	chaincodeStub.GetStateReturnsOnCall(9, nil, nil)
+	contractIds, err := interopcc.LockAssetBatch(ctx, assetAgreementsBytesBase64, []string{lockInfoBytesBase64, lockInfoBytesBase64})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(contractIds))
+	require.NotEqual(t, contractIds[0], contractIds[1])
+
+	// Test failure when called directly by a client
+	wtest.SetMockStubCCId(chaincodeStub, "interopcc")
+	chaincodeStub.GetStateReturnsOnCall(10, []byte("interopcc"), nil)
+	_, err = interopcc.LockAssetBatch(ctx, assetAgreementsBytesBase64, []string{lockInfoBytesBase64, lockInfoBytesBase64})
+	require.EqualError(t, err, "Illegal access: LockAssetBatch being called directly by client")
+}
+
+func TestUnlockFungibleAssetBatch(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	contractIds := []string{"contract1", "contract2"}
+
+	// Test failure when one of the contracts was locked by a different chaincode
+	chaincodeStub.GetStateReturnsOnCall(0, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(1, []byte("othercc"), nil)
+	err := interopcc.UnlockFungibleAssetBatch(ctx, contractIds)
+	require.EqualError(t, err, "Illegal access: UnlockFungibleAssetBatch being called from chaincode Id mycc; expected othercc")
+
+	// Test failure with the same contract appearing twice in the batch
+	chaincodeStub.GetStateReturnsOnCall(2, []byte(localCCId), nil)
+	chaincodeStub.GetStateReturnsOnCall(3, []byte(localCCId), nil)
+	err = interopcc.UnlockFungibleAssetBatch(ctx, []string{contractIds[0], contractIds[0]})
+	require.EqualError(t, err, "contractId contract1 appears more than once in the batch")
+}
+
+// function to create a mock iterator over the given ledger <key, value> pairs
+func createStateQueryIterator(kvs []*queryresult.KV) *mocks.StateQueryIterator {
+	iterator := &mocks.StateQueryIterator{}
+	next := 0
+	iterator.HasNextCalls(func() bool {
+		return next < len(kvs)
+	})
+	iterator.NextCalls(func() (*queryresult.KV, error) {
+		next++
+		return kvs[next-1], nil
+	})
+	return iterator
+}
+
+func TestExpiredLocks(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	locker := getTxCreatorECertBase64()
+	recipient := "Bob"
+	currentTimeSecs := uint64(time.Now().Unix())
+	hashLock := assetexchange.HashLock{HashMechanism: common.HashMechanism_SHA256, HashBase64: assetexchange.GenerateSHA256HashInBase64Form("abcd")}
+
+	// An expired non-fungible asset lock, an expired fungible asset lock and an active fungible asset lock, in key order
+	assetLockKey := "assetLockKey01"
+	assetLockKeyBytes, _ := json.Marshal(assetLockKey)
+	assetLockValBytes, _ := json.Marshal(assetexchange.AssetLockValue{ContractId: "contract1", Locker: locker, Recipient: recipient,
+		LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs - 10, TimeSpec: common.TimeSpec_EPOCH})
+	expiredFungibleLockValBytes, _ := json.Marshal(assetexchange.FungibleAssetLockValue{Type: "cbdc", NumUnits: 10, Locker: locker, Recipient: recipient,
+		LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs - 10, TimeSpec: common.TimeSpec_EPOCH})
+	activeFungibleLockValBytes, _ := json.Marshal(assetexchange.FungibleAssetLockValue{Type: "cbdc", NumUnits: 20, Locker: locker, Recipient: recipient,
+		LockInfo: hashLock, ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs, TimeSpec: common.TimeSpec_EPOCH})
+	ledger := map[string][]byte{
+		"ContractId_contract1": assetLockKeyBytes,
+		assetLockKey:           assetLockValBytes,
+		"ContractId_contract2": expiredFungibleLockValBytes,
+		"ContractId_contract3": activeFungibleLockValBytes,
+	}
+	contractIdMapEntries := []*queryresult.KV{
+		{Key: "ContractId_contract1", Value: assetLockKeyBytes},
+		{Key: "ContractId_contract2", Value: expiredFungibleLockValBytes},
+		{Key: "ContractId_contract3", Value: activeFungibleLockValBytes},
+	}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+
+	// Test failure with an invalid page size
+	_, err := interopcc.GetExpiredLocks(ctx, 0, "")
+	require.EqualError(t, err, "invalid page size 0")
+
+	// Test success: the first page of two lock contracts has both the expired locks, and a bookmark to the next page
+	chaincodeStub.GetStateByRangeWithPaginationReturns(createStateQueryIterator(contractIdMapEntries[:2]),
+		&pb.QueryResponseMetadata{FetchedRecordsCount: 2, Bookmark: "ContractId_contract3"}, nil)
+	expiredLocksPageJSON, err := interopcc.GetExpiredLocks(ctx, 2, "")
+	require.NoError(t, err)
+	expiredLocksPage := assetexchange.ExpiredLocksPage{}
+	require.NoError(t, json.Unmarshal([]byte(expiredLocksPageJSON), &expiredLocksPage))
+	require.Equal(t, 2, len(expiredLocksPage.ExpiredLocks))
+	require.Equal(t, "contract1", expiredLocksPage.ExpiredLocks[0].ContractId)
+	require.False(t, expiredLocksPage.ExpiredLocks[0].IsFungible)
+	require.Equal(t, "contract2", expiredLocksPage.ExpiredLocks[1].ContractId)
+	require.True(t, expiredLocksPage.ExpiredLocks[1].IsFungible)
+	require.Equal(t, locker, expiredLocksPage.ExpiredLocks[1].Locker)
+	require.Equal(t, "ContractId_contract3", expiredLocksPage.Bookmark)
+
+	// Test success: the last page has no expired lock and no bookmark
+	chaincodeStub.GetStateByRangeWithPaginationReturns(createStateQueryIterator(contractIdMapEntries[2:]),
+		&pb.QueryResponseMetadata{FetchedRecordsCount: 1, Bookmark: "ContractId_contract3"}, nil)
+	expiredLocksPageJSON, err = interopcc.GetExpiredLocks(ctx, 2, "ContractId_contract3")
+	require.NoError(t, err)
+	require.Equal(t, `{"expiredLocks":[],"bookmark":""}`, expiredLocksPageJSON)
+
+	// Test failure when the reporter is not an admin
+	_, err = interopcc.ReportExpiredLocks(ctx, 1)
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+
+	// Test failure with a zero maximum count
+	_, err = interopcc.ReportExpiredLocks(ctx, 0)
+	require.EqualError(t, err, "maximum number of locks to report must be positive")
+
+	// Test success: only the first expired lock is reported when the maximum count is 1, and it is marked but not released
+	chaincodeStub.PutStateCalls(func(key string, value []byte) error {
+		ledger[key] = value
+		return nil
+	})
+	chaincodeStub.GetStateByRangeReturns(createStateQueryIterator(contractIdMapEntries), nil)
+	contractIds, err := interopcc.ReportExpiredLocks(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"contract1"}, contractIds)
+	require.Equal(t, 0, chaincodeStub.DelStateCallCount())
+	require.Equal(t, 1, chaincodeStub.PutStateCallCount())
+	reportedAssetLockVal := assetexchange.AssetLockValue{}
+	require.NoError(t, json.Unmarshal(ledger[assetLockKey], &reportedAssetLockVal))
+	require.True(t, reportedAssetLockVal.ExpiryReported)
+	require.Equal(t, assetLockKeyBytes, ledger["ContractId_contract1"])
+	eventName, eventPayload := chaincodeStub.SetEventArgsForCall(0)
+	require.Equal(t, "AssetLockExpired", eventName)
+	expiryEvent := &common.AssetLockEvent{}
+	require.NoError(t, proto.Unmarshal(eventPayload, expiryEvent))
+	require.Equal(t, common.AssetLockEventType_EXPIRED, expiryEvent.EventType)
+	require.Equal(t, "contract1", expiryEvent.ContractId)
+	require.Equal(t, locker, expiryEvent.Locker)
+	require.Equal(t, recipient, expiryEvent.Recipient)
+
+	// Test success: the already reported lock and the active lock are skipped, and the remaining expired lock is reported
+	chaincodeStub.GetStateByRangeReturns(createStateQueryIterator(contractIdMapEntries), nil)
+	contractIds, err = interopcc.ReportExpiredLocks(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{"contract2"}, contractIds)
+	require.Equal(t, 0, chaincodeStub.DelStateCallCount())
+	require.Equal(t, 2, chaincodeStub.PutStateCallCount())
+	reportedFungibleLockVal := assetexchange.FungibleAssetLockValue{}
+	require.NoError(t, json.Unmarshal(ledger["ContractId_contract2"], &reportedFungibleLockVal))
+	require.True(t, reportedFungibleLockVal.ExpiryReported)
+	require.Equal(t, uint64(10), reportedFungibleLockVal.NumUnits)
+	eventName, eventPayload = chaincodeStub.SetEventArgsForCall(1)
+	require.Equal(t, "AssetLockExpired", eventName)
+	require.NoError(t, proto.Unmarshal(eventPayload, expiryEvent))
+	require.Equal(t, "contract2", expiryEvent.ContractId)
+	require.Equal(t, "cbdc", expiryEvent.AssetType)
+	require.Equal(t, uint64(10), expiryEvent.NumUnits)
+
+	// Test success: nothing left to report, and hence no event
+	chaincodeStub.GetStateByRangeReturns(createStateQueryIterator([]*queryresult.KV{
+		{Key: "ContractId_contract1", Value: ledger["ContractId_contract1"]},
+		{Key: "ContractId_contract2", Value: ledger["ContractId_contract2"]},
+		{Key: "ContractId_contract3", Value: ledger["ContractId_contract3"]},
+	}), nil)
+	contractIds, err = interopcc.ReportExpiredLocks(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(contractIds))
+	require.Equal(t, 2, chaincodeStub.PutStateCallCount())
+	require.Equal(t, 2, chaincodeStub.SetEventCallCount())
+}
+
+// function to back the mock stub with an in-memory ledger, supporting composite keys and range queries
+func backMockStubWithLedger(chaincodeStub *mocks.ChaincodeStub) map[string][]byte {
+	ledger := map[string][]byte{}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+	chaincodeStub.PutStateCalls(func(key string, value []byte) error {
+		ledger[key] = value
+		return nil
+	})
+	chaincodeStub.DelStateCalls(func(key string) error {
+		delete(ledger, key)
+		return nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return "\x00" + objectType + "\x00" + strings.Join(attributes, "\x00") + "\x00", nil
+	})
+	chaincodeStub.SplitCompositeKeyCalls(func(compositeKey string) (string, []string, error) {
+		components := strings.Split(strings.Trim(compositeKey, "\x00"), "\x00")
+		return components[0], components[1:], nil
+	})
+	getStateByRange := func(startKey, endKey string) (shim.StateQueryIteratorInterface, error) {
+		kvs := []*queryresult.KV{}
+		for key, value := range ledger {
+			if key >= startKey && key < endKey {
+				kvs = append(kvs, &queryresult.KV{Key: key, Value: value})
+			}
+		}
+		sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+		return createStateQueryIterator(kvs), nil
+	}
+	chaincodeStub.GetStateByRangeCalls(getStateByRange)
+	chaincodeStub.GetStateByPartialCompositeKeyCalls(func(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+		prefix := "\x00" + objectType + "\x00" + strings.Join(attributes, "\x00") + "\x00"
+		return getStateByRange(prefix, prefix+"\xff")
+	})
+	return ledger
+}
+
+func TestLockQueries(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	locker := getTxCreatorECertBase64()
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+
+	ledger := backMockStubWithLedger(chaincodeStub)
+
+	lockInfoWithExpiry := func(expiryTimeSecs uint64) string {
+		lockInfoHTLCBytes, _ := proto.Marshal(&common.AssetLockHTLC{
+			HashMechanism:  common.HashMechanism_SHA256,
+			HashBase64:     []byte(assetexchange.GenerateSHA256HashInBase64Form("abcd")),
+			ExpiryTimeSecs: expiryTimeSecs,
+			TimeSpec:       common.TimeSpec_EPOCH,
+		})
+		lockInfoBytes, _ := proto.Marshal(&common.AssetLock{LockMechanism: common.LockMechanism_HTLC, LockInfo: lockInfoHTLCBytes})
+		return base64.StdEncoding.EncodeToString(lockInfoBytes)
+	}
+	lockFungibleAsset := func(txId string, numUnits uint64, recipient string, expiryTimeSecs uint64) string {
+		chaincodeStub.GetTxIDReturns(txId)
+		assetAgreementBytes, _ := proto.Marshal(&common.FungibleAssetExchangeAgreement{AssetType: "cbdc", NumUnits: numUnits, Recipient: recipient})
+		contractId, err := interopcc.LockFungibleAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), lockInfoWithExpiry(expiryTimeSecs))
+		require.NoError(t, err)
+		return contractId
+	}
+
+	// Lock a bond and 10 units of cbdc for Bob, and 20 units of cbdc for Charlie
+	chaincodeStub.GetTxIDReturns("tx1")
+	assetAgreementBytes, _ := proto.Marshal(&common.AssetExchangeAgreement{AssetType: "bond", Id: "A001", Recipient: "Bob"})
+	bondContractId, err := interopcc.LockAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), lockInfoWithExpiry(currentTimeSecs+300))
+	require.NoError(t, err)
+	cbdcContractId := lockFungibleAsset("tx2", 10, "Bob", currentTimeSecs+600)
+	lockFungibleAsset("tx3", 20, "Charlie", currentTimeSecs+900)
+	// A lock made by another chaincode is not reported to this one
+	wtest.SetMockStubCCId(chaincodeStub, "othercc")
+	lockFungibleAsset("tx4", 5, "Bob", currentTimeSecs+60)
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+
+	// Test success listing the locks by the caller for Bob
+	lockedAssetsJSON, err := interopcc.GetAllLockedAssets(ctx, "Bob", "")
+	require.NoError(t, err)
+	lockedAssets := []assetexchange.LockedAsset{}
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.ElementsMatch(t, []assetexchange.LockedAsset{
+		{ContractId: bondContractId, AssetType: "bond", AssetId: "A001", Locker: locker, Recipient: "Bob", ExpiryTimeSecs: currentTimeSecs + 300},
+		{ContractId: cbdcContractId, AssetType: "cbdc", NumUnits: 10, Locker: locker, Recipient: "Bob", ExpiryTimeSecs: currentTimeSecs + 600, IsFungible: true},
+	}, lockedAssets)
+	lockedAssetsJSON, err = interopcc.GetAllNonFungibleLockedAssets(ctx, "Bob", locker)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.Equal(t, 1, len(lockedAssets))
+	require.Equal(t, bondContractId, lockedAssets[0].ContractId)
+	lockedAssetsJSON, err = interopcc.GetAllFungibleLockedAssets(ctx, "Bob", "")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.Equal(t, 1, len(lockedAssets))
+	require.Equal(t, cbdcContractId, lockedAssets[0].ContractId)
+	lockedAssetsJSON, err = interopcc.GetAllLockedAssets(ctx, "Alice", "")
+	require.NoError(t, err)
+	require.Equal(t, "[]", lockedAssetsJSON)
+
+	// Test success querying the total locked units and the times to release
+	totalNumUnits, err := interopcc.GetTotalFungibleLockedAssets(ctx, "cbdc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), totalNumUnits)
+	timeToRelease, err := interopcc.GetAssetTimeToRelease(ctx, "bond", "A001", "Bob", "")
+	require.NoError(t, err)
+	require.Equal(t, currentTimeSecs+300, timeToRelease)
+	timeToRelease, err = interopcc.GetFungibleAssetTimeToRelease(ctx, "cbdc", 20, "Charlie", locker)
+	require.NoError(t, err)
+	require.Equal(t, currentTimeSecs+900, timeToRelease)
+	_, err = interopcc.GetFungibleAssetTimeToRelease(ctx, "cbdc", 20, "Bob", locker)
+	require.EqualError(t, err, "no 20 units of asset type cbdc are locked")
+
+	// Test success listing the locks expiring by a given time, in the order of expiry
+	lockedAssetsJSON, err = interopcc.GetAllAssetsLockedUntil(ctx, currentTimeSecs+600)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.Equal(t, 2, len(lockedAssets))
+	require.Equal(t, bondContractId, lockedAssets[0].ContractId)
+	require.Equal(t, cbdcContractId, lockedAssets[1].ContractId)
+	lockedAssetsJSON, err = interopcc.GetAllAssetsLockedUntil(ctx, currentTimeSecs+299)
+	require.NoError(t, err)
+	require.Equal(t, "[]", lockedAssetsJSON)
+
+	// Test that an unlocked asset is removed from the indexes
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 601)}, nil)
+	err = interopcc.UnlockFungibleAsset(ctx, cbdcContractId)
+	require.NoError(t, err)
+	totalNumUnits, err = interopcc.GetTotalFungibleLockedAssets(ctx, "cbdc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), totalNumUnits)
+	lockedAssetsJSON, err = interopcc.GetAllAssetsLockedUntil(ctx, currentTimeSecs+600)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.Equal(t, 1, len(lockedAssets))
+	require.Equal(t, bondContractId, lockedAssets[0].ContractId)
+	for key := range ledger {
+		require.NotContains(t, key, cbdcContractId)
+	}
+
+	// Test that a lock made before the lock indexes were maintained is only listed once it is backfilled
+	for key := range ledger {
+		if strings.Contains(key, bondContractId) && (strings.HasPrefix(key, "\x00LockBy") || strings.HasPrefix(key, "LockByExpiry_")) {
+			delete(ledger, key)
+		}
+	}
+	lockedAssetsJSON, err = interopcc.GetAllNonFungibleLockedAssets(ctx, "Bob", "")
+	require.NoError(t, err)
+	require.Equal(t, "[]", lockedAssetsJSON)
+	_, err = interopcc.BackfillLockIndexes(ctx, localCCId, 10, "")
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+	chaincodeStub.GetStateByRangeWithPaginationCalls(func(startKey, endKey string, pageSize int32, bookmark string) (shim.StateQueryIteratorInterface, *pb.QueryResponseMetadata, error) {
+		kvs := []*queryresult.KV{}
+		for key, value := range ledger {
+			if key >= startKey && key < endKey {
+				kvs = append(kvs, &queryresult.KV{Key: key, Value: value})
+			}
+		}
+		sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+		return createStateQueryIterator(kvs), &pb.QueryResponseMetadata{FetchedRecordsCount: int32(len(kvs))}, nil
+	})
+	numLedgerEntries := len(ledger)
+	bookmark, err := interopcc.BackfillLockIndexes(ctx, localCCId, 10, "")
+	require.NoError(t, err)
+	require.Equal(t, "", bookmark)
+	require.Equal(t, numLedgerEntries+4, len(ledger))
+	lockedAssetsJSON, err = interopcc.GetAllNonFungibleLockedAssets(ctx, "Bob", "")
+	require.NoError(t, err)
+	lockedAssets = []assetexchange.LockedAsset{}
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.Equal(t, []assetexchange.LockedAsset{
+		{ContractId: bondContractId, AssetType: "bond", AssetId: "A001", Locker: locker, Recipient: "Bob", ExpiryTimeSecs: currentTimeSecs + 300},
+	}, lockedAssets)
+	// Backfilling again leaves the indexes unchanged
+	_, err = interopcc.BackfillLockIndexes(ctx, localCCId, 10, "")
+	require.NoError(t, err)
+	require.Equal(t, numLedgerEntries+4, len(ledger))
+}
+
+func TestHybridAsset(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	caller := getTxCreatorECertBase64()
+	preimage := "abcd"
+	preimageBase64 := base64.StdEncoding.EncodeToString([]byte(preimage))
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+	ledger := backMockStubWithLedger(chaincodeStub)
+
+	lockInfoHTLCBytes, _ := proto.Marshal(&common.AssetLockHTLC{
+		HashMechanism:  common.HashMechanism_SHA256,
+		HashBase64:     []byte(assetexchange.GenerateSHA256HashInBase64Form(preimage)),
+		ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs,
+		TimeSpec:       common.TimeSpec_EPOCH,
+	})
+	lockInfoBytes, _ := proto.Marshal(&common.AssetLock{LockMechanism: common.LockMechanism_HTLC, LockInfo: lockInfoHTLCBytes})
+	lockInfoBase64 := base64.StdEncoding.EncodeToString(lockInfoBytes)
+	claimInfoHTLCBytes, _ := proto.Marshal(&common.AssetClaimHTLC{HashMechanism: common.HashMechanism_SHA256, HashPreimageBase64: []byte(preimageBase64)})
+	claimInfoBytes, _ := proto.Marshal(&common.AssetClaim{LockMechanism: common.LockMechanism_HTLC, ClaimInfo: claimInfoHTLCBytes})
+	claimInfoBase64 := base64.StdEncoding.EncodeToString(claimInfoBytes)
+	hybridAgreement := func(numUnits uint64, recipient string) string {
+		assetAgreementBytes, _ := proto.Marshal(&common.HybridAssetExchangeAgreement{AssetType: "bond", Id: "B001", AssetData: []byte("tranche"), NumUnits: numUnits, Recipient: recipient})
+		return base64.StdEncoding.EncodeToString(assetAgreementBytes)
+	}
+
+	// Test success locking 100 units of a bond for Bob
+	chaincodeStub.GetTxIDReturns("tx1")
+	contractId100, err := interopcc.LockHybridAsset(ctx, hybridAgreement(100, "Bob"), lockInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, localCCId, string(ledger[generateContractIdMapCCKey(contractId100)]))
+
+	// Test failure locking the same units again, and with no units
+	chaincodeStub.GetTxIDReturns("tx2")
+	_, err = interopcc.LockHybridAsset(ctx, hybridAgreement(100, "Bob"), lockInfoBase64)
+	require.EqualError(t, err, "100 units of asset of type bond and ID B001 are already locked")
+	_, err = interopcc.LockHybridAsset(ctx, hybridAgreement(0, "Bob"), lockInfoBase64)
+	require.EqualError(t, err, "hybrid asset agreement must specify both an asset ID and a positive number of units")
+
+	// Test success locking a different quantity of the same bond, which gets its own contractId
+	contractId50, err := interopcc.LockHybridAsset(ctx, hybridAgreement(50, "Bob"), lockInfoBase64)
+	require.NoError(t, err)
+	require.NotEqual(t, contractId100, contractId50)
+
+	// Test success querying the locks by agreement
+	isLocked, err := interopcc.IsHybridAssetLocked(ctx, hybridAgreement(100, "Bob"))
+	require.NoError(t, err)
+	require.True(t, isLocked) // the locker in the query is not set, and hence stands for the caller
+	isLocked, err = interopcc.IsHybridAssetLocked(ctx, hybridAgreement(100, "Charlie"))
+	require.NoError(t, err)
+	require.False(t, isLocked)
+	assetAgreementBytes, _ := proto.Marshal(&common.HybridAssetExchangeAgreement{AssetType: "bond", Id: "B001", NumUnits: 100, Locker: "*", Recipient: "Bob"})
+	isLocked, err = interopcc.IsHybridAssetLocked(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+	require.NoError(t, err)
+	require.True(t, isLocked)
+	assetAgreementBytes, _ = proto.Marshal(&common.HybridAssetExchangeAgreement{AssetType: "bond", Id: "B001", NumUnits: 70, Locker: "*", Recipient: "*"})
+	isLocked, err = interopcc.IsHybridAssetLocked(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+	require.NoError(t, err)
+	require.False(t, isLocked)
+	timeToRelease, err := interopcc.GetHybridAssetTimeToRelease(ctx, "bond", "B001", 50, "Bob", "")
+	require.NoError(t, err)
+	require.Equal(t, currentTimeSecs+defaultTimeLockSecs, timeToRelease)
+	_, err = interopcc.GetHybridAssetTimeToRelease(ctx, "bond", "B001", 70, "Bob", "")
+	require.EqualError(t, err, "no 70 units of asset of type bond and ID B001 are locked")
+	hashLockJSON, err := interopcc.GetHybridHTLCHash(ctx, hybridAgreement(50, "Bob"))
+	require.NoError(t, err)
+	require.Contains(t, hashLockJSON, assetexchange.GenerateSHA256HashInBase64Form(preimage))
+	lockedAssetsJSON, err := interopcc.GetAllNonFungibleLockedAssets(ctx, "Bob", "")
+	require.NoError(t, err)
+	lockedAssets := []assetexchange.LockedAsset{}
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.ElementsMatch(t, []assetexchange.LockedAsset{
+		{ContractId: contractId100, AssetType: "bond", AssetId: "B001", NumUnits: 100, Locker: caller, Recipient: "Bob", ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs},
+		{ContractId: contractId50, AssetType: "bond", AssetId: "B001", NumUnits: 50, Locker: caller, Recipient: "Bob", ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs},
+	}, lockedAssets)
+
+	// Test success claiming units locked for the caller, after which the hash preimage can be queried
+	chaincodeStub.GetTxIDReturns("tx3")
+	_, err = interopcc.LockHybridAsset(ctx, hybridAgreement(25, caller), lockInfoBase64)
+	require.NoError(t, err)
+	assetAgreementBytes, _ = proto.Marshal(&common.HybridAssetExchangeAgreement{AssetType: "bond", Id: "B001", NumUnits: 25, Locker: caller})
+	err = interopcc.ClaimHybridAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), claimInfoBase64)
+	require.NoError(t, err)
+	hashPreimage, err := interopcc.GetHybridHTLCHashPreImage(ctx, hybridAgreement(25, caller))
+	require.NoError(t, err)
+	require.Equal(t, preimageBase64, hashPreimage)
+	err = interopcc.ClaimHybridAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), claimInfoBase64)
+	require.EqualError(t, err, "no 25 units of asset of type bond and ID B001 are locked")
+
+	// Test failure claiming units locked for someone else
+	assetAgreementBytes, _ = proto.Marshal(&common.HybridAssetExchangeAgreement{AssetType: "bond", Id: "B001", NumUnits: 50, Locker: caller})
+	err = interopcc.ClaimHybridAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), claimInfoBase64)
+	require.EqualError(t, err, fmt.Sprintf("cannot claim 50 units of asset of type bond and ID B001 as they are locked by %s for Bob", caller))
+
+	// Test success unlocking after expiry, both by agreement and by contractId
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + defaultTimeLockSecs + 1)}, nil)
+	err = interopcc.UnlockHybridAsset(ctx, hybridAgreement(50, "Bob"))
+	require.NoError(t, err)
+	err = interopcc.UnlockAssetUsingContractId(ctx, contractId100)
+	require.NoError(t, err)
+	lockedAssetsJSON, err = interopcc.GetAllLockedAssets(ctx, "Bob", "")
+	require.NoError(t, err)
+	require.Equal(t, "[]", lockedAssetsJSON)
+	for key := range ledger {
+		require.NotContains(t, key, contractId100)
+		require.NotContains(t, key, contractId50)
+	}
+}
+
+func TestPartialClaimFungibleAsset(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	caller := getTxCreatorECertBase64()
+	preimage := "abcd"
+	preimageBase64 := base64.StdEncoding.EncodeToString([]byte(preimage))
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+	ledger := backMockStubWithLedger(chaincodeStub)
+
+	lockInfoHTLCBytes, _ := proto.Marshal(&common.AssetLockHTLC{
+		HashMechanism:  common.HashMechanism_SHA256,
+		HashBase64:     []byte(assetexchange.GenerateSHA256HashInBase64Form(preimage)),
+		ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs,
+		TimeSpec:       common.TimeSpec_EPOCH,
+	})
+	lockInfoBytes, _ := proto.Marshal(&common.AssetLock{LockMechanism: common.LockMechanism_HTLC, LockInfo: lockInfoHTLCBytes})
+	lockInfoBase64 := base64.StdEncoding.EncodeToString(lockInfoBytes)
+	claimInfoHTLCBytes, _ := proto.Marshal(&common.AssetClaimHTLC{HashMechanism: common.HashMechanism_SHA256, HashPreimageBase64: []byte(preimageBase64)})
+	claimInfoBytes, _ := proto.Marshal(&common.AssetClaim{LockMechanism: common.LockMechanism_HTLC, ClaimInfo: claimInfoHTLCBytes})
+	claimInfoBase64 := base64.StdEncoding.EncodeToString(claimInfoBytes)
+	assetAgreementBytes, _ := proto.Marshal(&common.FungibleAssetExchangeAgreement{AssetType: "cbdc", NumUnits: 100, Recipient: caller})
+	assetAgreementBase64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+
+	chaincodeStub.GetTxIDReturns("tx1")
+	contractId, err := interopcc.LockFungibleAsset(ctx, assetAgreementBase64, lockInfoBase64)
+	require.NoError(t, err)
+
+	// Test failure claiming no units, or more units than are locked
+	_, err = interopcc.PartialClaimFungibleAsset(ctx, contractId, 0, claimInfoBase64)
+	require.EqualError(t, err, "cannot claim 0 units of the fungible asset associated with contractId "+contractId+" as 100 units are locked")
+	_, err = interopcc.PartialClaimFungibleAsset(ctx, contractId, 101, claimInfoBase64)
+	require.EqualError(t, err, "cannot claim 101 units of the fungible asset associated with contractId "+contractId+" as 100 units are locked")
+
+	// Test success claiming a tranche, after which the remaining units stay locked under the same contractId
+	remainingUnits, err := interopcc.PartialClaimFungibleAsset(ctx, contractId, 30, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(70), remainingUnits)
+	remainingUnits, err = interopcc.PartialClaimFungibleAsset(ctx, contractId, 20, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), remainingUnits)
+	require.Equal(t, localCCId, string(ledger[generateContractIdMapCCKey(contractId)]))
+
+	// Test success querying the locked and claimed units
+	isLocked, err := interopcc.IsFungibleAssetLocked(ctx, contractId)
+	require.NoError(t, err)
+	require.True(t, isLocked)
+	lockStatus, err := interopcc.GetFungibleAssetLockStatus(ctx, contractId)
+	require.NoError(t, err)
+	require.Equal(t, assetexchange.FungibleAssetLockStatus{ContractId: contractId, IsLocked: true, LockedUnits: 50, ClaimedUnits: 50}, *lockStatus)
+	lockedAssetsJSON, err := interopcc.GetAllFungibleLockedAssets(ctx, caller, "")
+	require.NoError(t, err)
+	lockedAssets := []assetexchange.LockedAsset{}
+	require.NoError(t, json.Unmarshal([]byte(lockedAssetsJSON), &lockedAssets))
+	require.Equal(t, []assetexchange.LockedAsset{
+		{ContractId: contractId, AssetType: "cbdc", NumUnits: 50, ClaimedUnits: 50, Locker: caller, Recipient: caller, ExpiryTimeSecs: currentTimeSecs + defaultTimeLockSecs, IsFungible: true},
+	}, lockedAssets)
+	totalNumUnits, err := interopcc.GetTotalFungibleLockedAssets(ctx, "cbdc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), totalNumUnits)
+
+	// Test failure claiming a tranche after expiry, and success returning the remaining units to the locker
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + defaultTimeLockSecs + 1)}, nil)
+	_, err = interopcc.PartialClaimFungibleAsset(ctx, contractId, 10, claimInfoBase64)
+	require.EqualError(t, err, "cannot claim asset associated with contractId "+contractId+" as the expiry time is already elapsed")
+	lockStatus, err = interopcc.GetFungibleAssetLockStatus(ctx, contractId)
+	require.NoError(t, err)
+	require.False(t, lockStatus.IsLocked)
+	err = interopcc.UnlockFungibleAsset(ctx, contractId)
+	require.NoError(t, err)
+	for key := range ledger {
+		// the hash preimage revealed by the claimed tranches remains queryable
+		if !strings.HasPrefix(key, "ClaimContractId_") {
+			require.NotContains(t, key, contractId)
+		}
+	}
+
+	// Test success claiming all the units in tranches, the last of which removes the lock
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+	chaincodeStub.GetTxIDReturns("tx2")
+	contractId, err = interopcc.LockFungibleAsset(ctx, assetAgreementBase64, lockInfoBase64)
+	require.NoError(t, err)
+	remainingUnits, err = interopcc.PartialClaimFungibleAsset(ctx, contractId, 60, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), remainingUnits)
+	remainingUnits, err = interopcc.PartialClaimFungibleAsset(ctx, contractId, 40, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), remainingUnits)
+	require.Nil(t, ledger[generateContractIdMapCCKey(contractId)])
+	lockedAssetsJSON, err = interopcc.GetAllFungibleLockedAssets(ctx, caller, "")
+	require.NoError(t, err)
+	require.Equal(t, "[]", lockedAssetsJSON)
+	for key := range ledger {
+		if !strings.HasPrefix(key, "ClaimContractId_") {
+			require.NotContains(t, key, contractId)
+		}
+	}
+}
+
+// function that signs the message of a lock amendment (see 'GenerateExtendLockExpiryMessage' and
+// 'GenerateCancelLockMessage') on behalf of the recipient of the lock, returning the signature in base64
+func signLockAmendment(t *testing.T, message []byte, key interface{}) string {
+	if privKey, isEd25519 := key.(ed25519.PrivateKey); isEd25519 {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(privKey, message))
+	}
+	messageHash := sha256.Sum256(message)
+	signature, err := ecdsa.SignASN1(rand.Reader, key.(*ecdsa.PrivateKey), messageHash[:])
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func TestExtendLockExpiryAndCancelLock(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	localCCId := "mycc"
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+	interopcc := SmartContract{}
+
+	locker := getTxCreatorECertBase64()
+	recipient, recipientKey := createApprover(t, "recipient.example.com", "ecdsa")
+	_, otherKey := createApprover(t, "other.example.com", "ed25519")
+	currentTimeSecs := uint64(time.Now().Unix())
+	expiryTimeSecs := currentTimeSecs + defaultTimeLockSecs
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+	ledger := backMockStubWithLedger(chaincodeStub)
+
+	lockInfoHTLCBytes, _ := proto.Marshal(&common.AssetLockHTLC{
+		HashMechanism:  common.HashMechanism_SHA256,
+		HashBase64:     []byte(assetexchange.GenerateSHA256HashInBase64Form("abcd")),
+		ExpiryTimeSecs: expiryTimeSecs,
+		TimeSpec:       common.TimeSpec_EPOCH,
+	})
+	lockInfoBytes, _ := proto.Marshal(&common.AssetLock{LockMechanism: common.LockMechanism_HTLC, LockInfo: lockInfoHTLCBytes})
+	lockInfoBase64 := base64.StdEncoding.EncodeToString(lockInfoBytes)
+	assetAgreementBytes, _ := proto.Marshal(&common.AssetExchangeAgreement{AssetType: "bond", Id: "A001", Recipient: recipient})
+	assetAgreementBase64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+
+	chaincodeStub.GetTxIDReturns("tx1")
+	contractId, err := interopcc.LockAsset(ctx, assetAgreementBase64, lockInfoBase64)
+	require.NoError(t, err)
+
+	// Test failure extending the expiry without the recipient's signature over the new terms
+	newExpiryTimeSecs := expiryTimeSecs + 600
+	extendMessage := assetexchange.GenerateExtendLockExpiryMessage(contractId, newExpiryTimeSecs)
+	otherSignatureBase64 := signLockAmendment(t, extendMessage, otherKey)
+	err = interopcc.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs, otherSignatureBase64)
+	require.ErrorContains(t, err, "recipient of the lock associated with contractId "+contractId+" has not agreed to its amendment")
+	staleSignatureBase64 := signLockAmendment(t, extendMessage, recipientKey)
+	err = interopcc.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs+1, staleSignatureBase64)
+	require.ErrorContains(t, err, "recipient of the lock associated with contractId "+contractId+" has not agreed to its amendment")
+
+	// Test failure extending the expiry to an earlier time
+	earlierExpiryTimeSecs := expiryTimeSecs - 1
+	earlierSignatureBase64 := signLockAmendment(t, assetexchange.GenerateExtendLockExpiryMessage(contractId, earlierExpiryTimeSecs), recipientKey)
+	err = interopcc.ExtendLockExpiry(ctx, contractId, earlierExpiryTimeSecs, earlierSignatureBase64)
+	require.EqualError(t, err, fmt.Sprintf("new expiry %d of the lock associated with the contractId %s is not later than its current expiry %d", earlierExpiryTimeSecs, contractId, expiryTimeSecs))
+
+	// Test failure extending the expiry from a chaincode other than the one that made the lock
+	wtest.SetMockStubCCId(chaincodeStub, "othercc")
+	err = interopcc.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs, staleSignatureBase64)
+	require.EqualError(t, err, "Illegal access: ExtendLockExpiry being called from chaincode Id othercc; expected "+localCCId)
+	wtest.SetMockStubCCId(chaincodeStub, localCCId)
+
+	// Test success extending the expiry, which is reflected in the lock queries
+	err = interopcc.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs, staleSignatureBase64)
+	require.NoError(t, err)
+	require.Nil(t, ledger[fmt.Sprintf("LockByExpiry_%020d_%s", expiryTimeSecs, contractId)])
+	require.NotNil(t, ledger[fmt.Sprintf("LockByExpiry_%020d_%s", newExpiryTimeSecs, contractId)])
+	timeToRelease, err := interopcc.GetAssetTimeToRelease(ctx, "bond", "A001", recipient, "")
+	require.NoError(t, err)
+	require.Equal(t, newExpiryTimeSecs, timeToRelease)
+
+	// The lock can no longer be unlocked at its original expiry
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(expiryTimeSecs + 1)}, nil)
+	err = interopcc.UnlockAssetUsingContractId(ctx, contractId)
+	require.EqualError(t, err, "cannot unlock asset associated with the contractId "+contractId+" as the expiry time is not yet elapsed")
+
+	// Test failure cancelling the lock without the recipient's signature
+	cancelMessage := assetexchange.GenerateCancelLockMessage(contractId)
+	err = interopcc.CancelLock(ctx, contractId, otherSignatureBase64)
+	require.ErrorContains(t, err, "recipient of the lock associated with contractId "+contractId+" has not agreed to its amendment")
+	err = interopcc.CancelLock(ctx, contractId, signLockAmendment(t, cancelMessage, otherKey))
+	require.ErrorContains(t, err, "recipient of the lock associated with contractId "+contractId+" has not agreed to its amendment")
+
+	// Test success cancelling the lock before its expiry, which removes it from the ledger
+	err = interopcc.CancelLock(ctx, contractId, signLockAmendment(t, cancelMessage, recipientKey))
+	require.NoError(t, err)
+	for key := range ledger {
+		require.NotContains(t, key, contractId)
+	}
+
+	// Test success extending and then cancelling a lock on fungible assets
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+	fungibleAssetAgreementBytes, _ := proto.Marshal(&common.FungibleAssetExchangeAgreement{AssetType: "cbdc", NumUnits: 100, Recipient: recipient})
+	chaincodeStub.GetTxIDReturns("tx2")
+	contractId, err = interopcc.LockFungibleAsset(ctx, base64.StdEncoding.EncodeToString(fungibleAssetAgreementBytes), lockInfoBase64)
+	require.NoError(t, err)
+	extendMessage = assetexchange.GenerateExtendLockExpiryMessage(contractId, newExpiryTimeSecs)
+	err = interopcc.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs, signLockAmendment(t, extendMessage, recipientKey))
+	require.NoError(t, err)
+	timeToRelease, err = interopcc.GetFungibleAssetTimeToRelease(ctx, "cbdc", 100, recipient, locker)
+	require.NoError(t, err)
+	require.Equal(t, newExpiryTimeSecs, timeToRelease)
+
+	// Test failure extending the expiry once it has elapsed
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(newExpiryTimeSecs + 1)}, nil)
+	laterExpiryTimeSecs := newExpiryTimeSecs + 600
+	extendMessage = assetexchange.GenerateExtendLockExpiryMessage(contractId, laterExpiryTimeSecs)
+	err = interopcc.ExtendLockExpiry(ctx, contractId, laterExpiryTimeSecs, signLockAmendment(t, extendMessage, recipientKey))
+	require.EqualError(t, err, "cannot extend the expiry of the lock associated with the contractId "+contractId+" as the expiry time is already elapsed")
+
+	cancelMessage = assetexchange.GenerateCancelLockMessage(contractId)
+	err = interopcc.CancelLock(ctx, contractId, signLockAmendment(t, cancelMessage, recipientKey))
+	require.NoError(t, err)
+	totalNumUnits, err := interopcc.GetTotalFungibleLockedAssets(ctx, "cbdc")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), totalNumUnits)
+	for key := range ledger {
+		require.NotContains(t, key, contractId)
+	}
+}
@@ -24,15 +24,21 @@ import (
 const membershipObjectType = "membership"
 const membershipLocalSecurityDomain = "local-security-domain"
 
-// Check the validity of each certificate chain in this membership
+// Check the validity of each certificate chain, and each certificate revocation list, in this membership
 func validateMemberCertChains(membership *common.Membership) error {
-	for _, member := range membership.Members {
+	for memberId, member := range membership.Members {
 		if len(member.Chain) > 1 {
 			err := verifyCertificateChain(nil, member.Chain)
 			if err != nil {
 				return fmt.Errorf("Certificate chain corresponding to member %+v in security domain %s is invalid: %s", member, membership.SecurityDomain, err)
 			}
 		}
+		for _, crl := range member.Crls {
+			_, err := parseCRL(crl)
+			if err != nil {
+				return fmt.Errorf("CRL of member %s in security domain %s is invalid: %s", memberId, membership.SecurityDomain, err)
+			}
+		}
 	}
 	return nil
 }
@@ -484,10 +490,19 @@ func verifyMemberInSecurityDomain1(s *SmartContract, ctx contractapi.Transaction
 	return verifyMemberInSecurityDomain2(certPEM, cert, membership, requestingOrg)
 }
 
+// getCaMemberIntermediates returns the intermediate CA certificates in the chain of a "ca" member, which may issue
+// the member's certificates and CRLs, if they chain up to the member's CA; the chain is ignored otherwise.
+func getCaMemberIntermediates(member *common.Member) []string {
+	if len(member.Chain) == 0 || verifyCertificateChain(nil, append([]string{member.Value}, member.Chain...)) != nil {
+		return []string{}
+	}
+	return member.Chain
+}
+
 // verifyMemberInSecurityDomain2 function verifies the identity of the requester according to
 // the Membership for the external network the request originated from.
 // This takes a decoded X.509 certificate as argument (and optionally the certificate in PEM format too).
-// It takes a membership structure as argument.
+// It takes a membership structure as argument, and rejects certificates revoked in the member's CRLs.
 func verifyMemberInSecurityDomain2(certPEM string, cert *x509.Certificate, membership *common.Membership, requestingOrg string) error {
 	err := isCertificateWithinExpiry(cert)
 	if err != nil {
@@ -497,13 +512,19 @@ func verifyMemberInSecurityDomain2(certPEM string, cert *x509.Certificate, membe
 	if ok == false {
 		return fmt.Errorf("Member does not exist for org: %s", requestingOrg)
 	}
+	var caCertPEMs []string
 	switch member.Type {
 	case "ca":
 		if member.Value == "" {
 			return fmt.Errorf("CA member certificate is blank")
 		}
+		intermediateCertPEMs := getCaMemberIntermediates(member)
+		caCertPEMs = append([]string{member.Value}, intermediateCertPEMs...)
 		if certPEM != member.Value {	// The CA is automatically a member of the security domain
 			err := verifyCaCertificate(cert, member.Value)
+			if err != nil && len(intermediateCertPEMs) > 0 && verifyCertificateChain(cert, caCertPEMs) == nil {
+				err = nil		// The certificate was issued by the CA's last intermediate CA
+			}
 			if err != nil {
 				return err
 			}
@@ -517,8 +538,9 @@ func verifyMemberInSecurityDomain2(certPEM string, cert *x509.Certificate, membe
 		if err != nil {
 			return err
 		}
+		caCertPEMs = chain
 	default:
 		return fmt.Errorf("Certificate type not supported: %s", member.Type)
 	}
-	return nil
+	return verifyCertificateNotRevoked(cert, member.Crls, caCertPEMs)
 }
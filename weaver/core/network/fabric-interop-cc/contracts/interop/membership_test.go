@@ -1150,3 +1150,171 @@ func TestVerifyMembership(t *testing.T) {
 	err = verifyMemberInSecurityDomain(&interopcc, ctx, string(pemCert), "test", "member1")
 	require.EqualError(t, err, "Certificate type not supported: unknown")
 }
+
+func TestVerifyMembershipRevocation(t *testing.T) {
+	// make a CA, and two member certificates issued by it
+	now := time.Now()
+	caTemplate := x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: "ca.org1.example.com",
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		SerialNumber:          big.NewInt(1),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caCertBytes, caKey, err := createECDSACertAndKeyFromTemplate(caTemplate)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caCertBytes)
+	require.NoError(t, err)
+	caCertPEM, err := x509CertToPem(caCertBytes)
+	require.NoError(t, err)
+	memberCerts := []*x509.Certificate{}
+	memberCertPEMs := []string{}
+	for _, serialNumber := range []int64{2, 3} {
+		memberKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		memberTemplate := x509.Certificate{
+			Subject: pkix.Name{
+				CommonName: fmt.Sprintf("user%d.org1.example.com", serialNumber),
+			},
+			NotBefore:    now.Add(-time.Hour),
+			NotAfter:     now.Add(24 * time.Hour),
+			SerialNumber: big.NewInt(serialNumber),
+		}
+		memberCertBytes, err := x509.CreateCertificate(rand.Reader, &memberTemplate, caCert, &memberKey.PublicKey, caKey)
+		require.NoError(t, err)
+		memberCert, err := x509.ParseCertificate(memberCertBytes)
+		require.NoError(t, err)
+		memberCertPEM, err := x509CertToPem(memberCertBytes)
+		require.NoError(t, err)
+		memberCerts = append(memberCerts, memberCert)
+		memberCertPEMs = append(memberCertPEMs, memberCertPEM)
+	}
+
+	// the CA revokes the first member certificate
+	crlTemplate := x509.RevocationList{
+		Number:              big.NewInt(1),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(24 * time.Hour),
+		RevokedCertificates: []pkix.RevokedCertificate{{SerialNumber: big.NewInt(2), RevocationTime: now}},
+	}
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, &crlTemplate, caCert, caKey)
+	require.NoError(t, err)
+	crlPEM := string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlBytes}))
+
+	membership := common.Membership{
+		SecurityDomain: securityDomainId,
+		Members: map[string]*common.Member{"Org1MSP": {
+			Value: caCertPEM,
+			Type:  "ca",
+			Crls:  []string{crlPEM},
+		}},
+	}
+	require.NoError(t, validateMemberCertChains(&membership))
+
+	// Test: the revoked certificate is rejected, and the other one accepted
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[0], memberCerts[0], &membership, "Org1MSP")
+	require.EqualError(t, err, "Certificate with serial number 2 has been revoked")
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[1], memberCerts[1], &membership, "Org1MSP")
+	require.NoError(t, err)
+
+	// Test: base64-encoded DER CRLs are also accepted, for members with certificate chains too
+	membership.Members["Org1MSP"] = &common.Member{
+		Type:  "certificate",
+		Chain: []string{caCertPEM},
+		Crls:  []string{base64.StdEncoding.EncodeToString(crlBytes)},
+	}
+	require.NoError(t, validateMemberCertChains(&membership))
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[0], memberCerts[0], &membership, "Org1MSP")
+	require.EqualError(t, err, "Certificate with serial number 2 has been revoked")
+
+	// Test: a CRL for the same issuer name but not signed by the member's CA is not trusted
+	otherCACertBytes, otherCAKey, err := createECDSACertAndKeyFromTemplate(caTemplate)
+	require.NoError(t, err)
+	otherCACert, err := x509.ParseCertificate(otherCACertBytes)
+	require.NoError(t, err)
+	forgedCRLBytes, err := x509.CreateRevocationList(rand.Reader, &crlTemplate, otherCACert, otherCAKey)
+	require.NoError(t, err)
+	membership.Members["Org1MSP"].Crls = []string{base64.StdEncoding.EncodeToString(forgedCRLBytes)}
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[1], memberCerts[1], &membership, "Org1MSP")
+	require.EqualError(t, err, "CRL issued by CN=ca.org1.example.com is not signed by a known CA")
+
+	// Test: CRLs past their next update still apply, without rejecting the certificates they do not revoke
+	staleCRLTemplate := crlTemplate
+	staleCRLTemplate.ThisUpdate = now.Add(-2 * time.Hour)
+	staleCRLTemplate.NextUpdate = now.Add(-time.Hour)
+	staleCRLBytes, err := x509.CreateRevocationList(rand.Reader, &staleCRLTemplate, caCert, caKey)
+	require.NoError(t, err)
+	membership.Members["Org1MSP"].Crls = []string{base64.StdEncoding.EncodeToString(staleCRLBytes)}
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[0], memberCerts[0], &membership, "Org1MSP")
+	require.EqualError(t, err, "Certificate with serial number 2 has been revoked")
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[1], memberCerts[1], &membership, "Org1MSP")
+	require.NoError(t, err)
+
+	// make an intermediate CA under the CA, and a member certificate issued by it
+	intCAKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	intCATemplate := caTemplate
+	intCATemplate.Subject = pkix.Name{CommonName: "ica.org1.example.com"}
+	intCATemplate.SerialNumber = big.NewInt(4)
+	intCACertBytes, err := x509.CreateCertificate(rand.Reader, &intCATemplate, caCert, &intCAKey.PublicKey, caKey)
+	require.NoError(t, err)
+	intCACert, err := x509.ParseCertificate(intCACertBytes)
+	require.NoError(t, err)
+	intCACertPEM, err := x509CertToPem(intCACertBytes)
+	require.NoError(t, err)
+	intMemberKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	intMemberTemplate := x509.Certificate{
+		Subject:      pkix.Name{CommonName: "user5.org1.example.com"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * time.Hour),
+		SerialNumber: big.NewInt(5),
+	}
+	intMemberCertBytes, err := x509.CreateCertificate(rand.Reader, &intMemberTemplate, intCACert, &intMemberKey.PublicKey, intCAKey)
+	require.NoError(t, err)
+	intMemberCert, err := x509.ParseCertificate(intMemberCertBytes)
+	require.NoError(t, err)
+	intMemberCertPEM, err := x509CertToPem(intMemberCertBytes)
+	require.NoError(t, err)
+
+	// Test: CRLs signed by the intermediate CA of a "ca" member are trusted
+	intCRLTemplate := crlTemplate
+	intCRLTemplate.RevokedCertificates = []pkix.RevokedCertificate{{SerialNumber: big.NewInt(5), RevocationTime: now}}
+	intCRLBytes, err := x509.CreateRevocationList(rand.Reader, &intCRLTemplate, intCACert, intCAKey)
+	require.NoError(t, err)
+	membership.Members["Org1MSP"] = &common.Member{
+		Value: caCertPEM,
+		Type:  "ca",
+		Chain: []string{intCACertPEM},
+	}
+	require.NoError(t, validateMemberCertChains(&membership))
+	err = verifyMemberInSecurityDomain2(intMemberCertPEM, intMemberCert, &membership, "Org1MSP")
+	require.NoError(t, err)
+	err = verifyMemberInSecurityDomain2(memberCertPEMs[1], memberCerts[1], &membership, "Org1MSP")
+	require.NoError(t, err)
+	membership.Members["Org1MSP"].Crls = []string{base64.StdEncoding.EncodeToString(intCRLBytes)}
+	err = verifyMemberInSecurityDomain2(intMemberCertPEM, intMemberCert, &membership, "Org1MSP")
+	require.EqualError(t, err, "Certificate with serial number 5 has been revoked")
+
+	// Test: certificates issued by a revoked intermediate CA are rejected
+	caCRLTemplate := crlTemplate
+	caCRLTemplate.RevokedCertificates = []pkix.RevokedCertificate{{SerialNumber: big.NewInt(4), RevocationTime: now}}
+	caCRLBytes, err := x509.CreateRevocationList(rand.Reader, &caCRLTemplate, caCert, caKey)
+	require.NoError(t, err)
+	membership.Members["Org1MSP"] = &common.Member{
+		Type:  "certificate",
+		Chain: []string{caCertPEM, intCACertPEM},
+		Crls:  []string{base64.StdEncoding.EncodeToString(caCRLBytes)},
+	}
+	require.NoError(t, validateMemberCertChains(&membership))
+	err = verifyMemberInSecurityDomain2(intMemberCertPEM, intMemberCert, &membership, "Org1MSP")
+	require.EqualError(t, err, "CA certificate CN=ica.org1.example.com with serial number 4 has been revoked")
+
+	// Test: memberships with malformed CRLs are invalid
+	membership.Members["Org1MSP"].Crls = []string{"not a CRL"}
+	require.Error(t, validateMemberCertChains(&membership))
+}
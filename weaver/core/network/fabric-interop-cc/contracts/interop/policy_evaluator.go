@@ -0,0 +1,301 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// policy_evaluator contains the logic used to check whether the set of signers (notaries/endorsers)
+// on a proof satisfies the criteria in a verification policy. The interpretation of the criteria is
+// determined by the policy type.
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+)
+
+// Supported verification policy types.
+//
+// signature-and: every entry in Criteria must be a signer. This is also how the legacy "Signature"
+// policy type (in any case) is interpreted; any other policy type is rejected.
+//
+// k-of-n: Criteria[0] is the threshold k and Criteria[1:] are the n distinct candidate signers,
+// e.g. ["2", "Org1MSP", "Org2MSP", "Org3MSP"].
+//
+// expression: each entry in Criteria is a boolean expression over signer IDs using
+// '&&'/'AND', '||'/'OR' and parentheses, e.g. "(OrgA && OrgB) || OrgC". Multiple entries are ANDed.
+const (
+	policyTypeSignatureAnd = "signature-and"
+	policyTypeKOfN         = "k-of-n"
+	policyTypeExpression   = "expression"
+	policyTypeSignature    = "signature"
+)
+
+// isSignatureAndPolicy checks whether a policy type requires every entry in Criteria to be a signer.
+func isSignatureAndPolicy(policyType string) bool {
+	return policyType == policyTypeSignatureAnd || strings.EqualFold(policyType, policyTypeSignature)
+}
+
+// validatePolicy checks that the criteria of a policy are well formed for its type. Policies of an
+// unsupported type are accepted here, as recorded configurations may predate this check, but they
+// fail evaluation.
+func validatePolicy(policy *common.Policy) error {
+	if policy == nil {
+		return fmt.Errorf("Policy is empty")
+	}
+	switch policy.Type {
+	case policyTypeKOfN:
+		_, _, err := parseKOfNCriteria(policy.Criteria)
+		return err
+	case policyTypeExpression:
+		if len(policy.Criteria) == 0 {
+			return fmt.Errorf("Expression policy must have at least one criteria")
+		}
+		for _, criteria := range policy.Criteria {
+			if _, err := parsePolicyExpression(criteria); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// validateVerificationPolicy checks that every policy in a verification policy is well formed.
+func validateVerificationPolicy(verificationPolicy *common.VerificationPolicy) error {
+	for _, identifier := range verificationPolicy.Identifiers {
+		if err := validatePolicy(identifier.Policy); err != nil {
+			return fmt.Errorf("Invalid policy for pattern '%s': %s", identifier.Pattern, err.Error())
+		}
+	}
+	return nil
+}
+
+// evaluatePolicy checks that the list of signers satisfies the policy, returning an error describing
+// why it is not satisfied otherwise.
+func evaluatePolicy(policy *common.Policy, signerList []string) error {
+	if policy == nil {
+		return fmt.Errorf("Policy is empty")
+	}
+	switch policy.Type {
+	case policyTypeKOfN:
+		threshold, candidates, err := parseKOfNCriteria(policy.Criteria)
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, candidate := range candidates {
+			if Contains(signerList, candidate) {
+				count++
+			}
+		}
+		if count < threshold {
+			return fmt.Errorf("Notarizations do not meet threshold: required %d of %v, found %d", threshold, candidates, count)
+		}
+		return nil
+	case policyTypeExpression:
+		for _, criteria := range policy.Criteria {
+			expr, err := parsePolicyExpression(criteria)
+			if err != nil {
+				return err
+			}
+			if !expr.eval(signerList) {
+				return fmt.Errorf("Notarizations do not satisfy policy expression: %s", criteria)
+			}
+		}
+		return nil
+	default:
+		if !isSignatureAndPolicy(policy.Type) {
+			return fmt.Errorf("Unsupported policy type '%s'", policy.Type)
+		}
+		for _, signer := range policy.Criteria {
+			if !Contains(signerList, signer) {
+				return fmt.Errorf("Notarizations missing signer: %s", signer)
+			}
+		}
+		return nil
+	}
+}
+
+// parseKOfNCriteria splits k-of-n criteria into the threshold and the list of candidate signers.
+func parseKOfNCriteria(criteria []string) (int, []string, error) {
+	if len(criteria) < 2 {
+		return 0, nil, fmt.Errorf("k-of-n policy must have a threshold followed by at least one signer")
+	}
+	threshold, err := strconv.Atoi(strings.TrimSpace(criteria[0]))
+	if err != nil {
+		return 0, nil, fmt.Errorf("Invalid k-of-n threshold '%s': %s", criteria[0], err.Error())
+	}
+	candidates := criteria[1:]
+	for i, candidate := range candidates {
+		if Contains(candidates[:i], candidate) {
+			return 0, nil, fmt.Errorf("Duplicate k-of-n signer '%s'", candidate)
+		}
+	}
+	if threshold < 1 || threshold > len(candidates) {
+		return 0, nil, fmt.Errorf("Invalid k-of-n threshold %d for %d signers", threshold, len(candidates))
+	}
+	return threshold, candidates, nil
+}
+
+// policyExpr is a node in a parsed policy expression.
+type policyExpr struct {
+	op       string // "and", "or" or "" for a signer leaf
+	signer   string
+	operands []*policyExpr
+}
+
+func (e *policyExpr) eval(signerList []string) bool {
+	switch e.op {
+	case "and":
+		for _, operand := range e.operands {
+			if !operand.eval(signerList) {
+				return false
+			}
+		}
+		return true
+	case "or":
+		for _, operand := range e.operands {
+			if operand.eval(signerList) {
+				return true
+			}
+		}
+		return false
+	default:
+		return Contains(signerList, e.signer)
+	}
+}
+
+// policyExprParser is a recursive descent parser for policy expressions:
+//
+//	expr := term { ('||' | 'OR') term }
+//	term := factor { ('&&' | 'AND') factor }
+//	factor := ID | '(' expr ')'
+type policyExprParser struct {
+	tokens []string
+	pos    int
+}
+
+// parsePolicyExpression parses a boolean expression over signer IDs.
+func parsePolicyExpression(expression string) (*policyExpr, error) {
+	tokens, err := tokenizePolicyExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("Empty policy expression")
+	}
+	p := &policyExprParser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("Invalid policy expression '%s': %s", expression, err.Error())
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("Invalid policy expression '%s': unexpected token '%s'", expression, p.tokens[p.pos])
+	}
+	return expr, nil
+}
+
+func tokenizePolicyExpression(expression string) ([]string, error) {
+	tokens := []string{}
+	runes := []rune(expression)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '&' || c == '|':
+			if i+1 >= len(runes) || runes[i+1] != c {
+				return nil, fmt.Errorf("Invalid policy expression '%s': expected '%c%c'", expression, c, c)
+			}
+			tokens = append(tokens, string([]rune{c, c}))
+			i += 2
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()&|", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		}
+	}
+	return tokens, nil
+}
+
+func (p *policyExprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *policyExprParser) parseOr() (*policyExpr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*policyExpr{left}
+	for p.peek() == "||" || strings.EqualFold(p.peek(), "OR") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, right)
+	}
+	if len(operands) == 1 {
+		return left, nil
+	}
+	return &policyExpr{op: "or", operands: operands}, nil
+}
+
+func (p *policyExprParser) parseAnd() (*policyExpr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	operands := []*policyExpr{left}
+	for p.peek() == "&&" || strings.EqualFold(p.peek(), "AND") {
+		p.pos++
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, right)
+	}
+	if len(operands) == 1 {
+		return left, nil
+	}
+	return &policyExpr{op: "and", operands: operands}, nil
+}
+
+func (p *policyExprParser) parseFactor() (*policyExpr, error) {
+	token := p.peek()
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of expression")
+	case token == "(":
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("missing ')'")
+		}
+		p.pos++
+		return expr, nil
+	case token == ")" || token == "&&" || token == "||" || strings.EqualFold(token, "AND") || strings.EqualFold(token, "OR"):
+		return nil, fmt.Errorf("unexpected token '%s'", token)
+	default:
+		p.pos++
+		return &policyExpr{signer: token}, nil
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"testing"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluatePolicySignatureAnd(t *testing.T) {
+	policy := &common.Policy{Type: "signature", Criteria: []string{"Org1MSP", "Org2MSP"}}
+	require.NoError(t, evaluatePolicy(policy, []string{"Org1MSP", "Org2MSP"}))
+	require.EqualError(t, evaluatePolicy(policy, []string{"Org1MSP"}), "Notarizations missing signer: Org2MSP")
+
+	policy.Type = "signature-and"
+	require.NoError(t, evaluatePolicy(policy, []string{"Org2MSP", "Org1MSP", "Org3MSP"}))
+
+	// Unknown policy types are rejected rather than treated as signature-and
+	policy.Type = "signature-or"
+	require.EqualError(t, evaluatePolicy(policy, []string{"Org1MSP", "Org2MSP"}), "Unsupported policy type 'signature-or'")
+}
+
+func TestEvaluatePolicyKOfN(t *testing.T) {
+	policy := &common.Policy{Type: "k-of-n", Criteria: []string{"2", "Org1MSP", "Org2MSP", "Org3MSP"}}
+	require.NoError(t, validatePolicy(policy))
+	require.NoError(t, evaluatePolicy(policy, []string{"Org1MSP", "Org3MSP"}))
+	require.NoError(t, evaluatePolicy(policy, []string{"Org1MSP", "Org2MSP", "Org3MSP"}))
+	require.EqualError(t, evaluatePolicy(policy, []string{"Org1MSP", "Org4MSP"}),
+		"Notarizations do not meet threshold: required 2 of [Org1MSP Org2MSP Org3MSP], found 1")
+
+	// Invalid thresholds
+	require.Error(t, validatePolicy(&common.Policy{Type: "k-of-n", Criteria: []string{"Org1MSP"}}))
+	require.Error(t, validatePolicy(&common.Policy{Type: "k-of-n", Criteria: []string{"two", "Org1MSP", "Org2MSP"}}))
+	require.Error(t, validatePolicy(&common.Policy{Type: "k-of-n", Criteria: []string{"0", "Org1MSP"}}))
+	require.Error(t, validatePolicy(&common.Policy{Type: "k-of-n", Criteria: []string{"3", "Org1MSP", "Org2MSP"}}))
+
+	// A signer listed several times cannot count more than once towards the threshold
+	duplicatePolicy := &common.Policy{Type: "k-of-n", Criteria: []string{"2", "Org1MSP", "Org1MSP", "Org2MSP"}}
+	require.EqualError(t, validatePolicy(duplicatePolicy), "Duplicate k-of-n signer 'Org1MSP'")
+	require.EqualError(t, evaluatePolicy(duplicatePolicy, []string{"Org1MSP"}), "Duplicate k-of-n signer 'Org1MSP'")
+}
+
+func TestEvaluatePolicyExpression(t *testing.T) {
+	policy := &common.Policy{Type: "expression", Criteria: []string{"(OrgA && OrgB) || OrgC"}}
+	require.NoError(t, validatePolicy(policy))
+	require.NoError(t, evaluatePolicy(policy, []string{"OrgA", "OrgB"}))
+	require.NoError(t, evaluatePolicy(policy, []string{"OrgC"}))
+	require.EqualError(t, evaluatePolicy(policy, []string{"OrgA"}),
+		"Notarizations do not satisfy policy expression: (OrgA && OrgB) || OrgC")
+
+	// Keyword operators and precedence: AND binds tighter than OR
+	policy = &common.Policy{Type: "expression", Criteria: []string{"OrgA or OrgB AND OrgC"}}
+	require.NoError(t, evaluatePolicy(policy, []string{"OrgA"}))
+	require.NoError(t, evaluatePolicy(policy, []string{"OrgB", "OrgC"}))
+	require.Error(t, evaluatePolicy(policy, []string{"OrgB"}))
+
+	// Multiple criteria are ANDed
+	policy = &common.Policy{Type: "expression", Criteria: []string{"OrgA || OrgB", "OrgC"}}
+	require.NoError(t, evaluatePolicy(policy, []string{"OrgB", "OrgC"}))
+	require.Error(t, evaluatePolicy(policy, []string{"OrgB"}))
+
+	// Malformed expressions
+	for _, expression := range []string{"", "OrgA &&", "(OrgA || OrgB", "OrgA & OrgB", "OrgA OrgB", "|| OrgA", "OrgA)"} {
+		require.Error(t, validatePolicy(&common.Policy{Type: "expression", Criteria: []string{expression}}), expression)
+	}
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// relayedViews contains the code that lets views be forwarded through intermediate networks: the recording and
+// attestation of relayed views by an intermediate network, and the hop-by-hop verification of forwarded views
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	log "github.com/sirupsen/logrus"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+const relayedViewObjectType = "relayedView"
+
+// relayedViewProofType is the proof type of a View whose data is a ViewEnvelope
+const relayedViewProofType = "Relayed"
+
+// RelayedViewRecord is the record of a view from a foreign network that this network verified and relayed,
+// identified by the hex-encoded SHA-256 hash of the serialized view
+type RelayedViewRecord struct {
+	Address  string `json:"address"`
+	ViewHash string `json:"viewHash"`
+}
+
+func getViewHash(viewBytes []byte) string {
+	viewHash := sha256.Sum256(viewBytes)
+	return hex.EncodeToString(viewHash[:])
+}
+
+// RecordRelayedView cc verifies a view obtained from a foreign network and records that this network relays it, so
+// that this network can attest so, through GetRelayedView, to the networks that it forwards the view to.
+// Only the relay or a network admin can record a relayed view.
+func (s *SmartContract) RecordRelayedView(ctx contractapi.TransactionContextInterface, address string, b64ViewProto string) error {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		// Check if the caller has relay privileges
+		if isRelay, err := wutils.IsClientRelay(ctx.GetStub()); err != nil {
+			return fmt.Errorf("Relay client check error: %s", err)
+		} else if !isRelay {
+			return fmt.Errorf("Caller neither a network admin nor a relay; access denied")
+		}
+	}
+	viewBytes, err := base64.StdEncoding.DecodeString(b64ViewProto)
+	if err != nil {
+		return logThenErrorf("Unable to base64 decode data: %s", err.Error())
+	}
+	err = s.VerifyView(ctx, b64ViewProto, address)
+	if err != nil {
+		return logThenErrorf("VerifyView error: %s", err)
+	}
+
+	relayedViewRecord := RelayedViewRecord{Address: address, ViewHash: getViewHash(viewBytes)}
+	relayedViewKey, err := ctx.GetStub().CreateCompositeKey(relayedViewObjectType, []string{relayedViewRecord.ViewHash})
+	if err != nil {
+		return logThenErrorf("%s", err.Error())
+	}
+	relayedViewRecordBytes, err := json.Marshal(&relayedViewRecord)
+	if err != nil {
+		return logThenErrorf("Marshal error: %s", err)
+	}
+	return ctx.GetStub().PutState(relayedViewKey, relayedViewRecordBytes)
+}
+
+// GetRelayedView cc gets the record of a view relayed by this network, by the hex-encoded SHA-256 hash of the view.
+// Remote networks query this function to obtain this network's attestation that it relayed the view.
+func (s *SmartContract) GetRelayedView(ctx contractapi.TransactionContextInterface, viewHash string) (string, error) {
+	relayedViewKey, err := ctx.GetStub().CreateCompositeKey(relayedViewObjectType, []string{viewHash})
+	if err != nil {
+		return "", logThenErrorf("%s", err.Error())
+	}
+	relayedViewRecordBytes, err := ctx.GetStub().GetState(relayedViewKey)
+	if err != nil {
+		return "", logThenErrorf("%s", err.Error())
+	}
+	if relayedViewRecordBytes == nil {
+		return "", logThenErrorf("Relayed view with hash: %s does not exist", viewHash)
+	}
+	return string(relayedViewRecordBytes), nil
+}
+
+// decodeViewEnvelope decodes the envelope of a relayed view, returning it along with the decoded source view
+func decodeViewEnvelope(data []byte) (*common.ViewEnvelope, *common.View, error) {
+	var viewEnvelope common.ViewEnvelope
+	err := protoV2.Unmarshal(data, &viewEnvelope)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ViewEnvelope Unmarshal error: %s", err)
+	}
+	var sourceView common.View
+	err = protoV2.Unmarshal(viewEnvelope.View, &sourceView)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Source View Unmarshal error: %s", err)
+	}
+	if sourceView.GetMeta().GetProofType() == relayedViewProofType {
+		return nil, nil, fmt.Errorf("Source view of a relayed view cannot itself be relayed")
+	}
+	if len(viewEnvelope.Attestations) == 0 {
+		return nil, nil, fmt.Errorf("Relayed view carries no relay attestations")
+	}
+	return &viewEnvelope, &sourceView, nil
+}
+
+// verifyRelayedView verifies a view from a source network that was forwarded through intermediate networks.
+//
+// Verification requires the following checks to be performed:
+// 1. Verify the source view against the address and the source network's Membership and verification policy.
+// 2. Verify each relay attestation against its address and the intermediate network's Membership and verification
+// policy, starting with the intermediate network nearest to the source.
+// 3. Check that each relay attestation records the address and hash of the view preceding it.
+// Each relay attestation must be a view of GetRelayedView, with the hash of the view preceding it as argument, in the
+// intermediate network's Interop Chaincode, which is expected to be deployed under the same name as this one.
+func verifyRelayedView(s *SmartContract, ctx contractapi.TransactionContextInterface, data []byte, address string) error {
+	viewEnvelope, sourceView, err := decodeViewEnvelope(data)
+	if err != nil {
+		return err
+	}
+	interopChaincodeId, err := wutils.GetLocalChaincodeID(ctx.GetStub())
+	if err != nil {
+		return fmt.Errorf("Error getting this chaincode's ID: %s", err)
+	}
+
+	// 1. Verify the source view against the address and the source network's Membership and verification policy.
+	err = verifyView(s, ctx, sourceView, address)
+	if err != nil {
+		return fmt.Errorf("Source view verification failed: %s", err.Error())
+	}
+
+	precedingAddress, precedingViewBytes := address, viewEnvelope.View
+	for i, relayAttestation := range viewEnvelope.Attestations {
+		// 2. Verify the relay attestation against its address and the intermediate network's Membership and
+		// verification policy.
+		err = verifyRelayAttestationAddress(relayAttestation.Address, interopChaincodeId, getViewHash(precedingViewBytes))
+		if err != nil {
+			return fmt.Errorf("Relay attestation %d address error: %s", i, err.Error())
+		}
+		var attestationView common.View
+		err = protoV2.Unmarshal(relayAttestation.View, &attestationView)
+		if err != nil {
+			return fmt.Errorf("Relay attestation %d View Unmarshal error: %s", i, err)
+		}
+		if attestationView.GetMeta().GetProofType() == relayedViewProofType {
+			return fmt.Errorf("Relay attestation %d cannot itself be relayed", i)
+		}
+		err = verifyView(s, ctx, &attestationView, relayAttestation.Address)
+		if err != nil {
+			return fmt.Errorf("Relay attestation %d verification failed: %s", i, err.Error())
+		}
+
+		// 3. Check that the relay attestation records the address and hash of the view preceding it.
+		relayedViewRecordBytes, err := ExtractAndValidateDataFromView(&attestationView, []string{})
+		if err != nil {
+			return fmt.Errorf("Relay attestation %d data error: %s", i, err.Error())
+		}
+		var relayedViewRecord RelayedViewRecord
+		err = json.Unmarshal(relayedViewRecordBytes, &relayedViewRecord)
+		if err != nil {
+			return fmt.Errorf("Relay attestation %d Unmarshal error: %s", i, err)
+		}
+		if relayedViewRecord.Address != precedingAddress || relayedViewRecord.ViewHash != getViewHash(precedingViewBytes) {
+			return fmt.Errorf("Relay attestation %d does not attest the view preceding it: Address: %s Hash: %s", i, precedingAddress, getViewHash(precedingViewBytes))
+		}
+		precedingAddress, precedingViewBytes = relayAttestation.Address, relayAttestation.View
+	}
+	log.Infof("Proof associated with view relayed through %d networks for query '%s' is VALID", len(viewEnvelope.Attestations), address)
+	return nil
+}
+
+// verifyRelayAttestationAddress checks that the address of a relay attestation is that of the GetRelayedView function
+// of an intermediate network's Interop Chaincode, queried with the hash of the view preceding the attestation, so that
+// no other function or chaincode of the intermediate network can attest a relayed view
+func verifyRelayAttestationAddress(attestationAddress string, interopChaincodeId string, precedingViewHash string) error {
+	address, err := parseAddress(attestationAddress)
+	if err != nil {
+		return err
+	}
+	viewAddress, err := parseFabricViewAddress(address.ViewSegment)
+	if err != nil {
+		return err
+	}
+	if viewAddress.Contract != interopChaincodeId || viewAddress.CCFunc != "GetRelayedView" {
+		return fmt.Errorf("Address %s is not that of the GetRelayedView function of the Interop Chaincode %s", attestationAddress, interopChaincodeId)
+	}
+	if len(viewAddress.Args) != 1 || viewAddress.Args[0] != precedingViewHash {
+		return fmt.Errorf("Address %s does not query the hash %s of the view preceding the attestation", attestationAddress, precedingViewHash)
+	}
+	return nil
+}
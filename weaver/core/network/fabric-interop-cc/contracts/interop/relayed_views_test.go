@@ -0,0 +1,218 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/fabric"
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/require"
+	protoV2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// createFabricView creates a Fabric view of the given payload for the given address, endorsed by a single peer
+func createFabricView(t *testing.T, address string, payload []byte, mspId string, certPEM string, privKey *ecdsa.PrivateKey) []byte {
+	interopPayloadBytes, err := protoV2.Marshal(&common.InteropPayload{Address: address, Payload: payload})
+	require.NoError(t, err)
+	chaincodeActionBytes, err := proto.Marshal(&peer.ChaincodeAction{Response: &peer.Response{Status: 200, Payload: interopPayloadBytes}})
+	require.NoError(t, err)
+	proposalResponsePayload := &peer.ProposalResponsePayload{Extension: chaincodeActionBytes}
+	proposalResponsePayloadBytes, err := proto.Marshal(proposalResponsePayload)
+	require.NoError(t, err)
+	endorser, err := proto.Marshal(&msp.SerializedIdentity{Mspid: mspId, IdBytes: []byte(certPEM)})
+	require.NoError(t, err)
+	hashed := sha256.Sum256(append(proposalResponsePayloadBytes, endorser...))
+	signature, err := ecdsa.SignASN1(rand.Reader, privKey, hashed[:])
+	require.NoError(t, err)
+
+	fabricViewBytes, err := protoV2.Marshal(&fabric.FabricView{
+		EndorsedProposalResponses: []*fabric.FabricView_EndorsedProposalResponse{{
+			Payload:     proposalResponsePayload,
+			Endorsement: &peer.Endorsement{Endorser: endorser, Signature: signature},
+		}},
+	})
+	require.NoError(t, err)
+	viewBytes, err := protoV2.Marshal(&common.View{
+		Meta: &common.Meta{Protocol: common.Meta_FABRIC, ProofType: "Notarization", SerializationFormat: "STRING"},
+		Data: fabricViewBytes,
+	})
+	require.NoError(t, err)
+	return viewBytes
+}
+
+// createRelayedView wraps a source view and relay attestations in a view envelope
+func createRelayedView(t *testing.T, sourceViewBytes []byte, attestations []*common.RelayAttestation) string {
+	viewEnvelopeBytes, err := protoV2.Marshal(&common.ViewEnvelope{View: sourceViewBytes, Attestations: attestations})
+	require.NoError(t, err)
+	viewBytes, err := protoV2.Marshal(&common.View{
+		Meta: &common.Meta{Protocol: common.Meta_FABRIC, ProofType: relayedViewProofType, SerializationFormat: "STRING"},
+		Data: viewEnvelopeBytes,
+	})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(viewBytes)
+}
+
+func TestRelayedView(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	wtest.SetMockStubCCId(chaincodeStub, "interop")
+	interopcc := SmartContract{}
+
+	// Back the mock stub with an in-memory ledger
+	ledger := map[string][]byte{}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+	chaincodeStub.PutStateCalls(func(key string, value []byte) error {
+		ledger[key] = value
+		return nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + ":" + strings.Join(attributes, ":"), nil
+	})
+	chaincodeStub.InvokeChaincodeReturns(peer.Response{Status: 200, Payload: []byte("I am a result")})
+	putConfig := func(objectType string, securityDomain string, config interface{}) {
+		configBytes, err := json.Marshal(config)
+		require.NoError(t, err)
+		ledger[objectType+":"+securityDomain] = configBytes
+	}
+
+	// The source network, whose view is forwarded through the intermediate network
+	sourceAddress := "relay-network1:9080/network1/mychannel:simplestate:Read:a"
+	var sourceTestData TestData
+	sourceTestDataBytes, err := ioutil.ReadFile("./test_data/fabric_viewdata_1_org.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(sourceTestDataBytes, &sourceTestData))
+	sourceViewBytes, err := base64.StdEncoding.DecodeString(sourceTestData.B64View)
+	require.NoError(t, err)
+	sourceCACert, err := ioutil.ReadFile("./test_data/fabric_cacert_org1.pem")
+	require.NoError(t, err)
+	putConfig(membershipObjectType, "network1", &common.Membership{
+		SecurityDomain: "network1",
+		Members:        map[string]*common.Member{"Org1MSP": {Value: string(sourceCACert), Type: "ca"}},
+	})
+	putConfig(verificationPolicyObjectType, "network1", &common.VerificationPolicy{
+		SecurityDomain: "network1",
+		Identifiers:    []*common.Identifier{{Pattern: "mychannel:simplestate:Read:a", Policy: &common.Policy{Criteria: []string{"Org1MSP"}, Type: "signature"}}},
+	})
+
+	// The intermediate network, whose peer's certificate is its own CA
+	relayPrivKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	relayCert := createSelfSignedCert(t, &relayPrivKey.PublicKey, relayPrivKey)
+	relayCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: relayCert.Raw}))
+	putConfig(membershipObjectType, "network2", &common.Membership{
+		SecurityDomain: "network2",
+		Members:        map[string]*common.Member{"Org2MSP": {Value: relayCertPEM, Type: "ca"}},
+	})
+	putConfig(verificationPolicyObjectType, "network2", &common.VerificationPolicy{
+		SecurityDomain: "network2",
+		Identifiers:    []*common.Identifier{{Pattern: "mychannel:interop:GetRelayedView:*", Policy: &common.Policy{Criteria: []string{"Org2MSP"}, Type: "signature"}}},
+	})
+
+	// Test failure recording a view when the caller is neither a network admin nor a relay
+	chaincodeStub.GetCreatorReturns([]byte(getCreator()), nil)
+	err = interopcc.RecordRelayedView(ctx, sourceAddress, sourceTestData.B64View)
+	require.EqualError(t, err, "Caller neither a network admin nor a relay; access denied")
+	// Set caller to be the relay now
+	chaincodeStub.GetCreatorReturns([]byte(getRelayCreator()), nil)
+
+	// Test failure recording an invalid view, and querying a view that was not recorded
+	err = interopcc.RecordRelayedView(ctx, "relay-network1:9080/network1/mychannel:simplestate:Read:b", sourceTestData.B64View)
+	require.ErrorContains(t, err, "VerifyView error: ")
+	sourceViewHash := getViewHash(sourceViewBytes)
+	_, err = interopcc.GetRelayedView(ctx, sourceViewHash)
+	require.EqualError(t, err, "Relayed view with hash: "+sourceViewHash+" does not exist")
+
+	// Test success recording the source view in the intermediate network, and producing its attestation
+	err = interopcc.RecordRelayedView(ctx, sourceAddress, sourceTestData.B64View)
+	require.NoError(t, err)
+	relayedViewRecord, err := interopcc.GetRelayedView(ctx, sourceViewHash)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"address":"`+sourceAddress+`","viewHash":"`+sourceViewHash+`"}`, relayedViewRecord)
+	attestationAddress := "relay-network2:9080/network2/mychannel:interop:GetRelayedView:" + sourceViewHash
+	attestation := &common.RelayAttestation{
+		Address: attestationAddress,
+		View:    createFabricView(t, attestationAddress, []byte(relayedViewRecord), "Org2MSP", relayCertPEM, relayPrivKey),
+	}
+
+	// Test success consuming the relayed view, with the data of the source view
+	relayedView := createRelayedView(t, sourceViewBytes, []*common.RelayAttestation{attestation})
+	err = interopcc.VerifyView(ctx, relayedView, sourceAddress)
+	require.NoError(t, err)
+	err = interopcc.WriteExternalState(ctx, "simplestate", "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{sourceAddress}, []string{relayedView}, [][]string{{""}})
+	require.NoError(t, err)
+	var sourceView common.View
+	require.NoError(t, protoV2.Unmarshal(sourceViewBytes, &sourceView))
+	sourceViewData, err := ExtractAndValidateDataFromView(&sourceView, []string{""})
+	require.NoError(t, err)
+	_, invokeArgs, _ := chaincodeStub.InvokeChaincodeArgsForCall(0)
+	require.Equal(t, sourceViewData, invokeArgs[2])
+
+	// Test failure consuming the relayed view a second time with replay protection enabled
+	ledger[replayProtectionConfigKey] = []byte(`{"enabled":true,"timestampWindowSecs":0}`)
+	chaincodeStub.GetTxTimestampReturns(timestamppb.Now(), nil)
+	err = interopcc.WriteExternalState(ctx, "simplestate", "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{sourceAddress}, []string{relayedView}, [][]string{{""}})
+	require.NoError(t, err)
+	err = interopcc.WriteExternalState(ctx, "simplestate", "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{sourceAddress}, []string{relayedView}, [][]string{{""}})
+	require.ErrorContains(t, err, "Replay check failed: Replayed view: nonce ")
+	require.Equal(t, 2, chaincodeStub.InvokeChaincodeCallCount())
+	delete(ledger, replayProtectionConfigKey)
+
+	// Test failure when the source view does not match the address
+	err = interopcc.VerifyView(ctx, relayedView, "relay-network1:9080/network1/mychannel:simplestate:Read:b")
+	require.ErrorContains(t, err, "Source view verification failed: ")
+
+	// Test failure when no attestation is carried
+	err = interopcc.VerifyView(ctx, createRelayedView(t, sourceViewBytes, nil), sourceAddress)
+	require.EqualError(t, err, "Relayed view carries no relay attestations")
+
+	// Test failure when the attestation is for another view
+	otherRecord := `{"address":"` + sourceAddress + `","viewHash":"` + getViewHash([]byte("other view")) + `"}`
+	otherAttestation := &common.RelayAttestation{
+		Address: attestationAddress,
+		View:    createFabricView(t, attestationAddress, []byte(otherRecord), "Org2MSP", relayCertPEM, relayPrivKey),
+	}
+	err = interopcc.VerifyView(ctx, createRelayedView(t, sourceViewBytes, []*common.RelayAttestation{otherAttestation}), sourceAddress)
+	require.EqualError(t, err, "Relay attestation 0 does not attest the view preceding it: Address: "+sourceAddress+" Hash: "+sourceViewHash)
+
+	// Test failure when the attestation is not a view of the Interop Chaincode's GetRelayedView for the preceding view
+	for _, otherAttestationAddress := range []string{
+		"relay-network2:9080/network2/mychannel:simplestate:GetRelayedView:" + sourceViewHash,
+		"relay-network2:9080/network2/mychannel:interop:GetHTLCHash:" + sourceViewHash,
+		"relay-network2:9080/network2/mychannel:interop:GetRelayedView:" + getViewHash([]byte("other view")),
+	} {
+		otherAttestation := &common.RelayAttestation{
+			Address: otherAttestationAddress,
+			View:    createFabricView(t, otherAttestationAddress, []byte(relayedViewRecord), "Org2MSP", relayCertPEM, relayPrivKey),
+		}
+		err = interopcc.VerifyView(ctx, createRelayedView(t, sourceViewBytes, []*common.RelayAttestation{otherAttestation}), sourceAddress)
+		require.ErrorContains(t, err, "Relay attestation 0 address error: Address "+otherAttestationAddress)
+	}
+
+	// Test failure when the attestation does not satisfy the intermediate network's verification policy
+	putConfig(verificationPolicyObjectType, "network2", &common.VerificationPolicy{
+		SecurityDomain: "network2",
+		Identifiers:    []*common.Identifier{{Pattern: "mychannel:interop:GetRelayedView:*", Policy: &common.Policy{Criteria: []string{"Org2MSP", "Org3MSP"}, Type: "signature"}}},
+	})
+	err = interopcc.VerifyView(ctx, relayedView, sourceAddress)
+	require.ErrorContains(t, err, "Relay attestation 0 verification failed: ")
+}
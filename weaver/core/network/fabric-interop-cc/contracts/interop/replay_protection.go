@@ -0,0 +1,226 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// replayprotection contains the code that guards the writing of external state against replayed views, by recording
+// the nonces of the requests whose views were consumed on the ledger, and the related admin operations
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+const replayProtectionConfigKey = "replayProtectionConfig"
+const requestNonceObjectType = "requestNonce"
+
+// ReplayProtectionConfig determines whether views consumed by WriteExternalState are checked for replays. If
+// 'timestampWindowSecs' is non-zero, request nonces must be of the form '<seconds since epoch>:<unique suffix>', and the
+// (signed) timestamp must be within that many seconds of the transaction time.
+type ReplayProtectionConfig struct {
+	Enabled             bool   `json:"enabled"`
+	TimestampWindowSecs uint64 `json:"timestampWindowSecs"`
+}
+
+// requestNonceRecord is the ledger record of the nonce of a request whose view was consumed
+type requestNonceRecord struct {
+	TimestampSecs uint64 `json:"timestampSecs"`
+}
+
+// SetReplayProtection cc is used to enable or disable replay protection for views consumed by WriteExternalState
+func (s *SmartContract) SetReplayProtection(ctx contractapi.TransactionContextInterface, enabled bool, timestampWindowSecs uint64) error {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	configBytes, err := json.Marshal(&ReplayProtectionConfig{Enabled: enabled, TimestampWindowSecs: timestampWindowSecs})
+	if err != nil {
+		return logThenErrorf("Marshal error: %s", err)
+	}
+	return ctx.GetStub().PutState(replayProtectionConfigKey, configBytes)
+}
+
+// GetReplayProtection cc gets the replay protection configuration for views consumed by WriteExternalState
+func (s *SmartContract) GetReplayProtection(ctx contractapi.TransactionContextInterface) (string, error) {
+	config, err := getReplayProtectionConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return "", logThenErrorf("Marshal error: %s", err)
+	}
+	return string(configBytes), nil
+}
+
+// PruneRequestNonces cc is used to delete up to 'maxCount' nonce records of views that can no longer be replayed, as
+// their timestamps are outside the timestamp window; it returns the number of records deleted
+func (s *SmartContract) PruneRequestNonces(ctx contractapi.TransactionContextInterface, maxCount uint32) (uint32, error) {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return 0, fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return 0, fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	if maxCount == 0 {
+		return 0, logThenErrorf("Maximum number of nonce records to prune must be positive")
+	}
+	config, err := getReplayProtectionConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if config.TimestampWindowSecs == 0 {
+		return 0, logThenErrorf("Nonce records cannot be pruned without a request timestamp window, as their requests could then be replayed")
+	}
+	txTimeSecs, err := wutils.GetTxTimestampSecs(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	nonceIterator, err := ctx.GetStub().GetStateByPartialCompositeKey(requestNonceObjectType, []string{})
+	if err != nil {
+		return 0, logThenErrorf("Unable to read nonce records: %s", err)
+	}
+	defer nonceIterator.Close()
+
+	var prunedCount uint32
+	for nonceIterator.HasNext() && prunedCount < maxCount {
+		nonceKV, err := nonceIterator.Next()
+		if err != nil {
+			return 0, logThenErrorf("Unable to read nonce records: %s", err)
+		}
+		nonceRecord := requestNonceRecord{}
+		err = json.Unmarshal(nonceKV.Value, &nonceRecord)
+		if err != nil {
+			return 0, logThenErrorf("Unmarshal error: %s", err)
+		}
+		if nonceRecord.TimestampSecs+config.TimestampWindowSecs < txTimeSecs {
+			err = ctx.GetStub().DelState(nonceKV.Key)
+			if err != nil {
+				return 0, logThenErrorf("Unable to delete nonce record: %s", err)
+			}
+			prunedCount++
+		}
+	}
+	return prunedCount, nil
+}
+
+func getReplayProtectionConfig(ctx contractapi.TransactionContextInterface) (*ReplayProtectionConfig, error) {
+	configBytes, err := ctx.GetStub().GetState(replayProtectionConfigKey)
+	if err != nil {
+		return nil, logThenErrorf("Unable to read replay protection configuration: %s", err)
+	}
+	config := &ReplayProtectionConfig{}
+	if configBytes == nil {
+		return config, nil
+	}
+	err = json.Unmarshal(configBytes, config)
+	if err != nil {
+		return nil, logThenErrorf("Unmarshal error: %s", err)
+	}
+	return config, nil
+}
+
+// parseNonceTimestamp extracts the request timestamp from a nonce of the form '<seconds since epoch>:<unique suffix>'
+func parseNonceTimestamp(nonce string) (uint64, error) {
+	timestampString, _, found := strings.Cut(nonce, ":")
+	if !found {
+		return 0, fmt.Errorf("nonce %s does not carry a timestamp", nonce)
+	}
+	return strconv.ParseUint(timestampString, 10, 64)
+}
+
+// checkViewReplay rejects, if replay protection is enabled, a view whose request nonce has already been used by the
+// same requester with the same source network, or whose request timestamp is outside the configured window; the nonce
+// is otherwise recorded. The nonce is echoed by the source network in the interop payloads of the view, and is hence
+// covered by the (already verified) proof of the view.
+func checkViewReplay(ctx contractapi.TransactionContextInterface, address string, b64ViewProto string) error {
+	config, err := getReplayProtectionConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled {
+		return nil
+	}
+
+	viewBytes, err := base64.StdEncoding.DecodeString(b64ViewProto)
+	if err != nil {
+		return fmt.Errorf("Unable to base64 decode data: %s", err.Error())
+	}
+	var view common.View
+	err = protoV2.Unmarshal(viewBytes, &view)
+	if err != nil {
+		return fmt.Errorf("View Unmarshal error: %s", err)
+	}
+	interopPayloads, err := getInteropPayloadsFromView(&view)
+	if err != nil {
+		return err
+	}
+	if len(interopPayloads) == 0 {
+		return fmt.Errorf("View carries no interop payload")
+	}
+	nonce := interopPayloads[0].Nonce
+	requestorCertificate := interopPayloads[0].RequestorCertificate
+	for _, interopPayload := range interopPayloads[1:] {
+		if interopPayload.Nonce != nonce || interopPayload.RequestorCertificate != requestorCertificate {
+			return fmt.Errorf("Mismatching request nonces or requestors among interop payloads")
+		}
+	}
+	if nonce == "" {
+		return fmt.Errorf("Request nonce required for replay protection")
+	}
+	addressStruct, err := parseAddress(address)
+	if err != nil {
+		return fmt.Errorf("Unable to parse address: %s", err.Error())
+	}
+
+	timestampSecs, err := wutils.GetTxTimestampSecs(ctx.GetStub())
+	if err != nil {
+		return err
+	}
+	if config.TimestampWindowSecs > 0 {
+		requestTimestampSecs, err := parseNonceTimestamp(nonce)
+		if err != nil {
+			return fmt.Errorf("Invalid request timestamp: %s", err)
+		}
+		if requestTimestampSecs+config.TimestampWindowSecs < timestampSecs || requestTimestampSecs > timestampSecs+config.TimestampWindowSecs {
+			return fmt.Errorf("Request timestamp %d is not within %d seconds of the current time", requestTimestampSecs, config.TimestampWindowSecs)
+		}
+		timestampSecs = requestTimestampSecs
+	}
+
+	// The certificate is identified by its hash, to keep the key short
+	certificateHash := sha256.Sum256([]byte(requestorCertificate))
+	nonceKey, err := ctx.GetStub().CreateCompositeKey(requestNonceObjectType, []string{addressStruct.LedgerSegment, hex.EncodeToString(certificateHash[:]), nonce})
+	if err != nil {
+		return err
+	}
+	nonceRecordBytes, err := ctx.GetStub().GetState(nonceKey)
+	if err != nil {
+		return err
+	}
+	if nonceRecordBytes != nil {
+		return fmt.Errorf("Replayed view: nonce %s has already been used", nonce)
+	}
+	nonceRecordBytes, err = json.Marshal(&requestNonceRecord{TimestampSecs: timestampSecs})
+	if err != nil {
+		return err
+	}
+	return ctx.GetStub().PutState(nonceKey, nonceRecordBytes)
+}
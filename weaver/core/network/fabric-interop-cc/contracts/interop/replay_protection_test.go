@@ -0,0 +1,146 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils/mocks"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+	"github.com/stretchr/testify/require"
+	protoV2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// function that creates a (base64 encoded) view carrying the given request nonce, for replay checks, which do not verify
+// the proof of the view
+func createReplayTestView(t *testing.T, requestorCertificate, nonce string) string {
+	interopPayloadBytes, err := protoV2.Marshal(&common.InteropPayload{Payload: []byte("data"), RequestorCertificate: requestorCertificate, Nonce: nonce})
+	require.NoError(t, err)
+	besuViewBytes, err := protoV2.Marshal(&besu.BesuView{InteropPayload: interopPayloadBytes})
+	require.NoError(t, err)
+	viewBytes, err := protoV2.Marshal(&common.View{Meta: &common.Meta{Protocol: common.Meta_ETHEREUM, ProofType: "Notarization"}, Data: besuViewBytes})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(viewBytes)
+}
+
+func TestReplayProtection(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	// Back the mock stub with an in-memory ledger
+	ledger := map[string][]byte{}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+	chaincodeStub.PutStateCalls(func(key string, value []byte) error {
+		ledger[key] = value
+		return nil
+	})
+	chaincodeStub.DelStateCalls(func(key string) error {
+		delete(ledger, key)
+		return nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + ":" + strings.Join(attributes, ":"), nil
+	})
+	chaincodeStub.GetStateByPartialCompositeKeyCalls(func(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+		kvs := []*queryresult.KV{}
+		for key, value := range ledger {
+			if strings.HasPrefix(key, objectType+":") {
+				kvs = append(kvs, &queryresult.KV{Key: key, Value: value})
+			}
+		}
+		sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+		return createStateQueryIterator(kvs), nil
+	})
+	currentTimeSecs := uint64(time.Now().Unix())
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+
+	address := "localhost:9080/network1/mychannel:simplestate:Read:a"
+	view := createReplayTestView(t, "cert", "nonce1")
+
+	// Replay protection is disabled by default
+	config, err := interopcc.GetReplayProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, `{"enabled":false,"timestampWindowSecs":0}`, config)
+	require.NoError(t, checkViewReplay(ctx, address, view))
+	require.NoError(t, checkViewReplay(ctx, address, view))
+
+	// Only a network admin can configure replay protection
+	err = interopcc.SetReplayProtection(ctx, true, 0)
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	_, err = interopcc.PruneRequestNonces(ctx, 10)
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+
+	// Without a timestamp window, a nonce can be used only once per requester and source network
+	err = interopcc.SetReplayProtection(ctx, true, 0)
+	require.NoError(t, err)
+	require.NoError(t, checkViewReplay(ctx, address, view))
+	err = checkViewReplay(ctx, address, view)
+	require.EqualError(t, err, "Replayed view: nonce nonce1 has already been used")
+	require.NoError(t, checkViewReplay(ctx, "localhost:9080/network2/mychannel:simplestate:Read:a", view))
+	require.NoError(t, checkViewReplay(ctx, address, createReplayTestView(t, "cert2", "nonce1")))
+	err = checkViewReplay(ctx, address, createReplayTestView(t, "cert", ""))
+	require.EqualError(t, err, "Request nonce required for replay protection")
+	// Nonce records cannot be pruned without a timestamp window
+	_, err = interopcc.PruneRequestNonces(ctx, 10)
+	require.EqualError(t, err, "Nonce records cannot be pruned without a request timestamp window, as their requests could then be replayed")
+
+	// With a timestamp window, the nonce must carry a timestamp within the window
+	err = interopcc.SetReplayProtection(ctx, true, 60)
+	require.NoError(t, err)
+	config, err = interopcc.GetReplayProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, `{"enabled":true,"timestampWindowSecs":60}`, config)
+	err = checkViewReplay(ctx, address, createReplayTestView(t, "cert", "nonce2"))
+	require.EqualError(t, err, "Invalid request timestamp: nonce nonce2 does not carry a timestamp")
+	staleNonce := fmt.Sprintf("%d:abc", currentTimeSecs-61)
+	err = checkViewReplay(ctx, address, createReplayTestView(t, "cert", staleNonce))
+	require.EqualError(t, err, fmt.Sprintf("Request timestamp %d is not within 60 seconds of the current time", currentTimeSecs-61))
+	futureNonce := fmt.Sprintf("%d:abc", currentTimeSecs+61)
+	err = checkViewReplay(ctx, address, createReplayTestView(t, "cert", futureNonce))
+	require.EqualError(t, err, fmt.Sprintf("Request timestamp %d is not within 60 seconds of the current time", currentTimeSecs+61))
+	timestampedNonce := fmt.Sprintf("%d:abc", currentTimeSecs+30)
+	timestampedView := createReplayTestView(t, "cert", timestampedNonce)
+	require.NoError(t, checkViewReplay(ctx, address, timestampedView))
+	err = checkViewReplay(ctx, address, timestampedView)
+	require.EqualError(t, err, fmt.Sprintf("Replayed view: nonce %s has already been used", timestampedNonce))
+
+	// Pruning deletes only records outside the timestamp window, up to the given count
+	_, err = interopcc.PruneRequestNonces(ctx, 0)
+	require.EqualError(t, err, "Maximum number of nonce records to prune must be positive")
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 61)}, nil)
+	prunedCount, err := interopcc.PruneRequestNonces(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), prunedCount)
+	prunedCount, err = interopcc.PruneRequestNonces(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), prunedCount)
+	prunedCount, err = interopcc.PruneRequestNonces(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), prunedCount)
+	// The record of the timestamped request, still within the window, is retained
+	err = checkViewReplay(ctx, address, timestampedView)
+	require.EqualError(t, err, fmt.Sprintf("Replayed view: nonce %s has already been used", timestampedNonce))
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 91)}, nil)
+	prunedCount, err = interopcc.PruneRequestNonces(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), prunedCount)
+}
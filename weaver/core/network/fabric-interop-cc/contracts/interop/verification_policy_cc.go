@@ -32,6 +32,9 @@ func (s *SmartContract) CreateVerificationPolicy(ctx contractapi.TransactionCont
 	if err != nil {
 		return fmt.Errorf("Unmarshal error: %s", err)
 	}
+	if err := validateVerificationPolicy(verificationPolicy); err != nil {
+		return err
+	}
 	verificationPolicyKey, err := ctx.GetStub().CreateCompositeKey(verificationPolicyObjectType, []string{verificationPolicy.SecurityDomain})
 	acp, getErr := ctx.GetStub().GetState(verificationPolicyKey)
 	if getErr != nil {
@@ -61,6 +64,9 @@ func (s *SmartContract) UpdateVerificationPolicy(ctx contractapi.TransactionCont
 	if err != nil {
 		return fmt.Errorf("Unmarshal error: %s", err)
 	}
+	if err := validateVerificationPolicy(verificationPolicy); err != nil {
+		return err
+	}
 	verificationPolicyKey, err := ctx.GetStub().CreateCompositeKey(verificationPolicyObjectType, []string{verificationPolicy.SecurityDomain})
 	_, err = s.GetVerificationPolicyBySecurityDomain(ctx, verificationPolicy.SecurityDomain)
 	if err != nil {
@@ -134,6 +140,9 @@ func resolvePolicy(s *SmartContract, ctx contractapi.TransactionContextInterface
 	for _, identifier := range verificationPolicy.Identifiers {
 		// short circuit if there is an exact match
 		if identifier.Pattern == viewAddress {
+			if err := validatePolicy(identifier.Policy); err != nil {
+				return nil, fmt.Errorf("Verification Policy Error: %s", err.Error())
+			}
 			return identifier.Policy, nil
 		}
 
@@ -145,6 +154,9 @@ func resolvePolicy(s *SmartContract, ctx contractapi.TransactionContextInterface
 
 	// return the bestMatch if there was one
 	if currentBestMatch.Pattern != "" {
+		if err := validatePolicy(currentBestMatch.Policy); err != nil {
+			return nil, fmt.Errorf("Verification Policy Error: %s", err.Error())
+		}
 		return currentBestMatch.Policy, nil
 	}
 
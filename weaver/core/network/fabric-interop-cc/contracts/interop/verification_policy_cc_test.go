@@ -73,6 +73,18 @@ func TestCreateVerificationPolicy(t *testing.T) {
 	err = interopcc.CreateVerificationPolicy(ctx, string(verificationPolicyBytes))
 	require.EqualError(t, err, fmt.Sprintf("VerificationPolicy already exists with id: %s", verificationPolicyAsset.SecurityDomain))
 
+	// Malformed k-of-n policy
+	invalidVerificationPolicy := common.VerificationPolicy{
+		SecurityDomain: "2346",
+		Identifiers: []*common.Identifier{{
+			Pattern: "Identifier",
+			Policy:  &common.Policy{Type: "k-of-n", Criteria: []string{"3", "Org1MSP", "Org2MSP"}},
+		}},
+	}
+	invalidVerificationPolicyBytes, err := json.Marshal(&invalidVerificationPolicy)
+	require.NoError(t, err)
+	err = interopcc.CreateVerificationPolicy(ctx, string(invalidVerificationPolicyBytes))
+	require.EqualError(t, err, "Invalid policy for pattern 'Identifier': Invalid k-of-n threshold 3 for 2 signers")
 }
 
 func TestUpdateVerificationPolicy(t *testing.T) {
@@ -0,0 +1,237 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// verifiedViewCache contains the code that lets views submitted repeatedly to the interop chaincode skip
+// re-verification, by caching the verification of views on the ledger, and the related admin operations
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	log "github.com/sirupsen/logrus"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+const verifiedViewCacheConfigKey = "verifiedViewCacheConfig"
+const verifiedViewObjectType = "verifiedView"
+
+// VerifiedViewCacheConfig determines whether the views verified when writing external state are cached, so that a
+// view submitted again within 'maxAgeSecs' seconds of its verification is not verified again
+type VerifiedViewCacheConfig struct {
+	Enabled    bool   `json:"enabled"`
+	MaxAgeSecs uint64 `json:"maxAgeSecs"`
+}
+
+// VerifiedViewRecord is the cache entry of a verified view, keyed by the hex-encoded SHA-256 hash of the view.
+// The policy version identifies the Memberships and verification policies that the view was verified against.
+type VerifiedViewRecord struct {
+	Address        string `json:"address"`
+	VerifiedAtSecs uint64 `json:"verifiedAtSecs"`
+	PolicyVersion  string `json:"policyVersion"`
+}
+
+// SetVerifiedViewCache cc is used to enable or disable the caching of verified views
+func (s *SmartContract) SetVerifiedViewCache(ctx contractapi.TransactionContextInterface, enabled bool, maxAgeSecs uint64) error {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	if enabled && maxAgeSecs == 0 {
+		return logThenErrorf("Maximum age of cached views must be positive")
+	}
+	configBytes, err := json.Marshal(&VerifiedViewCacheConfig{Enabled: enabled, MaxAgeSecs: maxAgeSecs})
+	if err != nil {
+		return logThenErrorf("Marshal error: %s", err)
+	}
+	return ctx.GetStub().PutState(verifiedViewCacheConfigKey, configBytes)
+}
+
+// GetVerifiedViewCache cc gets the configuration of the caching of verified views
+func (s *SmartContract) GetVerifiedViewCache(ctx contractapi.TransactionContextInterface) (string, error) {
+	config, err := getVerifiedViewCacheConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return "", logThenErrorf("Marshal error: %s", err)
+	}
+	return string(configBytes), nil
+}
+
+// PruneVerifiedViews cc is used to delete up to 'maxCount' cache entries of verified views that are older than the
+// maximum age (or all entries if the cache is disabled); it returns the number of entries deleted
+func (s *SmartContract) PruneVerifiedViews(ctx contractapi.TransactionContextInterface, maxCount uint32) (uint32, error) {
+	// Check if the caller has network admin privileges
+	if isAdmin, err := wutils.IsClientNetworkAdmin(ctx); err != nil {
+		return 0, fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return 0, fmt.Errorf("Caller not a network admin; access denied")
+	}
+
+	if maxCount == 0 {
+		return 0, logThenErrorf("Maximum number of cached views to prune must be positive")
+	}
+	config, err := getVerifiedViewCacheConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+	txTimeSecs, err := wutils.GetTxTimestampSecs(ctx.GetStub())
+	if err != nil {
+		return 0, logThenErrorf("%s", err.Error())
+	}
+
+	verifiedViewIterator, err := ctx.GetStub().GetStateByPartialCompositeKey(verifiedViewObjectType, []string{})
+	if err != nil {
+		return 0, logThenErrorf("Unable to read cached views: %s", err)
+	}
+	defer verifiedViewIterator.Close()
+
+	var prunedCount uint32
+	for verifiedViewIterator.HasNext() && prunedCount < maxCount {
+		verifiedViewKV, err := verifiedViewIterator.Next()
+		if err != nil {
+			return 0, logThenErrorf("Unable to read cached views: %s", err)
+		}
+		verifiedViewRecord := VerifiedViewRecord{}
+		err = json.Unmarshal(verifiedViewKV.Value, &verifiedViewRecord)
+		if err != nil {
+			return 0, logThenErrorf("Unmarshal error: %s", err)
+		}
+		if !config.Enabled || verifiedViewRecord.VerifiedAtSecs+config.MaxAgeSecs < txTimeSecs {
+			err = ctx.GetStub().DelState(verifiedViewKV.Key)
+			if err != nil {
+				return 0, logThenErrorf("Unable to delete cached view: %s", err)
+			}
+			prunedCount++
+		}
+	}
+	return prunedCount, nil
+}
+
+func getVerifiedViewCacheConfig(ctx contractapi.TransactionContextInterface) (*VerifiedViewCacheConfig, error) {
+	configBytes, err := ctx.GetStub().GetState(verifiedViewCacheConfigKey)
+	if err != nil {
+		return nil, logThenErrorf("Unable to read verified view cache configuration: %s", err)
+	}
+	config := &VerifiedViewCacheConfig{}
+	if configBytes == nil {
+		return config, nil
+	}
+	err = json.Unmarshal(configBytes, config)
+	if err != nil {
+		return nil, logThenErrorf("Unmarshal error: %s", err)
+	}
+	return config, nil
+}
+
+// getViewPolicyVersion identifies the versions of the Memberships and verification policies of the security domains
+// that a view is verified against, i.e., that of the address and, for a relayed view, those of its attestations,
+// by hashing the records of these on the ledger
+func getViewPolicyVersion(ctx contractapi.TransactionContextInterface, view *common.View, address string) (string, error) {
+	addresses := []string{address}
+	if view.GetMeta().GetProofType() == relayedViewProofType {
+		viewEnvelope, _, err := decodeViewEnvelope(view.Data)
+		if err != nil {
+			return "", err
+		}
+		for _, relayAttestation := range viewEnvelope.Attestations {
+			addresses = append(addresses, relayAttestation.Address)
+		}
+	}
+
+	policyVersionHash := sha256.New()
+	for _, viewAddress := range addresses {
+		addressStruct, err := parseAddress(viewAddress)
+		if err != nil {
+			return "", fmt.Errorf("Unable to parse address: %s", err.Error())
+		}
+		for _, objectType := range []string{membershipObjectType, verificationPolicyObjectType} {
+			recordKey, err := ctx.GetStub().CreateCompositeKey(objectType, []string{addressStruct.LedgerSegment})
+			if err != nil {
+				return "", err
+			}
+			recordBytes, err := ctx.GetStub().GetState(recordKey)
+			if err != nil {
+				return "", err
+			}
+			recordHash := sha256.Sum256(recordBytes)
+			policyVersionHash.Write(recordHash[:])
+		}
+	}
+	return hex.EncodeToString(policyVersionHash.Sum(nil)), nil
+}
+
+// verifyViewWithCache verifies a view as VerifyView does, unless the verified view cache is enabled and holds an
+// entry for the view and address that is within the maximum age, and whose policy version is current.
+// A view verified while the cache is enabled is recorded in it.
+func verifyViewWithCache(s *SmartContract, ctx contractapi.TransactionContextInterface, b64ViewProto string, address string) error {
+	config, err := getVerifiedViewCacheConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if !config.Enabled {
+		return s.VerifyView(ctx, b64ViewProto, address)
+	}
+
+	viewBytes, err := base64.StdEncoding.DecodeString(b64ViewProto)
+	if err != nil {
+		return fmt.Errorf("Unable to base64 decode data: %s", err.Error())
+	}
+	var view common.View
+	err = protoV2.Unmarshal(viewBytes, &view)
+	if err != nil {
+		return fmt.Errorf("View Unmarshal error: %s", err)
+	}
+	policyVersion, err := getViewPolicyVersion(ctx, &view, address)
+	if err != nil {
+		return err
+	}
+	txTimeSecs, err := wutils.GetTxTimestampSecs(ctx.GetStub())
+	if err != nil {
+		return err
+	}
+	verifiedViewKey, err := ctx.GetStub().CreateCompositeKey(verifiedViewObjectType, []string{getViewHash(viewBytes)})
+	if err != nil {
+		return err
+	}
+	verifiedViewRecordBytes, err := ctx.GetStub().GetState(verifiedViewKey)
+	if err != nil {
+		return err
+	}
+	if verifiedViewRecordBytes != nil {
+		verifiedViewRecord := VerifiedViewRecord{}
+		err = json.Unmarshal(verifiedViewRecordBytes, &verifiedViewRecord)
+		if err != nil {
+			return fmt.Errorf("Unmarshal error: %s", err)
+		}
+		if verifiedViewRecord.Address == address && verifiedViewRecord.PolicyVersion == policyVersion &&
+			verifiedViewRecord.VerifiedAtSecs <= txTimeSecs && txTimeSecs <= verifiedViewRecord.VerifiedAtSecs+config.MaxAgeSecs {
+			log.Infof("Proof for query '%s' was verified at %d; skipping verification", address, verifiedViewRecord.VerifiedAtSecs)
+			return nil
+		}
+	}
+
+	err = verifyView(s, ctx, &view, address)
+	if err != nil {
+		return err
+	}
+	verifiedViewRecordBytes, err = json.Marshal(&VerifiedViewRecord{Address: address, VerifiedAtSecs: txTimeSecs, PolicyVersion: policyVersion})
+	if err != nil {
+		return fmt.Errorf("Marshal error: %s", err)
+	}
+	return ctx.GetStub().PutState(verifiedViewKey, verifiedViewRecordBytes)
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils/mocks"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/ledger/queryresult"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestVerifiedViewCache(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	// Back the mock stub with an in-memory ledger holding the configuration of the source network
+	ledger := map[string][]byte{}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+	chaincodeStub.PutStateCalls(func(key string, value []byte) error {
+		ledger[key] = value
+		return nil
+	})
+	chaincodeStub.DelStateCalls(func(key string) error {
+		delete(ledger, key)
+		return nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + ":" + strings.Join(attributes, ":"), nil
+	})
+	chaincodeStub.GetStateByPartialCompositeKeyCalls(func(objectType string, attributes []string) (shim.StateQueryIteratorInterface, error) {
+		kvs := []*queryresult.KV{}
+		for key, value := range ledger {
+			if strings.HasPrefix(key, objectType+":") {
+				kvs = append(kvs, &queryresult.KV{Key: key, Value: value})
+			}
+		}
+		sort.Slice(kvs, func(i, j int) bool { return kvs[i].Key < kvs[j].Key })
+		return createStateQueryIterator(kvs), nil
+	})
+	currentTimeSecs := uint64(1700000000)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs)}, nil)
+
+	caCert, err := ioutil.ReadFile("./test_data/fabric_cacert_org1.pem")
+	require.NoError(t, err)
+	ledger[membershipObjectType+":network1"], err = json.Marshal(&common.Membership{
+		SecurityDomain: "network1",
+		Members:        map[string]*common.Member{"Org1MSP": {Value: string(caCert), Type: "ca"}},
+	})
+	require.NoError(t, err)
+	verificationPolicy := &common.VerificationPolicy{
+		SecurityDomain: "network1",
+		Identifiers:    []*common.Identifier{{Pattern: "mychannel:simplestate:Read:a", Policy: &common.Policy{Criteria: []string{"Org1MSP"}, Type: "signature"}}},
+	}
+	ledger[verificationPolicyObjectType+":network1"], err = json.Marshal(verificationPolicy)
+	require.NoError(t, err)
+	var testData TestData
+	testDataBytes, err := ioutil.ReadFile("./test_data/fabric_viewdata_1_org.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(testDataBytes, &testData))
+	address := "relay-network1:9080/network1/mychannel:simplestate:Read:a"
+	viewBytes, err := base64.StdEncoding.DecodeString(testData.B64View)
+	require.NoError(t, err)
+	verifiedViewKey := verifiedViewObjectType + ":" + getViewHash(viewBytes)
+	getVerifiedViewRecord := func() VerifiedViewRecord {
+		verifiedViewRecord := VerifiedViewRecord{}
+		require.NoError(t, json.Unmarshal(ledger[verifiedViewKey], &verifiedViewRecord))
+		return verifiedViewRecord
+	}
+
+	// The cache is disabled by default, and views verified then are not cached
+	config, err := interopcc.GetVerifiedViewCache(ctx)
+	require.NoError(t, err)
+	require.Equal(t, `{"enabled":false,"maxAgeSecs":0}`, config)
+	_, err = interopcc.ParseAndValidateView(ctx, address, testData.B64View, []string{""})
+	require.NoError(t, err)
+	require.Nil(t, ledger[verifiedViewKey])
+
+	// Only a network admin can configure the cache
+	err = interopcc.SetVerifiedViewCache(ctx, true, 60)
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	_, err = interopcc.PruneVerifiedViews(ctx, 10)
+	require.EqualError(t, err, "Caller not a network admin; access denied")
+	clientIdentity := &mocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueCalls(setClientAdmin)
+	ctx.GetClientIdentityReturns(clientIdentity)
+	err = interopcc.SetVerifiedViewCache(ctx, true, 0)
+	require.EqualError(t, err, "Maximum age of cached views must be positive")
+	err = interopcc.SetVerifiedViewCache(ctx, true, 60)
+	require.NoError(t, err)
+	config, err = interopcc.GetVerifiedViewCache(ctx)
+	require.NoError(t, err)
+	require.Equal(t, `{"enabled":true,"maxAgeSecs":60}`, config)
+
+	// A verified view is cached, with the address, verification time and policy version
+	_, err = interopcc.ParseAndValidateView(ctx, address, testData.B64View, []string{""})
+	require.NoError(t, err)
+	verifiedViewRecord := getVerifiedViewRecord()
+	require.Equal(t, address, verifiedViewRecord.Address)
+	require.Equal(t, currentTimeSecs, verifiedViewRecord.VerifiedAtSecs)
+	policyVersion := verifiedViewRecord.PolicyVersion
+	require.NotEmpty(t, policyVersion)
+
+	// Within the maximum age, the view is not verified again
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 60)}, nil)
+	_, err = interopcc.ParseAndValidateView(ctx, address, testData.B64View, []string{""})
+	require.NoError(t, err)
+	require.Equal(t, currentTimeSecs, getVerifiedViewRecord().VerifiedAtSecs)
+
+	// Beyond the maximum age, the view is verified again
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 61)}, nil)
+	_, err = interopcc.ParseAndValidateView(ctx, address, testData.B64View, []string{""})
+	require.NoError(t, err)
+	require.Equal(t, currentTimeSecs+61, getVerifiedViewRecord().VerifiedAtSecs)
+
+	// A change in the verification policy of the source network invalidates the cached entry
+	verificationPolicy.Identifiers = append(verificationPolicy.Identifiers, &common.Identifier{Pattern: "mychannel:simplestate:Read:b", Policy: &common.Policy{Criteria: []string{"Org1MSP"}, Type: "signature"}})
+	ledger[verificationPolicyObjectType+":network1"], err = json.Marshal(verificationPolicy)
+	require.NoError(t, err)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 62)}, nil)
+	_, err = interopcc.ParseAndValidateView(ctx, address, testData.B64View, []string{""})
+	require.NoError(t, err)
+	verifiedViewRecord = getVerifiedViewRecord()
+	require.Equal(t, currentTimeSecs+62, verifiedViewRecord.VerifiedAtSecs)
+	require.NotEqual(t, policyVersion, verifiedViewRecord.PolicyVersion)
+
+	// A cached entry is not used for a different address, under which the view fails verification
+	_, err = interopcc.ParseAndValidateView(ctx, "relay-network1:9080/network1/mychannel:simplestate:Read:b", testData.B64View, []string{""})
+	require.Error(t, err)
+
+	// A deleted membership is never served from the cache
+	delete(ledger, membershipObjectType+":network1")
+	_, err = interopcc.ParseAndValidateView(ctx, address, testData.B64View, []string{""})
+	require.Error(t, err)
+
+	// Pruning deletes only entries older than the maximum age, or all entries if the cache is disabled
+	_, err = interopcc.PruneVerifiedViews(ctx, 0)
+	require.EqualError(t, err, "Maximum number of cached views to prune must be positive")
+	prunedCount, err := interopcc.PruneVerifiedViews(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), prunedCount)
+	chaincodeStub.GetTxTimestampReturns(&timestamppb.Timestamp{Seconds: int64(currentTimeSecs + 123)}, nil)
+	prunedCount, err = interopcc.PruneVerifiedViews(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), prunedCount)
+	ledger[verifiedViewKey], err = json.Marshal(&verifiedViewRecord)
+	require.NoError(t, err)
+	err = interopcc.SetVerifiedViewCache(ctx, false, 0)
+	require.NoError(t, err)
+	prunedCount, err = interopcc.PruneVerifiedViews(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), prunedCount)
+	require.Nil(t, ledger[verifiedViewKey])
+}
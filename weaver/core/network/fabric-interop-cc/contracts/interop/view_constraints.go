@@ -0,0 +1,179 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// viewConstraints contains the code that checks the consistency of views supplied together to the interop chaincode,
+// before their data is passed on to an application chaincode
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+const (
+	fieldsEqualViewConstraintType     = "fieldsEqual"
+	sameBlockHeightViewConstraintType = "sameBlockHeight"
+)
+
+// ViewField identifies a field in the JSON data of a view, by the index of the view and the path of the field, i.e.,
+// the object keys and array indices leading to it, separated by '.' (e.g., 'owner.id' or 'holders.0').
+// An empty path identifies the whole data of the view, which then need not be JSON.
+type ViewField struct {
+	View int    `json:"view"`
+	Path string `json:"path"`
+}
+
+// ViewConstraint is a consistency constraint across views.
+// A 'fieldsEqual' constraint requires the given fields of views to have equal values.
+// A 'sameBlockHeight' constraint requires the given views, or all views if none are given, to have been served at
+// the same block height of their ledger; it is supported for views whose proofs cover the block height (Besu views).
+type ViewConstraint struct {
+	Type   string      `json:"type"`
+	Fields []ViewField `json:"fields,omitempty"`
+	Views  []int       `json:"views,omitempty"`
+}
+
+// getViewFieldValue returns the value of the field at the given path in the data of a view
+func getViewFieldValue(viewData string, path string) (interface{}, error) {
+	if path == "" {
+		return viewData, nil
+	}
+	var value interface{}
+	dec := json.NewDecoder(strings.NewReader(viewData))
+	dec.UseNumber()
+	err := dec.Decode(&value)
+	if err != nil {
+		return nil, fmt.Errorf("View data is not JSON: %s", err)
+	}
+	for _, segment := range strings.Split(path, ".") {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			fieldValue, exists := node[segment]
+			if !exists {
+				return nil, fmt.Errorf("Field %s not found in view data", path)
+			}
+			value = fieldValue
+		case []interface{}:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, fmt.Errorf("Field %s not found in view data", path)
+			}
+			value = node[index]
+		default:
+			return nil, fmt.Errorf("Field %s not found in view data", path)
+		}
+	}
+	return value, nil
+}
+
+// getViewBlockHeight returns the block height of the ledger a view was served at, for views whose proof covers it,
+// i.e., the number of the block header in the proof of a Besu view. The proofs of other views (e.g., endorsements of a
+// Fabric network) do not attest to a block height, so these views cannot be checked. The view of a relayed view is
+// that of the source network.
+func getViewBlockHeight(b64ViewProto string) (uint64, error) {
+	viewBytes, err := base64.StdEncoding.DecodeString(b64ViewProto)
+	if err != nil {
+		return 0, fmt.Errorf("Unable to base64 decode data: %s", err.Error())
+	}
+	view := &common.View{}
+	err = protoV2.Unmarshal(viewBytes, view)
+	if err != nil {
+		return 0, fmt.Errorf("View Unmarshal error: %s", err)
+	}
+	if view.GetMeta().GetProofType() == relayedViewProofType {
+		_, view, err = decodeViewEnvelope(view.Data)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if view.GetMeta().GetProtocol() != common.Meta_ETHEREUM {
+		return 0, fmt.Errorf("Block height of %s views is not covered by their proofs", view.GetMeta().GetProtocol().String())
+	}
+
+	var besuViewData besu.BesuView
+	err = protoV2.Unmarshal(view.Data, &besuViewData)
+	if err != nil {
+		return 0, fmt.Errorf("BesuView Unmarshal error: %s", err)
+	}
+	blockNumberBytes, err := parseQuantity(besuViewData.GetBlockHeader().GetNumber())
+	if err != nil {
+		return 0, fmt.Errorf("Invalid block number: %s", err.Error())
+	}
+	blockNumber := new(big.Int).SetBytes(blockNumberBytes)
+	if !blockNumber.IsUint64() {
+		return 0, fmt.Errorf("Invalid block number: %s", blockNumber.String())
+	}
+	return blockNumber.Uint64(), nil
+}
+
+// evaluateViewConstraints checks that the views, whose (validated) data is given, satisfy the given constraints
+func evaluateViewConstraints(viewConstraints []ViewConstraint, b64ViewProtos []string, viewDataList []string) error {
+	checkViewIndex := func(constraintIndex int, viewIndex int) error {
+		if viewIndex < 0 || viewIndex >= len(viewDataList) {
+			return fmt.Errorf("Constraint %d: view index %d out of bounds of array (length %d)", constraintIndex, viewIndex, len(viewDataList))
+		}
+		return nil
+	}
+
+	for c, viewConstraint := range viewConstraints {
+		switch viewConstraint.Type {
+		case fieldsEqualViewConstraintType:
+			if len(viewConstraint.Fields) < 2 {
+				return fmt.Errorf("Constraint %d: at least two fields must be compared", c)
+			}
+			var firstValue interface{}
+			for i, viewField := range viewConstraint.Fields {
+				if err := checkViewIndex(c, viewField.View); err != nil {
+					return err
+				}
+				value, err := getViewFieldValue(viewDataList[viewField.View], viewField.Path)
+				if err != nil {
+					return fmt.Errorf("Constraint %d: view %d: %s", c, viewField.View, err.Error())
+				}
+				if i == 0 {
+					firstValue = value
+				} else if !reflect.DeepEqual(firstValue, value) {
+					firstField := viewConstraint.Fields[0]
+					return fmt.Errorf("Constraint %d: field '%s' of view %d does not match field '%s' of view %d", c, viewField.Path, viewField.View, firstField.Path, firstField.View)
+				}
+			}
+		case sameBlockHeightViewConstraintType:
+			viewIndices := viewConstraint.Views
+			if len(viewIndices) == 0 {
+				for i := range b64ViewProtos {
+					viewIndices = append(viewIndices, i)
+				}
+			}
+			var firstBlockHeight uint64
+			for i, viewIndex := range viewIndices {
+				if err := checkViewIndex(c, viewIndex); err != nil {
+					return err
+				}
+				blockHeight, err := getViewBlockHeight(b64ViewProtos[viewIndex])
+				if err != nil {
+					return fmt.Errorf("Constraint %d: view %d: %s", c, viewIndex, err.Error())
+				}
+				if i == 0 {
+					firstBlockHeight = blockHeight
+				} else if blockHeight != firstBlockHeight {
+					return fmt.Errorf("Constraint %d: view %d is at block height %d, but view %d is at block height %d", c, viewIndex, blockHeight, viewIndices[0], firstBlockHeight)
+				}
+			}
+		default:
+			return fmt.Errorf("Constraint %d: unsupported constraint type: %s", c, viewConstraint.Type)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/stretchr/testify/require"
+	protoV2 "google.golang.org/protobuf/proto"
+)
+
+// createBesuViewAtBlockHeight creates an (unsigned) Besu view whose block header has the given block number
+func createBesuViewAtBlockHeight(t *testing.T, blockNumber string) string {
+	besuViewDataBytes, err := protoV2.Marshal(&besu.BesuView{BlockHeader: &besu.BlockHeader{Number: blockNumber}})
+	require.NoError(t, err)
+	viewBytes, err := protoV2.Marshal(&common.View{Meta: &common.Meta{Protocol: common.Meta_ETHEREUM, ProofType: "Notarization"}, Data: besuViewDataBytes})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(viewBytes)
+}
+
+func TestEvaluateViewConstraints(t *testing.T) {
+	viewDataList := []string{
+		`{"bond":{"id":"b01","owner":"Alice"},"rates":[1.5,2]}`,
+		`{"holder":"Alice","rate":2,"issuer":"Bob"}`,
+		`17.12`,
+	}
+	evaluate := func(viewConstraintsJSON string, b64ViewProtos []string) error {
+		viewConstraints, err := decodeViewConstraints([]byte(viewConstraintsJSON))
+		require.NoError(t, err)
+		return evaluateViewConstraints(viewConstraints, b64ViewProtos, viewDataList)
+	}
+
+	// Test success and failure comparing fields of views
+	err := evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"bond.owner"},{"view":1,"path":"holder"}]},`+
+		`{"type":"fieldsEqual","fields":[{"view":0,"path":"rates.1"},{"view":1,"path":"rate"}]}]`, nil)
+	require.NoError(t, err)
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"bond.owner"},{"view":1,"path":"issuer"}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: field 'issuer' of view 1 does not match field 'bond.owner' of view 0")
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"rates.0"},{"view":1,"path":"rate"}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: field 'rate' of view 1 does not match field 'rates.0' of view 0")
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"bond.holder"},{"view":1,"path":"holder"}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: view 0: Field bond.holder not found in view data")
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"rates.2"},{"view":1,"path":"rate"}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: view 0: Field rates.2 not found in view data")
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":2,"path":""},{"view":0,"path":""}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: field '' of view 0 does not match field '' of view 2")
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"bond.owner"}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: at least two fields must be compared")
+	err = evaluate(`[{"type":"fieldsEqual","fields":[{"view":0,"path":"bond.owner"},{"view":3,"path":"holder"}]}]`, nil)
+	require.EqualError(t, err, "Constraint 0: view index 3 out of bounds of array (length 3)")
+
+	// Test success and failure comparing the block heights of views
+	cordaViewBytes, err := protoV2.Marshal(&common.View{Meta: &common.Meta{Protocol: common.Meta_CORDA, ProofType: "Notarization"}})
+	require.NoError(t, err)
+	cordaView := base64.StdEncoding.EncodeToString(cordaViewBytes)
+	err = evaluate(`[{"type":"sameBlockHeight"}]`, []string{createBesuViewAtBlockHeight(t, "0x2a"), createBesuViewAtBlockHeight(t, "42"), createBesuViewAtBlockHeight(t, "0x2a")})
+	require.NoError(t, err)
+	err = evaluate(`[{"type":"sameBlockHeight","views":[0,2]}]`, []string{createBesuViewAtBlockHeight(t, "0x2a"), cordaView, createBesuViewAtBlockHeight(t, "0x2b")})
+	require.EqualError(t, err, "Constraint 0: view 2 is at block height 43, but view 0 is at block height 42")
+	err = evaluate(`[{"type":"sameBlockHeight","views":[0,1]}]`, []string{createBesuViewAtBlockHeight(t, "0x2a"), cordaView, cordaView})
+	require.EqualError(t, err, "Constraint 0: view 1: Block height of CORDA views is not covered by their proofs")
+	err = evaluate(`[{"type":"sameBlockHeight","views":[0,1]}]`, []string{createBesuViewAtBlockHeight(t, "0x2a"), createBesuViewAtBlockHeight(t, "0xzz"), cordaView})
+	require.EqualError(t, err, "Constraint 0: view 1: Invalid block number: Invalid quantity: 0xzz")
+
+	// Test failure with an unsupported constraint type
+	err = evaluate(`[{"type":"sameTimestamp"}]`, nil)
+	require.EqualError(t, err, "Constraint 0: unsupported constraint type: sameTimestamp")
+}
+
+func TestWriteExternalStateWithConstraints(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	interopcc := SmartContract{}
+
+	// Back the mock stub with an in-memory ledger holding the configuration of the source network
+	ledger := map[string][]byte{}
+	chaincodeStub.GetStateCalls(func(key string) ([]byte, error) {
+		return ledger[key], nil
+	})
+	chaincodeStub.CreateCompositeKeyCalls(func(objectType string, attributes []string) (string, error) {
+		return objectType + ":" + strings.Join(attributes, ":"), nil
+	})
+	chaincodeStub.InvokeChaincodeReturns(peer.Response{Status: 200, Payload: []byte("I am a result")})
+	caCert, err := ioutil.ReadFile("./test_data/fabric_cacert_org1.pem")
+	require.NoError(t, err)
+	ledger[membershipObjectType+":network1"], err = json.Marshal(&common.Membership{
+		SecurityDomain: "network1",
+		Members:        map[string]*common.Member{"Org1MSP": {Value: string(caCert), Type: "ca"}},
+	})
+	require.NoError(t, err)
+	ledger[verificationPolicyObjectType+":network1"], err = json.Marshal(&common.VerificationPolicy{
+		SecurityDomain: "network1",
+		Identifiers:    []*common.Identifier{{Pattern: "mychannel:simplestate:Read:a", Policy: &common.Policy{Criteria: []string{"Org1MSP"}, Type: "signature"}}},
+	})
+	require.NoError(t, err)
+	var testData TestData
+	testDataBytes, err := ioutil.ReadFile("./test_data/fabric_viewdata_1_org.json")
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(testDataBytes, &testData))
+	address := "relay-network1:9080/network1/mychannel:simplestate:Read:a"
+	writeExternalStateWithConstraints := func(viewConstraintsJSON string) error {
+		return interopcc.WriteExternalStateWithConstraints(ctx, "simplestate", "mychannel", "Write", []string{"", ""}, []int{0, 1},
+			[]string{address, address}, []string{testData.B64View, testData.B64View}, [][]string{{""}, {""}}, viewConstraintsJSON)
+	}
+
+	// Test success when the constraints are satisfied
+	err = writeExternalStateWithConstraints(`[{"type":"fieldsEqual","fields":[{"view":0,"path":""},{"view":1,"path":""}]}]`)
+	require.NoError(t, err)
+	require.Equal(t, 1, chaincodeStub.InvokeChaincodeCallCount())
+
+	// Test failure, without invoking the application chaincode, when a constraint is not satisfied
+	err = writeExternalStateWithConstraints(`[{"type":"sameBlockHeight"}]`)
+	require.EqualError(t, err, "View constraint check failed: Constraint 0: view 0: Block height of FABRIC views is not covered by their proofs")
+	require.Equal(t, 1, chaincodeStub.InvokeChaincodeCallCount())
+
+	// Test failure with invalid constraints
+	err = writeExternalStateWithConstraints(`[{"type":"fieldsEqual","paths":["a","b"]}]`)
+	require.EqualError(t, err, `Unmarshal error: json: unknown field "paths"`)
+}
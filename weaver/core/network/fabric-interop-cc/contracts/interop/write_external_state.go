@@ -8,10 +8,9 @@ package main
 
 import (
 	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-chaincode-go/shim"
@@ -19,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric-protos-go/msp"
 	"github.com/hyperledger/fabric-protos-go/peer"
 	log "github.com/sirupsen/logrus"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/corda"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/fabric"
@@ -32,44 +32,9 @@ type interop interface {
 // Extract data (i.e., query response) from view
 // TODO - Also take verification policy as parameter and determine if enough matching responses exist (current logic mandates unanimity among payloads)
 func ExtractAndValidateDataFromView(view *common.View, b64ViewContentList []string) ([]byte, error) {
-	var interopPayloadList []*common.InteropPayload
-	if view.Meta.Protocol == common.Meta_FABRIC {
-		var fabricViewData fabric.FabricView
-		err := protoV2.Unmarshal(view.Data, &fabricViewData)
-		if err != nil {
-			return nil, fmt.Errorf("FabricView Unmarshal error: %s", err)
-		}
-		interopPayloadList = make([]*common.InteropPayload, len(fabricViewData.EndorsedProposalResponses))
-		for i, endorsedProposalResponse := range fabricViewData.EndorsedProposalResponses {
-			var chaincodeAction peer.ChaincodeAction
-			err = proto.Unmarshal(endorsedProposalResponse.Payload.Extension, &chaincodeAction)
-			if err != nil {
-				return nil, fmt.Errorf("Unable to Unmarshal ChaincodeAction: %s", err.Error())
-			}
-			var interopPayload common.InteropPayload
-			err = protoV2.Unmarshal(chaincodeAction.Response.Payload, &interopPayload)
-			if err != nil {
-				return nil, fmt.Errorf("Unable to Unmarshal interopPayload: %s", err.Error())
-			}
-			interopPayloadList[i] = &interopPayload
-		}
-	} else if view.Meta.Protocol == common.Meta_CORDA {
-		var cordaViewData corda.ViewData
-		err := protoV2.Unmarshal(view.Data, &cordaViewData)
-		if err != nil {
-			return nil, fmt.Errorf("CordaView Unmarshal error: %s", err)
-		}
-		interopPayloadList = make([]*common.InteropPayload, len(cordaViewData.NotarizedPayloads))
-		for i, notarizedPayload := range cordaViewData.NotarizedPayloads {
-			var interopPayload common.InteropPayload
-			err = protoV2.Unmarshal(notarizedPayload.Payload, &interopPayload)
-			if err != nil {
-				return nil, fmt.Errorf("Unable to Unmarshal interopPayload: %s", err.Error())
-			}
-			interopPayloadList[i] = &interopPayload
-		}
-	} else {
-		return nil, fmt.Errorf("Cannot extract data from view; unsupported DLT type: %+v", view.Meta.Protocol)
+	interopPayloadList, err := getInteropPayloadsFromView(view)
+	if err != nil {
+		return nil, err
 	}
 
 	var payloadConfidential bool
@@ -77,6 +42,9 @@ func ExtractAndValidateDataFromView(view *common.View, b64ViewContentList []stri
 	for i, interopPayload := range interopPayloadList {
 		// If view data is encrypted, match it to supplied decrypted data using the hash in the view payload
 		if interopPayload.Confidential {
+			if len(b64ViewContentList) != len(interopPayloadList) {
+				return nil, fmt.Errorf("Number of decrypted payloads (%d) does not match number of view contents (%d)", len(b64ViewContentList), len(interopPayloadList))
+			}
 			// Unmarshal the (decrypted) confidential payload contents supplied by the caller
 			viewB64ContentBytes, err := base64.StdEncoding.DecodeString(b64ViewContentList[i])
 			if err != nil {
@@ -93,9 +61,6 @@ func ExtractAndValidateDataFromView(view *common.View, b64ViewContentList []stri
 				return nil, fmt.Errorf("ConfidentialPayload Unmarshal error: %s", err)
 			}
 			if i == 0 {
-				if len(b64ViewContentList) != len(interopPayloadList) {
-					return nil, fmt.Errorf("Number of decrypted payloads (%d) does not match number of view contents (%d)", len(b64ViewContentList), len(interopPayloadList))
-				}
 				payloadConfidential = true
 				viewPayload = confidentialPayloadContents.Payload
 			} else if !payloadConfidential {
@@ -103,15 +68,9 @@ func ExtractAndValidateDataFromView(view *common.View, b64ViewContentList []stri
 			} else if !bytes.Equal(viewPayload, confidentialPayloadContents.Payload) {
 				return nil, fmt.Errorf("Mismatching payloads in proposal responses: 0 - %+v, %d - %+v", viewPayload, i, confidentialPayloadContents.Payload)
 			}
-			if confidentialPayload.HashType == common.ConfidentialPayload_HMAC {
-				payloadHMAC := hmac.New(sha256.New, confidentialPayloadContents.Random)
-				payloadHMAC.Write(confidentialPayloadContents.Payload)
-				payloadHMACBytes := payloadHMAC.Sum(nil)
-				if !bytes.Equal(confidentialPayload.Hash, payloadHMACBytes) {
-					return nil, fmt.Errorf("View payload hash does not match hash of data submitted by client")
-				}
-			} else {
-				return nil, fmt.Errorf("Unsupported hash type in interop view payload: %+v", confidentialPayload.HashType)
+			err = verifyConfidentialPayloadHash(&confidentialPayload, &confidentialPayloadContents)
+			if err != nil {
+				return nil, err
 			}
 		} else {
 			if i == 0 {
@@ -127,6 +86,71 @@ func ExtractAndValidateDataFromView(view *common.View, b64ViewContentList []stri
 	return viewPayload, nil
 }
 
+// getInteropPayloadsFromView returns the interop payloads in a view, one per endorsement or notarization; the payloads
+// of a relayed view are those of the source view
+func getInteropPayloadsFromView(view *common.View) ([]*common.InteropPayload, error) {
+	var interopPayloadList []*common.InteropPayload
+	if view.GetMeta().GetProofType() == relayedViewProofType {
+		// The data comes from the source view that was relayed
+		_, sourceView, err := decodeViewEnvelope(view.Data)
+		if err != nil {
+			return nil, err
+		}
+		return getInteropPayloadsFromView(sourceView)
+	} else if view.Meta.Protocol == common.Meta_FABRIC {
+		var fabricViewData fabric.FabricView
+		err := protoV2.Unmarshal(view.Data, &fabricViewData)
+		if err != nil {
+			return nil, fmt.Errorf("FabricView Unmarshal error: %s", err)
+		}
+		interopPayloadList = make([]*common.InteropPayload, len(fabricViewData.EndorsedProposalResponses))
+		for i, endorsedProposalResponse := range fabricViewData.EndorsedProposalResponses {
+			var chaincodeAction peer.ChaincodeAction
+			err = proto.Unmarshal(endorsedProposalResponse.Payload.Extension, &chaincodeAction)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to Unmarshal ChaincodeAction: %s", err.Error())
+			}
+			var interopPayload common.InteropPayload
+			err = protoV2.Unmarshal(chaincodeAction.Response.Payload, &interopPayload)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to Unmarshal interopPayload: %s", err.Error())
+			}
+			interopPayloadList[i] = &interopPayload
+		}
+	} else if view.Meta.Protocol == common.Meta_CORDA {
+		var cordaViewData corda.ViewData
+		err := protoV2.Unmarshal(view.Data, &cordaViewData)
+		if err != nil {
+			return nil, fmt.Errorf("CordaView Unmarshal error: %s", err)
+		}
+		interopPayloadList = make([]*common.InteropPayload, len(cordaViewData.NotarizedPayloads))
+		for i, notarizedPayload := range cordaViewData.NotarizedPayloads {
+			var interopPayload common.InteropPayload
+			err = protoV2.Unmarshal(notarizedPayload.Payload, &interopPayload)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to Unmarshal interopPayload: %s", err.Error())
+			}
+			interopPayloadList[i] = &interopPayload
+		}
+	} else if view.Meta.Protocol == common.Meta_ETHEREUM {
+		var besuViewData besu.BesuView
+		err := protoV2.Unmarshal(view.Data, &besuViewData)
+		if err != nil {
+			return nil, fmt.Errorf("BesuView Unmarshal error: %s", err)
+		}
+		var interopPayload common.InteropPayload
+		err = protoV2.Unmarshal(besuViewData.InteropPayload, &interopPayload)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to Unmarshal interopPayload: %s", err.Error())
+		}
+		interopPayloadList = []*common.InteropPayload{&interopPayload}
+	} else {
+		return nil, fmt.Errorf("Cannot extract data from view; unsupported DLT type: %+v", view.Meta.Protocol)
+	}
+
+	return interopPayloadList, nil
+}
+
 // Validate view against address, and extract data (i.e., query response) from view
 func (s *SmartContract) ParseAndValidateView(ctx contractapi.TransactionContextInterface, address, b64ViewProto string, b64ViewContentList []string) (string, error) {
 	viewB64Bytes, err := base64.StdEncoding.DecodeString(b64ViewProto)
@@ -139,8 +163,8 @@ func (s *SmartContract) ParseAndValidateView(ctx contractapi.TransactionContextI
 		return "", fmt.Errorf("View Unmarshal error: %s", err)
 	}
 
-	// 1. Verify proof
-	err = s.VerifyView(ctx, b64ViewProto, address)
+	// 1. Verify proof, unless a fresh verification of it is cached
+	err = verifyViewWithCache(s, ctx, b64ViewProto, address)
 	if err != nil {
 		log.Errorf("Proof obtained from foreign network for query '%s' is INVALID", address)
 		return "", fmt.Errorf("VerifyView error: %s", err)
@@ -160,6 +184,23 @@ func (s *SmartContract) ParseAndValidateView(ctx contractapi.TransactionContextI
 // 1. Verify Proofs that are returned
 // 2. Call application chaincode
 func (s *SmartContract) WriteExternalState(ctx contractapi.TransactionContextInterface, applicationID string, applicationChannel string, applicationFunction string, applicationArgs []string, argIndicesForSubstitution []int, addresses []string, b64ViewProtos []string, b64ViewContents [][]string) error {
+	return writeExternalState(s, ctx, applicationID, applicationChannel, applicationFunction, applicationArgs, argIndicesForSubstitution, addresses, b64ViewProtos, b64ViewContents, []ViewConstraint{})
+}
+
+// WriteExternalStateWithConstraints flow is used to process responses from foreign networks for state that must be
+// consistent with each other, according to the given JSON list of view constraints.
+// 1. Verify Proofs that are returned
+// 2. Check that the views satisfy the constraints
+// 3. Call application chaincode
+func (s *SmartContract) WriteExternalStateWithConstraints(ctx contractapi.TransactionContextInterface, applicationID string, applicationChannel string, applicationFunction string, applicationArgs []string, argIndicesForSubstitution []int, addresses []string, b64ViewProtos []string, b64ViewContents [][]string, viewConstraintsJSON string) error {
+	viewConstraints, err := decodeViewConstraints([]byte(viewConstraintsJSON))
+	if err != nil {
+		return fmt.Errorf("Unmarshal error: %s", err)
+	}
+	return writeExternalState(s, ctx, applicationID, applicationChannel, applicationFunction, applicationArgs, argIndicesForSubstitution, addresses, b64ViewProtos, b64ViewContents, viewConstraints)
+}
+
+func writeExternalState(s *SmartContract, ctx contractapi.TransactionContextInterface, applicationID string, applicationChannel string, applicationFunction string, applicationArgs []string, argIndicesForSubstitution []int, addresses []string, b64ViewProtos []string, b64ViewContents [][]string, viewConstraints []ViewConstraint) error {
 	if len(argIndicesForSubstitution) != len(addresses) {
 		return fmt.Errorf("Number of argument indices for substitution (%d) does not match number of addresses (%d)", len(argIndicesForSubstitution), len(addresses))
 	}
@@ -173,6 +214,7 @@ func (s *SmartContract) WriteExternalState(ctx contractapi.TransactionContextInt
 	arr := append([]string{applicationFunction}, applicationArgs...)
 
 	// 1. Verify proofs that are returned
+	viewDataList := make([]string, len(addresses))
 	for i, argIndex := range argIndicesForSubstitution {
 		// Validate argument index
 		if argIndex >= len(applicationArgs) {
@@ -183,11 +225,23 @@ func (s *SmartContract) WriteExternalState(ctx contractapi.TransactionContextInt
 		if err != nil {
 			return err
 		}
+		// Reject views already consumed, if replay protection is enabled
+		err = checkViewReplay(ctx, addresses[i], b64ViewProtos[i])
+		if err != nil {
+			return fmt.Errorf("Replay check failed: %s", err)
+		}
+		viewDataList[i] = viewData
 		// Substitute argument in list with view data
 		arr[argIndex + 1] = viewData        // First argument is the CC function name
 	}
 
-	// 2. Call application chaincode with created state as the argument
+	// 2. Check that the views satisfy the constraints
+	err := evaluateViewConstraints(viewConstraints, b64ViewProtos, viewDataList)
+	if err != nil {
+		return fmt.Errorf("View constraint check failed: %s", err)
+	}
+
+	// 3. Call application chaincode with created state as the argument
 	byteArgs := strArrToBytesArr(arr)
 	log.Info(fmt.Sprintf("Calling invoke chaincode. AppId: %s, appChannel: %s", applicationID, applicationChannel))
 	pbResp := ctx.GetStub().InvokeChaincode(applicationID, byteArgs, applicationChannel)
@@ -212,6 +266,15 @@ func (s *SmartContract) VerifyView(ctx contractapi.TransactionContextInterface,
 	if err != nil {
 		return fmt.Errorf("View Unmarshal error: %s", err)
 	}
+	return verifyView(s, ctx, &view, address)
+}
+
+// verifyView verifies a decoded view against the given address, as described for VerifyView.
+// Views forwarded through intermediate networks are verified hop by hop.
+func verifyView(s *SmartContract, ctx contractapi.TransactionContextInterface, view *common.View, address string) error {
+	if view.GetMeta().GetProofType() == relayedViewProofType {
+		return verifyRelayedView(s, ctx, view.Data, address)
+	}
 	addressStruct, err := parseAddress(address)
 	if err != nil {
 		return fmt.Errorf("Unable to parse address: %s", err.Error())
@@ -242,6 +305,13 @@ func (s *SmartContract) VerifyView(ctx contractapi.TransactionContextInterface,
 		default:
 			return fmt.Errorf("Proof type not supported: %s", view.Meta.ProofType)
 		}
+	case common.Meta_ETHEREUM:
+		switch view.Meta.ProofType {
+		case "Notarization":
+			return verifyBesuNotarization(s, ctx, view.Data, verificationPolicy, addressStruct.LedgerSegment, address)
+		default:
+			return fmt.Errorf("Proof type not supported: %s", view.Meta.ProofType)
+		}
 	default:
 		return fmt.Errorf("Verification Error: Unrecognised protocol %s", view.Meta.Protocol)
 	}
@@ -298,11 +368,8 @@ func verifyCordaNotarization(s *SmartContract, ctx contractapi.TransactionContex
 	}
 
 	// 5. Check the notarizations fulfill the verification policy of the request.
-	requiredSigners := verificationPolicy.Criteria
-	for _, signer := range requiredSigners {
-		if !Contains(signerList, signer) {
-			return fmt.Errorf("Notarizations missing signer: %s", signer)
-		}
+	if err := evaluatePolicy(verificationPolicy, signerList); err != nil {
+		return err
 	}
 	log.Infof("Proof associated with response '%s' from Corda network for query '%s' is VALID", string(viewPayload), address)
 	return nil
@@ -372,12 +439,74 @@ func verifyFabricNotarization(s *SmartContract, ctx contractapi.TransactionConte
 		signerList = append(signerList, org)
 	}
 	// 5. Check the notarizations fulfill the verification policy of the request.
-	requiredSigners := verificationPolicy.Criteria
-	for _, signer := range requiredSigners {
-		if !Contains(signerList, signer) {
-			return fmt.Errorf("Notarizations missing signer: %s", signer)
-		}
+	if err := evaluatePolicy(verificationPolicy, signerList); err != nil {
+		return err
 	}
 	log.Infof("Proof associated with response '%s' from Fabric network for query '%s' is VALID", string(viewPayload), address)
 	return nil
 }
+
+// The verifyBesuNotarization function is used to verify views that come from a Besu network
+// that were generated with Notarization proofs, i.e. a block header signed by the network's validators
+// and a Merkle-Patricia proof of the receipt carrying the view payload.
+//
+// Verification requires the following checks to be performed:
+// 1. Ensure the response is in a valid format - view data should be parsed to [BesuView] and the
+// interop payload in it to [InteropPayload].
+// 2. Verify address in the interop payload is the same as original address
+// 3. Verify the validator signatures and receipt proof against the validators in the network's Membership, and
+// that the receipt log is an interop payload event of the contract in the view address.
+// 4. Check that the validator signatures fulfill the verification policy of the request.
+func verifyBesuNotarization(s *SmartContract, ctx contractapi.TransactionContextInterface, data []byte, verificationPolicy *common.Policy, securityDomain string, address string) error {
+	// 1. Ensure the response is in a valid format
+	var besuViewData besu.BesuView
+	err := protoV2.Unmarshal(data, &besuViewData)
+	if err != nil {
+		return fmt.Errorf("Unable to decode besu view data: %s", err.Error())
+	}
+	var interopPayload common.InteropPayload
+	err = protoV2.Unmarshal(besuViewData.InteropPayload, &interopPayload)
+	if err != nil {
+		return fmt.Errorf("Unable to Unmarshal interopPayload: %s", err.Error())
+	}
+
+	// 2. Verify address in the interop payload is the same as original address
+	if address != interopPayload.Address {
+		return fmt.Errorf("Address in response does not match original address: Original: %s Response: %s", address, interopPayload.Address)
+	}
+
+	// 3. Verify the validator signatures and receipt proof against the validators in the network's Membership.
+	parsedAddress, err := parseAddress(address)
+	if err != nil {
+		return err
+	}
+	contract, err := parseBesuViewContract(parsedAddress.ViewSegment)
+	if err != nil {
+		return err
+	}
+	membershipString, err := s.GetMembershipBySecurityDomain(ctx, securityDomain)
+	if err != nil {
+		return err
+	}
+	membership, err := decodeMembership([]byte(membershipString))
+	if err != nil {
+		return fmt.Errorf("Failed to unmarshal membership: %s", err.Error())
+	}
+	validators := map[string]string{}
+	for memberId, member := range membership.Members {
+		if member.Type == besuValidatorMemberType {
+			validators["0x"+strings.TrimPrefix(strings.ToLower(member.Value), "0x")] = memberId
+		}
+	}
+	signerList, err := verifyBesuProof(&besuViewData, validators, contract)
+	if err != nil {
+		return fmt.Errorf("Besu proof verification failed: %s", err.Error())
+	}
+
+	// 4. Check the validator signatures fulfill the verification policy of the request.
+	if err := evaluatePolicy(verificationPolicy, signerList); err != nil {
+		return err
+	}
+	log.Infof("Proof associated with response '%s' from Besu network for query '%s' is VALID", string(besuViewData.InteropPayload), address)
+	return nil
+}
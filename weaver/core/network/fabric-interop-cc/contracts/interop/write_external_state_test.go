@@ -9,6 +9,8 @@
 package main
 
 import (
+	"crypto/ecdsa"
+	"encoding/base64"
 	"encoding/json"
 	"testing"
 	"io/ioutil"
@@ -16,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/stretchr/testify/require"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	protoV2 "google.golang.org/protobuf/proto"
 	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
 )
 
@@ -125,8 +128,10 @@ func TestWriteExternalState(t *testing.T) {
 	require.NoError(t, err)
 	network1MembershipBytes, err := json.Marshal(&network1Membership_1_Org)
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturnsOnCall(0, network1VerificationPolicyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(1, network1MembershipBytes, nil)
+	// the first call for each view reads the (absent) verified view cache configuration, and the last the (absent)
+	// replay protection configuration
+	chaincodeStub.GetStateReturnsOnCall(1, network1VerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(2, network1MembershipBytes, nil)
 	chaincodeStub.InvokeChaincodeReturns(peer.Response{
 		Status:  200,
 		Message: "",
@@ -140,8 +145,8 @@ func TestWriteExternalState(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test success with encrypted view payload
-	chaincodeStub.GetStateReturnsOnCall(2, network1VerificationPolicyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(3, network1MembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(5, network1VerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(6, network1MembershipBytes, nil)
 	decContents = fabricTestData_1_Org.B64ViewContents
 	decContentsList[0] = decContents
 	err = interopcc.WriteExternalState(ctx, fabricNetwork, "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{fabricViewAddress}, []string{fabricTestData_1_Org.B64ViewConfidential}, decContentsList)
@@ -170,9 +175,9 @@ func TestWriteExternalState(t *testing.T) {
 	require.NoError(t, err)
 	network1MembershipBytes, err = json.Marshal(&network1Membership_2_Orgs)
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturnsOnCall(0, network1VerificationPolicyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(1, network1MembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, network1VerificationPolicyBytes, nil)
 	chaincodeStub.GetStateReturnsOnCall(2, network1MembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(3, network1MembershipBytes, nil)
 	chaincodeStub.InvokeChaincodeReturns(peer.Response{
 		Status:  200,
 		Message: "",
@@ -185,9 +190,9 @@ func TestWriteExternalState(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test success with encrypted view payload
-	chaincodeStub.GetStateReturnsOnCall(3, network1VerificationPolicyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(4, network1MembershipBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(5, network1MembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(6, network1VerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(7, network1MembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(8, network1MembershipBytes, nil)
 	decContents = fabricTestData_2_Orgs.B64ViewContents
 	decContentsList[0] = decContents
 	err = interopcc.WriteExternalState(ctx, fabricNetwork, "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{fabricViewAddress}, []string{fabricTestData_2_Orgs.B64ViewConfidential}, decContentsList)
@@ -216,9 +221,9 @@ func TestWriteExternalState(t *testing.T) {
 	require.NoError(t, err)
 	cordaMembershipBytes, err := json.Marshal(&cordaMembership)
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturnsOnCall(0, cordaVerificationPolicyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(1, cordaMembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, cordaVerificationPolicyBytes, nil)
 	chaincodeStub.GetStateReturnsOnCall(2, cordaMembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(3, cordaMembershipBytes, nil)
 	chaincodeStub.InvokeChaincodeReturns(peer.Response{
 		Status:  200,
 		Message: "",
@@ -233,9 +238,9 @@ func TestWriteExternalState(t *testing.T) {
 	network1Membership_2_Orgs.Members["Org1MSP"].Value = "invalid cert"
 	invalidMembershipBytes, err := json.Marshal(&network1Membership_2_Orgs)
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturnsOnCall(0, network1VerificationPolicyBytes, nil)
-	chaincodeStub.GetStateReturnsOnCall(1, invalidMembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, network1VerificationPolicyBytes, nil)
 	chaincodeStub.GetStateReturnsOnCall(2, invalidMembershipBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(3, invalidMembershipBytes, nil)
 	err = interopcc.WriteExternalState(ctx, fabricNetwork, "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{fabricViewAddress}, []string{fabricTestData_2_Orgs.B64View}, decContentsList)
 	require.EqualError(t, err, "VerifyView error: Verify membership failed. Certificate not valid: Client cert not in a known PEM format")
 
@@ -245,7 +250,82 @@ func TestWriteExternalState(t *testing.T) {
 	network1VerificationPolicy_2_Orgs.Identifiers[0].Pattern = "not matching policy"
 	invalidVerificationPolicyBytes, err := json.Marshal(&network1VerificationPolicy_2_Orgs)
 	require.NoError(t, err)
-	chaincodeStub.GetStateReturnsOnCall(0, invalidVerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, invalidVerificationPolicyBytes, nil)
 	err = interopcc.WriteExternalState(ctx, fabricNetwork, "mychannel", "Write", []string{"test-key", ""}, []int{1}, []string{fabricViewAddress}, []string{fabricTestData_2_Orgs.B64View}, decContentsList)
 	require.EqualError(t, err, "VerifyView error: Unable to resolve verification policy: Verification Policy Error: Failed to find verification policy matching view address: " + fabricPattern)
 }
+
+func TestVerifyViewBesu(t *testing.T) {
+	besuNetwork := "besu-network"
+	besuPattern := "1337:0x5FbDB2315678afecb367f032d93F642f64180aa3:get(string):key"
+	besuViewAddress := "relay-besu:9080/" + besuNetwork + "/" + besuPattern
+
+	validatorKeys := []*ecdsa.PrivateKey{besuTestKey(t, 201), besuTestKey(t, 202), besuTestKey(t, 203)}
+	besuMembership := common.Membership{
+		SecurityDomain: besuNetwork,
+		Members:        map[string]*common.Member{},
+	}
+	for i, key := range validatorKeys {
+		besuMembership.Members[[]string{"validator1", "validator2", "validator3"}[i]] = &common.Member{
+			Value: testAddress(key),
+			Type:  "address",
+		}
+	}
+	besuVerificationPolicy := common.VerificationPolicy{
+		SecurityDomain: besuNetwork,
+		Identifiers: []*common.Identifier{{
+			Pattern: besuPattern,
+			Policy: &common.Policy{
+				Criteria: []string{"2", "validator1", "validator2", "validator3"},
+				Type:     "k-of-n",
+			},
+		}},
+	}
+	besuMembershipBytes, err := json.Marshal(&besuMembership)
+	require.NoError(t, err)
+	besuVerificationPolicyBytes, err := json.Marshal(&besuVerificationPolicy)
+	require.NoError(t, err)
+
+	interopPayloadBytes, err := protoV2.Marshal(&common.InteropPayload{Address: besuViewAddress, Payload: []byte("value")})
+	require.NoError(t, err)
+	makeB64View := func(signers []*ecdsa.PrivateKey) (string, *common.View) {
+		besuViewBytes, err := protoV2.Marshal(besuTestView(t, interopPayloadBytes, signers))
+		require.NoError(t, err)
+		view := &common.View{
+			Meta: &common.Meta{Protocol: common.Meta_ETHEREUM, ProofType: "Notarization"},
+			Data: besuViewBytes,
+		}
+		viewBytes, err := protoV2.Marshal(view)
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(viewBytes), view
+	}
+	interopcc := SmartContract{}
+
+	// Happy case: 2 of 3 validators signed
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	chaincodeStub.GetStateReturnsOnCall(0, besuVerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, besuMembershipBytes, nil)
+	b64View, view := makeB64View(validatorKeys[1:])
+	err = interopcc.VerifyView(ctx, b64View, besuViewAddress)
+	require.NoError(t, err)
+	viewData, err := ExtractAndValidateDataFromView(view, []string{""})
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), viewData)
+
+	// Threshold not met
+	ctx, chaincodeStub = wtest.PrepMockStub()
+	chaincodeStub.GetStateReturnsOnCall(0, besuVerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, besuMembershipBytes, nil)
+	b64View, _ = makeB64View(validatorKeys[:1])
+	err = interopcc.VerifyView(ctx, b64View, besuViewAddress)
+	require.EqualError(t, err, "Notarizations do not meet threshold: required 2 of [validator1 validator2 validator3], found 1")
+
+	// Signature from a validator that is not in the membership
+	ctx, chaincodeStub = wtest.PrepMockStub()
+	chaincodeStub.GetStateReturnsOnCall(0, besuVerificationPolicyBytes, nil)
+	chaincodeStub.GetStateReturnsOnCall(1, besuMembershipBytes, nil)
+	unknownKey := besuTestKey(t, 999)
+	b64View, _ = makeB64View([]*ecdsa.PrivateKey{validatorKeys[0], unknownKey})
+	err = interopcc.VerifyView(ctx, b64View, besuViewAddress)
+	require.EqualError(t, err, "Besu proof verification failed: Block header signed by unknown validator: "+testAddress(unknownKey))
+}
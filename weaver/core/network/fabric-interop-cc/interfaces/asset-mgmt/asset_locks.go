@@ -27,6 +27,30 @@ type AssetManagement struct {
     interopChaincodeId string
 }
 
+// LockedAsset is a lock (on a non-fungible asset or a group of fungible assets), as reported by the lock queries of
+// the interop contract
+type LockedAsset struct {
+    ContractId     string          `json:"contractId"`
+    AssetType      string          `json:"assetType"`
+    AssetId        string          `json:"assetId,omitempty"`
+    NumUnits       uint64          `json:"numUnits,omitempty"`
+    ClaimedUnits   uint64          `json:"claimedUnits,omitempty"`
+    Locker         string          `json:"locker"`
+    Recipient      string          `json:"recipient"`
+    ExpiryTimeSecs uint64          `json:"expiryTimeSecs"`
+    TimeSpec       common.TimeSpec `json:"timeSpec,omitempty"`
+    IsFungible     bool            `json:"isFungible"`
+}
+
+// FungibleAssetLockStatus reports the units of a lock on a group of fungible assets that are still locked, and those
+// claimed so far, as reported by the interop contract
+type FungibleAssetLockStatus struct {
+    ContractId   string `json:"contractId"`
+    IsLocked     bool   `json:"isLocked"`
+    LockedUnits  uint64 `json:"lockedUnits"`
+    ClaimedUnits uint64 `json:"claimedUnits"`
+}
+
 
 // Utility functions
 func (am *AssetManagement) Configure(interopChaincodeId string) {
@@ -78,8 +102,23 @@ func (am *AssetManagement) validateLockInfo(lockInfo *common.AssetLock) error {
         if len(lockInfoHTLC.HashBase64) == 0 {
             return logThenErrorf("empty lock hash value")
         }
-        if lockInfoHTLC.TimeSpec != common.TimeSpec_EPOCH {
-            return logThenErrorf("only EPOCH time is supported at present")
+        if lockInfoHTLC.TimeSpec != common.TimeSpec_EPOCH && lockInfoHTLC.TimeSpec != common.TimeSpec_DURATION {
+            return logThenErrorf("unsupported time spec: %+v", lockInfoHTLC.TimeSpec)
+        }
+    } else if (lockInfo.LockMechanism == common.LockMechanism_SIGNATURE) {
+        lockInfoSignature := &common.AssetLockSignature{}
+        err := proto.Unmarshal(lockInfo.LockInfo, lockInfoSignature)
+        if err != nil {
+            return logThenErrorf(err.Error())
+        }
+        if len(lockInfoSignature.Approvers) == 0 {
+            return logThenErrorf("empty lock approvers")
+        }
+        if lockInfoSignature.Threshold == 0 || int(lockInfoSignature.Threshold) > len(lockInfoSignature.Approvers) {
+            return logThenErrorf("invalid lock threshold %d for %d approvers", lockInfoSignature.Threshold, len(lockInfoSignature.Approvers))
+        }
+        if lockInfoSignature.TimeSpec != common.TimeSpec_EPOCH && lockInfoSignature.TimeSpec != common.TimeSpec_DURATION {
+            return logThenErrorf("unsupported time spec: %+v", lockInfoSignature.TimeSpec)
         }
     } else {
         return logThenErrorf("unsupported lock mechanism: %+v", lockInfo.LockMechanism)
@@ -101,6 +140,15 @@ func (am *AssetManagement) validateClaimInfo(claimInfo *common.AssetClaim) error
         if len(claimInfoHTLC.HashPreimageBase64) == 0 {
             return logThenErrorf("empty lock hash preimage")
         }
+    } else if (claimInfo.LockMechanism == common.LockMechanism_SIGNATURE) {
+        claimInfoSignature := &common.AssetClaimSignature{}
+        err := proto.Unmarshal(claimInfo.ClaimInfo, claimInfoSignature)
+        if err != nil {
+            return logThenErrorf(err.Error())
+        }
+        if len(claimInfoSignature.Signatures) == 0 {
+            return logThenErrorf("empty claim signatures")
+        }
     } else {
         return logThenErrorf("unsupported lock mechanism: %+v", claimInfo.LockMechanism)
     }
@@ -248,6 +296,26 @@ func (am *AssetManagement) IsFungibleAssetLocked(stub shim.ChaincodeStubInterfac
     return isLocked, nil
 }
 
+func (am *AssetManagement) GetFungibleAssetLockStatus(stub shim.ChaincodeStubInterface, contractId string) (FungibleAssetLockStatus, error) {
+    var lockStatus FungibleAssetLockStatus
+    _, err := am.validateInteropccContractId(contractId)
+    if err != nil {
+        return lockStatus, err
+    }
+
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("GetFungibleAssetLockStatus"), []byte(contractId)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return lockStatus, errors.New(string(iccResp.GetMessage()))
+    }
+    err = json.Unmarshal(iccResp.Payload, &lockStatus)
+    if err != nil {
+        return lockStatus, logThenErrorf(err.Error())
+    }
+    fmt.Printf("contractId %s has %d units locked and %d units claimed\n", contractId, lockStatus.LockedUnits, lockStatus.ClaimedUnits)
+    return lockStatus, nil
+}
+
 func (am *AssetManagement) IsAssetLockedQueryUsingContractId(stub shim.ChaincodeStubInterface, contractId string) (bool, error) {
     _, err := am.validateInteropccContractId(contractId)
     if err != nil {
@@ -327,6 +395,39 @@ func (am *AssetManagement) ClaimFungibleAsset(stub shim.ChaincodeStubInterface,
     return true, nil
 }
 
+// Claims 'numUnits' of the units locked using contractId, returning the number of units that remain locked
+func (am *AssetManagement) PartialClaimFungibleAsset(stub shim.ChaincodeStubInterface, contractId string, numUnits uint64, claimInfo *common.AssetClaim) (uint64, error) {
+    _, err := am.validateInteropccContractId(contractId)
+    if err != nil {
+        return 0, err
+    }
+    if numUnits <= 0 {
+        return 0, logThenErrorf("invalid number of asset units")
+    }
+
+    err = am.validateClaimInfo(claimInfo)
+    if err != nil {
+        return 0, err
+    }
+
+    claimInfoBytes, err := proto.Marshal(claimInfo)
+    if err != nil {
+        return 0, logThenErrorf(err.Error())
+    }
+    claimInfoBytes64 := base64.StdEncoding.EncodeToString(claimInfoBytes)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("PartialClaimFungibleAsset"), []byte(contractId), []byte(strconv.FormatUint(numUnits, 10)), []byte(claimInfoBytes64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return 0, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    remainingUnits, err := strconv.ParseUint(string(iccResp.Payload), 10, 64)
+    if err != nil {
+        return 0, logThenErrorf(err.Error())
+    }
+    fmt.Printf("%d units of fungible asset locked using contractId %s are claimed, and %d units remain locked\n", numUnits, contractId, remainingUnits)
+    return remainingUnits, nil
+}
+
 func (am *AssetManagement) ClaimAssetUsingContractId(stub shim.ChaincodeStubInterface, contractId string, claimInfo *common.AssetClaim) (bool, error) {
     _, err := am.validateInteropccContractId(contractId)
     if err != nil {
@@ -405,6 +506,281 @@ func (am *AssetManagement) UnlockAssetUsingContractId(stub shim.ChaincodeStubInt
     return true, nil
 }
 
+// Extends the expiry of the lock with the given contractId, with the recipient's signature over the contractId and
+// the new expiry (see 'GenerateExtendLockExpiryMessage' in the assetexchange library)
+func (am *AssetManagement) ExtendLockExpiry(stub shim.ChaincodeStubInterface, contractId string, newExpiryTimeSecs uint64, recipientSignature []byte) (bool, error) {
+    _, err := am.validateInteropccContractId(contractId)
+    if err != nil {
+        return false, err
+    }
+    if len(recipientSignature) == 0 {
+        return false, logThenErrorf("empty recipient signature")
+    }
+
+    recipientSignatureBase64 := base64.StdEncoding.EncodeToString(recipientSignature)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("ExtendLockExpiry"), []byte(contractId), []byte(strconv.FormatUint(newExpiryTimeSecs, 10)), []byte(recipientSignatureBase64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return false, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    fmt.Printf("expiry of the lock with contractId %s is extended to %d\n", contractId, newExpiryTimeSecs)
+    return true, nil
+}
+
+// Cancels the lock with the given contractId before its expiry, with the recipient's signature over the contractId
+// (see 'GenerateCancelLockMessage' in the assetexchange library)
+func (am *AssetManagement) CancelLock(stub shim.ChaincodeStubInterface, contractId string, recipientSignature []byte) (bool, error) {
+    _, err := am.validateInteropccContractId(contractId)
+    if err != nil {
+        return false, err
+    }
+    if len(recipientSignature) == 0 {
+        return false, logThenErrorf("empty recipient signature")
+    }
+
+    recipientSignatureBase64 := base64.StdEncoding.EncodeToString(recipientSignature)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("CancelLock"), []byte(contractId), []byte(recipientSignatureBase64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return false, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    fmt.Printf("lock with contractId %s is cancelled\n", contractId)
+    return true, nil
+}
+
+// Batch transaction (invocation) functions: each batch is recorded in a single transaction, so either all
+// the assets in it are locked (or claimed, or unlocked) or none is
+
+func (am *AssetManagement) LockAssetBatch(stub shim.ChaincodeStubInterface, assetAgreements []*common.AssetExchangeAgreement, lockInfos []*common.AssetLock) ([]string, error) {
+    if len(am.interopChaincodeId) == 0 {
+        return []string{}, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+    }
+    if len(assetAgreements) != len(lockInfos) {
+        return []string{}, logThenErrorf("number of asset agreements (%d) and lock infos (%d) do not match", len(assetAgreements), len(lockInfos))
+    }
+
+    assetAgreementsBytes64 := []string{}
+    lockInfosBytes64 := []string{}
+    for i, assetAgreement := range assetAgreements {
+        _, err := am.validateInteropccAssetTypeAssetId(assetAgreement)
+        if err != nil {
+            return []string{}, err
+        }
+        if len(assetAgreement.Recipient) == 0 {
+            return []string{}, logThenErrorf("empty lock recipient")
+        }
+        assetAgreementBytes, err := proto.Marshal(assetAgreement)
+        if err != nil {
+            return []string{}, logThenErrorf(err.Error())
+        }
+        err = am.validateLockInfo(lockInfos[i])
+        if err != nil {
+            return []string{}, err
+        }
+        lockInfoBytes, err := proto.Marshal(lockInfos[i])
+        if err != nil {
+            return []string{}, logThenErrorf(err.Error())
+        }
+        assetAgreementsBytes64 = append(assetAgreementsBytes64, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+        lockInfosBytes64 = append(lockInfosBytes64, base64.StdEncoding.EncodeToString(lockInfoBytes))
+    }
+
+    contractIds, err := am.invokeInteropccBatch(stub, "LockAssetBatch", assetAgreementsBytes64, lockInfosBytes64)
+    if err != nil {
+        return []string{}, err
+    }
+    fmt.Printf("%d assets locked using contractIds %v\n", len(contractIds), contractIds)
+    return contractIds, nil
+}
+
+func (am *AssetManagement) LockFungibleAssetBatch(stub shim.ChaincodeStubInterface, assetAgreements []*common.FungibleAssetExchangeAgreement, lockInfos []*common.AssetLock) ([]string, error) {
+    if len(am.interopChaincodeId) == 0 {
+        return []string{}, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+    }
+    if len(assetAgreements) != len(lockInfos) {
+        return []string{}, logThenErrorf("number of asset agreements (%d) and lock infos (%d) do not match", len(assetAgreements), len(lockInfos))
+    }
+
+    assetAgreementsBytes64 := []string{}
+    lockInfosBytes64 := []string{}
+    for i, assetAgreement := range assetAgreements {
+        if len(assetAgreement.AssetType) == 0 {
+            return []string{}, logThenErrorf("empty asset type")
+        }
+        if assetAgreement.NumUnits <= 0 {
+            return []string{}, logThenErrorf("invalid number of asset units")
+        }
+        if len(assetAgreement.Recipient) == 0 {
+            return []string{}, logThenErrorf("empty lock recipient")
+        }
+        assetAgreementBytes, err := proto.Marshal(assetAgreement)
+        if err != nil {
+            return []string{}, logThenErrorf(err.Error())
+        }
+        err = am.validateLockInfo(lockInfos[i])
+        if err != nil {
+            return []string{}, err
+        }
+        lockInfoBytes, err := proto.Marshal(lockInfos[i])
+        if err != nil {
+            return []string{}, logThenErrorf(err.Error())
+        }
+        assetAgreementsBytes64 = append(assetAgreementsBytes64, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+        lockInfosBytes64 = append(lockInfosBytes64, base64.StdEncoding.EncodeToString(lockInfoBytes))
+    }
+
+    contractIds, err := am.invokeInteropccBatch(stub, "LockFungibleAssetBatch", assetAgreementsBytes64, lockInfosBytes64)
+    if err != nil {
+        return []string{}, err
+    }
+    fmt.Printf("%d groups of fungible assets locked using contractIds %v\n", len(contractIds), contractIds)
+    return contractIds, nil
+}
+
+func (am *AssetManagement) ClaimAssetBatch(stub shim.ChaincodeStubInterface, assetAgreements []*common.AssetExchangeAgreement, claimInfos []*common.AssetClaim) (bool, error) {
+    if len(am.interopChaincodeId) == 0 {
+        return false, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+    }
+    if len(assetAgreements) != len(claimInfos) {
+        return false, logThenErrorf("number of asset agreements (%d) and claim infos (%d) do not match", len(assetAgreements), len(claimInfos))
+    }
+
+    assetAgreementsBytes64 := []string{}
+    claimInfosBytes64 := []string{}
+    for i, assetAgreement := range assetAgreements {
+        _, err := am.validateInteropccAssetTypeAssetId(assetAgreement)
+        if err != nil {
+            return false, err
+        }
+        if len(assetAgreement.Locker) == 0 {
+            return false, logThenErrorf("empty locker")
+        }
+        assetAgreementBytes, err := proto.Marshal(assetAgreement)
+        if err != nil {
+            return false, logThenErrorf(err.Error())
+        }
+        err = am.validateClaimInfo(claimInfos[i])
+        if err != nil {
+            return false, err
+        }
+        claimInfoBytes, err := proto.Marshal(claimInfos[i])
+        if err != nil {
+            return false, logThenErrorf(err.Error())
+        }
+        assetAgreementsBytes64 = append(assetAgreementsBytes64, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+        claimInfosBytes64 = append(claimInfosBytes64, base64.StdEncoding.EncodeToString(claimInfoBytes))
+    }
+
+    _, err := am.invokeInteropccBatch(stub, "ClaimAssetBatch", assetAgreementsBytes64, claimInfosBytes64)
+    if err != nil {
+        return false, err
+    }
+    fmt.Printf("Claimed %d assets\n", len(assetAgreements))
+    return true, nil
+}
+
+func (am *AssetManagement) ClaimFungibleAssetBatch(stub shim.ChaincodeStubInterface, contractIds []string, claimInfos []*common.AssetClaim) (bool, error) {
+    if len(contractIds) != len(claimInfos) {
+        return false, logThenErrorf("number of contractIds (%d) and claim infos (%d) do not match", len(contractIds), len(claimInfos))
+    }
+
+    claimInfosBytes64 := []string{}
+    for i, contractId := range contractIds {
+        _, err := am.validateInteropccContractId(contractId)
+        if err != nil {
+            return false, err
+        }
+        err = am.validateClaimInfo(claimInfos[i])
+        if err != nil {
+            return false, err
+        }
+        claimInfoBytes, err := proto.Marshal(claimInfos[i])
+        if err != nil {
+            return false, logThenErrorf(err.Error())
+        }
+        claimInfosBytes64 = append(claimInfosBytes64, base64.StdEncoding.EncodeToString(claimInfoBytes))
+    }
+
+    _, err := am.invokeInteropccBatch(stub, "ClaimFungibleAssetBatch", contractIds, claimInfosBytes64)
+    if err != nil {
+        return false, err
+    }
+    fmt.Printf("Fungible assets locked using contractIds %v are claimed\n", contractIds)
+    return true, nil
+}
+
+func (am *AssetManagement) UnlockAssetBatch(stub shim.ChaincodeStubInterface, assetAgreements []*common.AssetExchangeAgreement) (bool, error) {
+    if len(am.interopChaincodeId) == 0 {
+        return false, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+    }
+
+    assetAgreementsBytes64 := []string{}
+    for _, assetAgreement := range assetAgreements {
+        _, err := am.validateInteropccAssetTypeAssetId(assetAgreement)
+        if err != nil {
+            return false, err
+        }
+        if len(assetAgreement.Recipient) == 0 {
+            return false, logThenErrorf("empty lock recipient")
+        }
+        assetAgreementBytes, err := proto.Marshal(assetAgreement)
+        if err != nil {
+            return false, logThenErrorf(err.Error())
+        }
+        assetAgreementsBytes64 = append(assetAgreementsBytes64, base64.StdEncoding.EncodeToString(assetAgreementBytes))
+    }
+
+    _, err := am.invokeInteropccBatch(stub, "UnlockAssetBatch", assetAgreementsBytes64)
+    if err != nil {
+        return false, err
+    }
+    fmt.Printf("Unlocked %d assets\n", len(assetAgreements))
+    return true, nil
+}
+
+func (am *AssetManagement) UnlockFungibleAssetBatch(stub shim.ChaincodeStubInterface, contractIds []string) (bool, error) {
+    for _, contractId := range contractIds {
+        _, err := am.validateInteropccContractId(contractId)
+        if err != nil {
+            return false, err
+        }
+    }
+
+    _, err := am.invokeInteropccBatch(stub, "UnlockFungibleAssetBatch", contractIds)
+    if err != nil {
+        return false, err
+    }
+    fmt.Printf("Fungible assets locked using contractIds %v are unlocked\n", contractIds)
+    return true, nil
+}
+
+// helper function to invoke a batch function in the interop chaincode, passing each list argument as a JSON array
+// and parsing the (optional) JSON array of strings returned in the response payload
+func (am *AssetManagement) invokeInteropccBatch(stub shim.ChaincodeStubInterface, funcName string, listArgs ...[]string) ([]string, error) {
+    iccArgs := [][]byte{[]byte(funcName)}
+    for _, listArg := range listArgs {
+        listArgJSON, err := json.Marshal(listArg)
+        if err != nil {
+            return []string{}, logThenErrorf(err.Error())
+        }
+        iccArgs = append(iccArgs, listArgJSON)
+    }
+
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, iccArgs, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return []string{}, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    results := []string{}
+    if len(iccResp.GetPayload()) > 0 {
+        err := json.Unmarshal(iccResp.GetPayload(), &results)
+        if err != nil {
+            return []string{}, logThenErrorf(err.Error())
+        }
+    }
+    return results, nil
+}
+
 
 // Ledger query functions
 
@@ -434,51 +810,39 @@ func (am *AssetManagement) GetTotalFungibleLockedAssets(stub shim.ChaincodeStubI
 
 // 'lockRecipient': if blank, assume caller
 // 'locker': if blank, assume caller
-func (am *AssetManagement) GetAllLockedAssetsFunc(stub shim.ChaincodeStubInterface, funcName string, lockRecipient string, locker string) ([]string, error) {
-    var assets []string
+func (am *AssetManagement) GetAllLockedAssetsFunc(stub shim.ChaincodeStubInterface, funcName string, lockRecipient string, locker string) ([]LockedAsset, error) {
+    var assets []LockedAsset
 
     if len(am.interopChaincodeId) == 0 {
-        return []string{}, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+        return []LockedAsset{}, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
     }
 
-    myselfBytes, err := stub.GetCreator()
-    if err != nil {
-        return []string{}, logThenErrorf(err.Error())
-    }
-    myself := string(myselfBytes)
-    if len(lockRecipient) == 0 {
-        log.Info("empty lock recipient; assuming caller")
-        lockRecipient = myself
-    }
-    if len(locker) == 0 {
-        log.Info("empty locker; assuming caller")
-        locker = myself
-    }
+    // a blank party is passed on to the interop chaincode, which resolves it to the caller's certificate
     if lockRecipient == locker {
-        return []string{}, logThenErrorf("invalid query: locker identical to recipient")
+        return []LockedAsset{}, logThenErrorf("invalid query: locker identical to recipient")
     }
     iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte(funcName), []byte(lockRecipient), []byte(locker)}, "")
     fmt.Printf("Response from Interop CC: %+v\n", iccResp)
     if iccResp.GetStatus() != shim.OK {
-        return []string{}, errors.New(string(iccResp.GetMessage()))
+        return []LockedAsset{}, errors.New(string(iccResp.GetMessage()))
     }
-    err = json.Unmarshal(iccResp.Payload, &assets)
+    err := json.Unmarshal(iccResp.Payload, &assets)
     if err != nil {
-        return []string{}, logThenErrorf(err.Error())
+        return []LockedAsset{}, logThenErrorf(err.Error())
     }
     fmt.Printf("Obtained info for %d assets locked by %s for %s\n", len(assets), locker, lockRecipient)
     return assets, nil
 }
 
-func (am *AssetManagement) GetAllLockedAssets(stub shim.ChaincodeStubInterface, lockRecipient string, locker string) ([]string, error) {
+func (am *AssetManagement) GetAllLockedAssets(stub shim.ChaincodeStubInterface, lockRecipient string, locker string) ([]LockedAsset, error) {
     return am.GetAllLockedAssetsFunc(stub, "GetAllLockedAssets", lockRecipient, locker)
 }
 
-func (am *AssetManagement) GetAllNonFungibleLockedAssets(stub shim.ChaincodeStubInterface, lockRecipient string, locker string) ([]string, error) {
+func (am *AssetManagement) GetAllNonFungibleLockedAssets(stub shim.ChaincodeStubInterface, lockRecipient string, locker string) ([]LockedAsset, error) {
     return am.GetAllLockedAssetsFunc(stub, "GetAllNonFungibleLockedAssets", lockRecipient, locker)
 }
 
-func (am *AssetManagement) GetAllFungibleLockedAssets(stub shim.ChaincodeStubInterface, lockRecipient string, locker string) ([]string, error) {
+func (am *AssetManagement) GetAllFungibleLockedAssets(stub shim.ChaincodeStubInterface, lockRecipient string, locker string) ([]LockedAsset, error) {
     return am.GetAllLockedAssetsFunc(stub, "GetAllFungibleLockedAssets", lockRecipient, locker)
 }
 
@@ -495,19 +859,7 @@ func (am *AssetManagement) GetAssetTimeToRelease(stub shim.ChaincodeStubInterfac
     if len(assetAgreement.Id) == 0 {
         return 0, logThenErrorf("empty asset ID")
     }
-    myselfBytes, err := stub.GetCreator()
-    if err != nil {
-        return 0, logThenErrorf(err.Error())
-    }
-    myself := string(myselfBytes)
-    if len(assetAgreement.Recipient) == 0 {
-        log.Info("empty lock recipient; assuming caller")
-        assetAgreement.Recipient = myself
-    }
-    if len(assetAgreement.Locker) == 0 {
-        log.Info("empty locker; assuming caller")
-        assetAgreement.Locker = myself
-    }
+    // a blank party is passed on to the interop chaincode, which resolves it to the caller's certificate
     if assetAgreement.Recipient == assetAgreement.Locker {
         return 0, logThenErrorf("invalid query: locker identical to recipient")
     }
@@ -540,19 +892,7 @@ func (am *AssetManagement) GetFungibleAssetTimeToRelease(stub shim.ChaincodeStub
     if assetAgreement.NumUnits <= 0 {
         return 0, logThenErrorf("invalid number of asset units")
     }
-    myselfBytes, err := stub.GetCreator()
-    if err != nil {
-        return 0, logThenErrorf(err.Error())
-    }
-    myself := string(myselfBytes)
-    if len(assetAgreement.Recipient) == 0 {
-        log.Info("empty lock recipient; assuming caller")
-        assetAgreement.Recipient = myself
-    }
-    if len(assetAgreement.Locker) == 0 {
-        log.Info("empty locker; assuming caller")
-        assetAgreement.Locker = myself
-    }
+    // a blank party is passed on to the interop chaincode, which resolves it to the caller's certificate
     if assetAgreement.Recipient == assetAgreement.Locker {
         return 0, logThenErrorf("invalid query: locker identical to recipient")
     }
@@ -573,24 +913,24 @@ func (am *AssetManagement) GetFungibleAssetTimeToRelease(stub shim.ChaincodeStub
 }
 
 // Assumption is that the caller is either the recipient or the locker in each element in the list, but we will let the interop CC take care of it
-func (am *AssetManagement) GetAllAssetsLockedUntil(stub shim.ChaincodeStubInterface, lockExpiryTimeSecs uint64) ([]string, error) {
-    var assets []string
+func (am *AssetManagement) GetAllAssetsLockedUntil(stub shim.ChaincodeStubInterface, lockExpiryTimeSecs uint64) ([]LockedAsset, error) {
+    var assets []LockedAsset
 
     if len(am.interopChaincodeId) == 0 {
-        return []string{}, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+        return []LockedAsset{}, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
     }
 
     if lockExpiryTimeSecs <= 0 {
-        return []string{}, logThenErrorf("invalid expiry time")
+        return []LockedAsset{}, logThenErrorf("invalid expiry time")
     }
     iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("GetAllAssetsLockedUntil"), []byte(strconv.FormatInt(int64(lockExpiryTimeSecs), 10))}, "")
     fmt.Printf("Response from Interop CC: %+v\n", iccResp)
     if iccResp.GetStatus() != shim.OK {
-        return []string{}, logThenErrorf(string(iccResp.GetMessage()))
+        return []LockedAsset{}, logThenErrorf(string(iccResp.GetMessage()))
     }
     err := json.Unmarshal(iccResp.Payload, &assets)
     if err != nil {
-        return []string{}, logThenErrorf(err.Error())
+        return []LockedAsset{}, logThenErrorf(err.Error())
     }
     fmt.Printf("Obtained info for %d assets locked until %+v\n", len(assets), time.Unix(int64(lockExpiryTimeSecs), 0))
     return assets, nil
@@ -695,3 +1035,210 @@ func (am *AssetManagement) GetHTLCHashPreImageByContractId(stub shim.ChaincodeSt
     }
     return string(iccResp.GetPayload()), nil
 }
+
+
+// Hybrid asset functions
+// A hybrid asset has both an identity and a quantity, so its locks are identified by asset type, asset ID and number of units
+
+func (am *AssetManagement) validateInteropccHybridAsset(assetAgreement *common.HybridAssetExchangeAgreement) (bool, error) {
+    if len(am.interopChaincodeId) == 0 {
+        return false, logThenErrorf("interoperation chaincode ID not set. Run the 'Configure(...)' function first.")
+    }
+    if len(assetAgreement.AssetType) == 0 {
+        return false, logThenErrorf("empty asset type")
+    }
+    if len(assetAgreement.Id) == 0 {
+        return false, logThenErrorf("empty asset ID")
+    }
+    if assetAgreement.NumUnits <= 0 {
+        return false, logThenErrorf("invalid number of asset units")
+    }
+
+    return true, nil
+}
+
+// helper function to validate the locker and recipient of a hybrid asset agreement used in a query; a blank party is
+// passed on to the interop chaincode, which resolves it to the caller's certificate
+func (am *AssetManagement) validateHybridAssetQueryParties(assetAgreement *common.HybridAssetExchangeAgreement) error {
+    if assetAgreement.Recipient == assetAgreement.Locker {
+        return logThenErrorf("invalid query: locker identical to recipient")
+    }
+
+    return nil
+}
+
+func (am *AssetManagement) LockHybridAsset(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement, lockInfo *common.AssetLock) (string, error) {
+    _, err := am.validateInteropccHybridAsset(assetAgreement)
+    if err != nil {
+        return "", err
+    }
+    if len(assetAgreement.Recipient) == 0 {
+        return "", logThenErrorf("empty lock recipient")
+    }
+
+    assetAgreementBytes, err := proto.Marshal(assetAgreement)
+    if err != nil {
+        return "", logThenErrorf(err.Error())
+    }
+    err = am.validateLockInfo(lockInfo)
+    if err != nil {
+        return "", err
+    }
+    lockInfoBytes, err := proto.Marshal(lockInfo)
+    if err != nil {
+        return "", logThenErrorf(err.Error())
+    }
+    assetAgreementBytes64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+    lockInfoBytes64 := base64.StdEncoding.EncodeToString(lockInfoBytes)
+
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("LockHybridAsset"), []byte(assetAgreementBytes64), []byte(lockInfoBytes64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return "", logThenErrorf(string(iccResp.GetMessage()))
+    }
+    contractId := string(iccResp.GetPayload())
+    fmt.Printf("%d units of asset %s of type %s locked for %s using contractId %s\n", assetAgreement.NumUnits, assetAgreement.Id, assetAgreement.AssetType, assetAgreement.Recipient, contractId)
+    return contractId, nil
+}
+
+// If 'assetAgreement.Locker' or 'assetAgreement.Recipient' is blank, assume it's the caller
+func (am *AssetManagement) IsHybridAssetLocked(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement) (bool, error) {
+    _, err := am.validateInteropccHybridAsset(assetAgreement)
+    if err != nil {
+        return false, err
+    }
+    err = am.validateHybridAssetQueryParties(assetAgreement)
+    if err != nil {
+        return false, err
+    }
+
+    assetAgreementBytes, err := proto.Marshal(assetAgreement)
+    if err != nil {
+        return false, logThenErrorf(err.Error())
+    }
+    assetAgreementBytes64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("IsHybridAssetLocked"), []byte(assetAgreementBytes64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return false, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    isLocked := (string(iccResp.Payload) == fmt.Sprintf("%t", true))
+    if isLocked {
+        fmt.Printf("%d units of asset %s of type %s locked by %s for %s\n", assetAgreement.NumUnits, assetAgreement.Id, assetAgreement.AssetType, assetAgreement.Locker, assetAgreement.Recipient)
+    } else {
+        fmt.Printf("%d units of asset %s of type %s not locked by %s for %s\n", assetAgreement.NumUnits, assetAgreement.Id, assetAgreement.AssetType, assetAgreement.Locker, assetAgreement.Recipient)
+    }
+    return isLocked, nil
+}
+
+func (am *AssetManagement) ClaimHybridAsset(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement, claimInfo *common.AssetClaim) (bool, error) {
+    _, err := am.validateInteropccHybridAsset(assetAgreement)
+    if err != nil {
+        return false, err
+    }
+    if len(assetAgreement.Locker) == 0 {
+        return false, logThenErrorf("empty locker")
+    }
+
+    assetAgreementBytes, err := proto.Marshal(assetAgreement)
+    if err != nil {
+        return false, logThenErrorf(err.Error())
+    }
+    err = am.validateClaimInfo(claimInfo)
+    if err != nil {
+        return false, err
+    }
+    claimInfoBytes, err := proto.Marshal(claimInfo)
+    if err != nil {
+        return false, logThenErrorf(err.Error())
+    }
+    assetAgreementBytes64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+    claimInfoBytes64 := base64.StdEncoding.EncodeToString(claimInfoBytes)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("ClaimHybridAsset"), []byte(assetAgreementBytes64), []byte(claimInfoBytes64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return false, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    fmt.Printf("Claimed %d units of asset %s of type %s locked by %s\n", assetAgreement.NumUnits, assetAgreement.Id, assetAgreement.AssetType, assetAgreement.Locker)
+    return true, nil
+}
+
+func (am *AssetManagement) UnlockHybridAsset(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement) (bool, error) {
+    _, err := am.validateInteropccHybridAsset(assetAgreement)
+    if err != nil {
+        return false, err
+    }
+    if len(assetAgreement.Recipient) == 0 {
+        return false, logThenErrorf("empty lock recipient")
+    }
+
+    assetAgreementBytes, err := proto.Marshal(assetAgreement)
+    if err != nil {
+        return false, logThenErrorf(err.Error())
+    }
+    assetAgreementBytes64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("UnlockHybridAsset"), []byte(assetAgreementBytes64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return false, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    fmt.Printf("%d units of asset %s of type %s unlocked\n", assetAgreement.NumUnits, assetAgreement.Id, assetAgreement.AssetType)
+    return true, nil
+}
+
+// 'lockRecipient': if blank, assume caller
+// 'locker': if blank, assume caller
+func (am *AssetManagement) GetHybridAssetTimeToRelease(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement) (uint64, error) {
+    _, err := am.validateInteropccHybridAsset(assetAgreement)
+    if err != nil {
+        return 0, err
+    }
+    err = am.validateHybridAssetQueryParties(assetAgreement)
+    if err != nil {
+        return 0, err
+    }
+
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte("GetHybridAssetTimeToRelease"), []byte(assetAgreement.AssetType), []byte(assetAgreement.Id), []byte(strconv.FormatUint(assetAgreement.NumUnits, 10)), []byte(assetAgreement.Recipient), []byte(assetAgreement.Locker)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return 0, logThenErrorf(string(iccResp.GetMessage()))
+    }
+    timeToReleaseSecs, err := strconv.ParseUint(string(iccResp.Payload), 10, 64)
+    if err != nil {
+        return 0, logThenErrorf(err.Error())
+    }
+    fmt.Printf("%d units of asset %s of type %s locked until %+v\n", assetAgreement.NumUnits, assetAgreement.Id, assetAgreement.AssetType, time.Unix(int64(timeToReleaseSecs), 0))
+    return timeToReleaseSecs, nil
+}
+
+func (am *AssetManagement) GetHybridHTLCHash(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement) (string, error) {
+    return am.queryHybridAssetAgreement(stub, "GetHybridHTLCHash", assetAgreement)
+}
+
+func (am *AssetManagement) GetHybridHTLCHashPreImage(stub shim.ChaincodeStubInterface, assetAgreement *common.HybridAssetExchangeAgreement) (string, error) {
+    return am.queryHybridAssetAgreement(stub, "GetHybridHTLCHashPreImage", assetAgreement)
+}
+
+// helper function to invoke an interop chaincode query function that takes a hybrid asset agreement, returning its response
+func (am *AssetManagement) queryHybridAssetAgreement(stub shim.ChaincodeStubInterface, funcName string, assetAgreement *common.HybridAssetExchangeAgreement) (string, error) {
+    _, err := am.validateInteropccHybridAsset(assetAgreement)
+    if err != nil {
+        return "", err
+    }
+    err = am.validateHybridAssetQueryParties(assetAgreement)
+    if err != nil {
+        return "", err
+    }
+
+    assetAgreementBytes, err := proto.Marshal(assetAgreement)
+    if err != nil {
+        return "", logThenErrorf(err.Error())
+    }
+    assetAgreementBytes64 := base64.StdEncoding.EncodeToString(assetAgreementBytes)
+    iccResp := stub.InvokeChaincode(am.interopChaincodeId, [][]byte{[]byte(funcName), []byte(assetAgreementBytes64)}, "")
+    fmt.Printf("Response from Interop CC: %+v\n", iccResp)
+    if iccResp.GetStatus() != shim.OK {
+        return "", logThenErrorf(string(iccResp.GetMessage()))
+    }
+    return string(iccResp.GetPayload()), nil
+}
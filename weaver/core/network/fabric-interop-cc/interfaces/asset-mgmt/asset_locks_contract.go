@@ -9,6 +9,7 @@ package assetmgmt
 import (
     "encoding/base64"
     "encoding/json"
+    "strconv"
 
     "github.com/golang/protobuf/proto"
     "github.com/hyperledger/fabric-contract-api-go/contractapi"
@@ -46,6 +47,13 @@ type ContractedFungibleAsset struct {
     NumUnits	uint64	`json:"id"`
 }
 
+// Object used in the map, contractId --> contracted-hybrid-asset
+type ContractedHybridAsset struct {
+    Type	string	`json:"type"`
+    Id		string	`json:"id"`
+    NumUnits	uint64	`json:"numUnits"`
+}
+
 func getAssetLockLookupMapKey(ctx contractapi.TransactionContextInterface, Type, Id string) (string, error) {
     assetLockKey, err := ctx.GetStub().CreateCompositeKey("AssetExchangeContract", []string{Type, Id})
     if err != nil {
@@ -63,6 +71,19 @@ func getFungibleAssetContractIdMapKey(contractId string) string {
     return "FungibleAssetContract_" + contractId
 }
 
+func getHybridAssetLockLookupMapKey(ctx contractapi.TransactionContextInterface, Type, Id string, NumUnits uint64) (string, error) {
+    assetLockKey, err := ctx.GetStub().CreateCompositeKey("HybridAssetExchangeContract", []string{Type, Id, strconv.FormatUint(NumUnits, 10)})
+    if err != nil {
+        return "", logThenErrorf("error while creating composite key: %+v", err)
+    }
+
+    return assetLockKey, nil
+}
+
+func getHybridAssetContractIdMapKey(contractId string) string {
+    return "HybridAssetContract_" + contractId
+}
+
 // write to the ledger the details needed at the time of unlock/claim
 func (amc *AssetManagementContract) ContractIdFungibleAssetsLookupMap(ctx contractapi.TransactionContextInterface, assetType string, numUnits uint64, contractId string) error {
     contractedFungibleAsset := &ContractedFungibleAsset {
@@ -185,6 +206,98 @@ func (amc *AssetManagementContract) DeleteAssetLookupMaps(ctx contractapi.Transa
     return nil
 }
 
+// write to the ledger the details needed at the time of unlock/claim of a hybrid asset
+func (amc *AssetManagementContract) ContractIdHybridAssetsLookupMap(ctx contractapi.TransactionContextInterface, assetType, assetId string, numUnits uint64, contractId string) error {
+    contractedHybridAsset := &ContractedHybridAsset {
+        Type: assetType,
+        Id: assetId,
+        NumUnits: numUnits,
+    }
+    contractedHybridAssetBytes, err := json.Marshal(contractedHybridAsset)
+    if err != nil {
+        return logThenErrorf("marshal error: %+v", err)
+    }
+    assetLockKey, err := getHybridAssetLockLookupMapKey(ctx, assetType, assetId, numUnits)
+    if err != nil {
+        return logThenErrorf(err.Error())
+    }
+    err = ctx.GetStub().PutState(assetLockKey, []byte(contractId))
+    if err != nil {
+        return logThenErrorf("failed to write to the hybrid asset ledger: %+v", err)
+    }
+    err = ctx.GetStub().PutState(getHybridAssetContractIdMapKey(contractId), contractedHybridAssetBytes)
+    if err != nil {
+        return logThenErrorf("failed to write to the hybrid asset ledger: %+v", err)
+    }
+
+    return nil
+}
+
+func (amc *AssetManagementContract) DeleteHybridAssetLookupMaps(ctx contractapi.TransactionContextInterface, assetType, assetId string, numUnits uint64) error {
+    // delete the lookup details
+    assetLockKey, err := getHybridAssetLockLookupMapKey(ctx, assetType, assetId, numUnits)
+    if err != nil {
+        return logThenErrorf(err.Error())
+    }
+    contractIdBytes, err := ctx.GetStub().GetState(assetLockKey)
+    if err != nil {
+        return logThenErrorf("unable to fetch from hybrid asset ledger: %+v", err.Error())
+    }
+    if contractIdBytes == nil {
+        return logThenErrorf("contractId not found on hybrid asset ledger")
+    }
+    err = ctx.GetStub().DelState(getHybridAssetContractIdMapKey(string(contractIdBytes)))
+    if err != nil {
+        return logThenErrorf("failed to delete entry contractId %s from hybrid asset ledger: %+v", string(contractIdBytes), err)
+    }
+    err = ctx.GetStub().DelState(assetLockKey)
+    if err != nil {
+        return logThenErrorf("failed to delete entry associated with contractId %s from hybrid asset ledger: %+v", string(contractIdBytes), err)
+    }
+
+    return nil
+}
+
+func (amc *AssetManagementContract) DeleteHybridAssetLookupMapsOnlyUsingContractId(ctx contractapi.TransactionContextInterface, contractId string) error {
+    // delete the lookup maps
+    assetType, assetId, numUnits, err := amc.FetchFromContractIdHybridAssetLookupMap(ctx, contractId)
+    if err != nil {
+        return err
+    }
+    assetLockKey, err := getHybridAssetLockLookupMapKey(ctx, assetType, assetId, numUnits)
+    if err != nil {
+        return logThenErrorf(err.Error())
+    }
+    err = ctx.GetStub().DelState(assetLockKey)
+    if err != nil {
+        return logThenErrorf("failed to delete entry associated with contractId %s from hybrid asset ledger: %+v", contractId, err)
+    }
+    err = ctx.GetStub().DelState(getHybridAssetContractIdMapKey(contractId))
+    if err != nil {
+        return logThenErrorf("failed to delete contractId %s from hybrid asset ledger: %+v", contractId, err)
+    }
+
+    return nil
+}
+
+// Fetch the contracted hybrid asset type, id and numUnits from the ledger
+func (amc *AssetManagementContract) FetchFromContractIdHybridAssetLookupMap(ctx contractapi.TransactionContextInterface, contractId string) (string, string, uint64, error) {
+    contractedHybridAssetBytes, err := ctx.GetStub().GetState(getHybridAssetContractIdMapKey(contractId))
+    if err != nil {
+        return "", "", 0, logThenErrorf("failed to read from hybrid asset ledger: %+v", err)
+    }
+    if contractedHybridAssetBytes == nil {
+        return "", "", 0, logThenErrorf("contractId %s not found on hybrid asset ledger", contractId)
+    }
+    contractedHybridAsset := ContractedHybridAsset{}
+    err = json.Unmarshal(contractedHybridAssetBytes, &contractedHybridAsset)
+    if err != nil {
+        return "", "", 0, logThenErrorf("unmarshal error: %+v", err)
+    }
+
+    return contractedHybridAsset.Type, contractedHybridAsset.Id, contractedHybridAsset.NumUnits, nil
+}
+
 // Fetch the contracted fungible asset type and numUnits from the ledger
 func (amc *AssetManagementContract) FetchFromContractIdFungibleAssetLookupMap(ctx contractapi.TransactionContextInterface, contractId string) (string, uint64, error) {
     contractedFungibleAssetBytes, err := ctx.GetStub().GetState(getFungibleAssetContractIdMapKey(contractId))
@@ -251,6 +364,24 @@ func (amc *AssetManagementContract) ValidateAndExtractFungibleAssetAgreement(fun
     return assetAgreement, nil
 }
 
+func (amc *AssetManagementContract) ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64 string) (*common.HybridAssetExchangeAgreement, error) {
+    assetAgreement := &common.HybridAssetExchangeAgreement{}
+    // Decoding from base64
+    hybridAssetExchangeAgreementSerializedProto, err := base64.StdEncoding.DecodeString(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+      return assetAgreement, logThenErrorf(err.Error())
+    }
+    if len(hybridAssetExchangeAgreementSerializedProto) == 0 {
+        return assetAgreement, logThenErrorf("empty asset agreement")
+    }
+    err = proto.Unmarshal([]byte(hybridAssetExchangeAgreementSerializedProto), assetAgreement)
+    if err != nil {
+        return assetAgreement, logThenErrorf(err.Error())
+    }
+
+    return assetAgreement, nil
+}
+
 func (amc *AssetManagementContract) ValidateAndExtractLockInfo(lockInfoSerializedProto64 string) (*common.AssetLock, error) {
     lockInfo := &common.AssetLock{}
     // Decoding from base64
@@ -381,6 +512,13 @@ func (amc *AssetManagementContract) IsFungibleAssetLocked(ctx contractapi.Transa
     return amc.assetManagement.IsFungibleAssetLocked(ctx.GetStub(), contractId)
 }
 
+func (amc *AssetManagementContract) GetFungibleAssetLockStatus(ctx contractapi.TransactionContextInterface, contractId string) (FungibleAssetLockStatus, error) {
+    if len(contractId) == 0 {
+        return FungibleAssetLockStatus{}, logThenErrorf("empty contract id")
+    }
+    return amc.assetManagement.GetFungibleAssetLockStatus(ctx.GetStub(), contractId)
+}
+
 func (amc *AssetManagementContract) IsAssetLockedQueryUsingContractId(ctx contractapi.TransactionContextInterface, contractId string) (bool, error) {
     if len(contractId) == 0 {
         return false, logThenErrorf("empty contract id")
@@ -459,6 +597,39 @@ func (amc *AssetManagementContract) ClaimFungibleAsset(ctx contractapi.Transacti
     return retVal, err
 }
 
+// The event emitted by this function carries a serialized 'AssetLockEvent' with the number of units claimed; its type is
+// 'PARTIALLY_CLAIMED' while some units remain locked, and 'CLAIMED' once the claim takes the last of them
+func (amc *AssetManagementContract) PartialClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string, numUnits uint64, claimInfoSerializedProto64 string) (uint64, error) {
+    if len(contractId) == 0 {
+        return 0, logThenErrorf("empty contract id")
+    }
+    claimInfo, err := amc.ValidateAndExtractClaimInfo(claimInfoSerializedProto64)
+    if err != nil {
+        return 0, err
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    remainingUnits, err := amc.assetManagement.PartialClaimFungibleAsset(ctx.GetStub(), contractId, numUnits, claimInfo)
+    if err == nil {
+        claimEvent := &common.AssetLockEvent{
+            EventType:  common.AssetLockEventType_PARTIALLY_CLAIMED,
+            ContractId: contractId,
+            NumUnits:   numUnits,
+        }
+        if remainingUnits == 0 {
+            claimEvent.EventType = common.AssetLockEventType_CLAIMED
+        }
+        claimEventBytes, eventErr := proto.Marshal(claimEvent)
+        if eventErr == nil {
+            eventErr = ctx.GetStub().SetEvent("PartialClaimFungibleAsset", claimEventBytes)
+        }
+        if eventErr != nil {
+            logWarnings("Unable to set 'PartialClaimFungibleAsset' event", eventErr.Error())
+        }
+    }
+    return remainingUnits, err
+}
+
 func (amc *AssetManagementContract) ClaimAssetUsingContractId(ctx contractapi.TransactionContextInterface, contractId, claimInfoSerializedProto64 string) (bool, error) {
     if len(contractId) == 0 {
         return false, logThenErrorf("empty contract id")
@@ -562,6 +733,300 @@ func (amc *AssetManagementContract) UnlockAssetUsingContractId(ctx contractapi.T
     return retVal, err
 }
 
+// Lock amendment functions, called by the locker with the recipient's signature (base64 encoded) over the new terms
+// The events emitted by these functions carry a serialized 'AssetLockEvent'
+
+func (amc *AssetManagementContract) ExtendLockExpiry(ctx contractapi.TransactionContextInterface, contractId string, newExpiryTimeSecs uint64, recipientSignatureBase64 string) (bool, error) {
+    if len(contractId) == 0 {
+        return false, logThenErrorf("empty contract id")
+    }
+    recipientSignature, err := base64.StdEncoding.DecodeString(recipientSignatureBase64)
+    if err != nil {
+        return false, logThenErrorf(err.Error())
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.ExtendLockExpiry(ctx.GetStub(), contractId, newExpiryTimeSecs, recipientSignature)
+    if retVal && err == nil {
+        extendEvent := &common.AssetLockEvent{
+            EventType:      common.AssetLockEventType_EXTENDED,
+            ContractId:     contractId,
+            ExpiryTimeSecs: newExpiryTimeSecs,
+        }
+        extendEventBytes, eventErr := proto.Marshal(extendEvent)
+        if eventErr == nil {
+            eventErr = ctx.GetStub().SetEvent("ExtendLockExpiry", extendEventBytes)
+        }
+        if eventErr != nil {
+            logWarnings("Unable to set 'ExtendLockExpiry' event", eventErr.Error())
+        }
+    }
+    return retVal, err
+}
+
+func (amc *AssetManagementContract) CancelLock(ctx contractapi.TransactionContextInterface, contractId string, recipientSignatureBase64 string) (bool, error) {
+    if len(contractId) == 0 {
+        return false, logThenErrorf("empty contract id")
+    }
+    recipientSignature, err := base64.StdEncoding.DecodeString(recipientSignatureBase64)
+    if err != nil {
+        return false, logThenErrorf(err.Error())
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.CancelLock(ctx.GetStub(), contractId, recipientSignature)
+    if retVal && err == nil {
+        cancelEvent := &common.AssetLockEvent{
+            EventType:  common.AssetLockEventType_CANCELLED,
+            ContractId: contractId,
+        }
+        cancelEventBytes, eventErr := proto.Marshal(cancelEvent)
+        if eventErr == nil {
+            eventErr = ctx.GetStub().SetEvent("CancelLock", cancelEventBytes)
+        }
+        if eventErr != nil {
+            logWarnings("Unable to set 'CancelLock' event", eventErr.Error())
+        }
+    }
+    return retVal, err
+}
+
+// Hybrid asset transaction (invocation) functions
+// The events emitted by these functions carry a serialized 'AssetLockEvent'
+
+func (amc *AssetManagementContract) LockHybridAsset(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string, lockInfoSerializedProto64 string) (string, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return "", err
+    }
+    lockInfo, err := amc.ValidateAndExtractLockInfo(lockInfoSerializedProto64)
+    if err != nil {
+        return "", err
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    contractId, err := amc.assetManagement.LockHybridAsset(ctx.GetStub(), assetAgreement, lockInfo)
+    if err == nil {
+        setHybridAssetEvent(ctx, "LockHybridAsset", common.AssetLockEventType_LOCKED, contractId, assetAgreement)
+    }
+    return contractId, err
+}
+
+func (amc *AssetManagementContract) IsHybridAssetLocked(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string) (bool, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return false, err
+    }
+
+    return amc.assetManagement.IsHybridAssetLocked(ctx.GetStub(), assetAgreement)
+}
+
+func (amc *AssetManagementContract) ClaimHybridAsset(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string, claimInfoSerializedProto64 string) (bool, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return false, err
+    }
+    claimInfo, err := amc.ValidateAndExtractClaimInfo(claimInfoSerializedProto64)
+    if err != nil {
+        return false, err
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.ClaimHybridAsset(ctx.GetStub(), assetAgreement, claimInfo)
+    if retVal && err == nil {
+        setHybridAssetEvent(ctx, "ClaimHybridAsset", common.AssetLockEventType_CLAIMED, "", assetAgreement)
+    }
+    return retVal, err
+}
+
+func (amc *AssetManagementContract) UnlockHybridAsset(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string) (bool, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return false, err
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.UnlockHybridAsset(ctx.GetStub(), assetAgreement)
+    if retVal && err == nil {
+        setHybridAssetEvent(ctx, "UnlockHybridAsset", common.AssetLockEventType_UNLOCKED, "", assetAgreement)
+    }
+    return retVal, err
+}
+
+// helper function to emit an event for a state transition of a hybrid asset lock
+func setHybridAssetEvent(ctx contractapi.TransactionContextInterface, eventName string, eventType common.AssetLockEventType, contractId string, assetAgreement *common.HybridAssetExchangeAgreement) {
+    lockEvent := &common.AssetLockEvent{
+        EventType:  eventType,
+        ContractId: contractId,
+        AssetType:  assetAgreement.AssetType,
+        AssetId:    assetAgreement.Id,
+        NumUnits:   assetAgreement.NumUnits,
+        Locker:     assetAgreement.Locker,
+        Recipient:  assetAgreement.Recipient,
+    }
+    lockEventBytes, err := proto.Marshal(lockEvent)
+    if err == nil {
+        err = ctx.GetStub().SetEvent(eventName, lockEventBytes)
+    }
+    if err != nil {
+        logWarnings("Unable to set '" + eventName + "' event", err.Error())
+    }
+}
+
+
+// Batch transaction (invocation) functions
+// Each batch is recorded atomically, and the event emitted for a batch carries the JSON-encoded list of its
+// contract IDs (or serialized asset agreements, for non-fungible claims and unlocks)
+
+func (amc *AssetManagementContract) LockAssetBatch(ctx contractapi.TransactionContextInterface, assetAgreementsSerializedProto64 []string, lockInfosSerializedProto64 []string) ([]string, error) {
+    if len(assetAgreementsSerializedProto64) != len(lockInfosSerializedProto64) {
+        return []string{}, logThenErrorf("number of asset agreements (%d) and lock infos (%d) do not match", len(assetAgreementsSerializedProto64), len(lockInfosSerializedProto64))
+    }
+    assetAgreements := []*common.AssetExchangeAgreement{}
+    lockInfos := []*common.AssetLock{}
+    for i := range assetAgreementsSerializedProto64 {
+        assetAgreement, err := amc.ValidateAndExtractAssetAgreement(assetAgreementsSerializedProto64[i])
+        if err != nil {
+            return []string{}, err
+        }
+        lockInfo, err := amc.ValidateAndExtractLockInfo(lockInfosSerializedProto64[i])
+        if err != nil {
+            return []string{}, err
+        }
+        assetAgreements = append(assetAgreements, assetAgreement)
+        lockInfos = append(lockInfos, lockInfo)
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    contractIds, err := amc.assetManagement.LockAssetBatch(ctx.GetStub(), assetAgreements, lockInfos)
+    if err == nil {
+        setBatchEvent(ctx, "LockAssetBatch", contractIds)
+    }
+    return contractIds, err
+}
+
+func (amc *AssetManagementContract) LockFungibleAssetBatch(ctx contractapi.TransactionContextInterface, fungibleAssetExchangeAgreementsSerializedProto64 []string, lockInfosSerializedProto64 []string) ([]string, error) {
+    if len(fungibleAssetExchangeAgreementsSerializedProto64) != len(lockInfosSerializedProto64) {
+        return []string{}, logThenErrorf("number of asset agreements (%d) and lock infos (%d) do not match", len(fungibleAssetExchangeAgreementsSerializedProto64), len(lockInfosSerializedProto64))
+    }
+    assetAgreements := []*common.FungibleAssetExchangeAgreement{}
+    lockInfos := []*common.AssetLock{}
+    for i := range fungibleAssetExchangeAgreementsSerializedProto64 {
+        assetAgreement, err := amc.ValidateAndExtractFungibleAssetAgreement(fungibleAssetExchangeAgreementsSerializedProto64[i])
+        if err != nil {
+            return []string{}, err
+        }
+        lockInfo, err := amc.ValidateAndExtractLockInfo(lockInfosSerializedProto64[i])
+        if err != nil {
+            return []string{}, err
+        }
+        assetAgreements = append(assetAgreements, assetAgreement)
+        lockInfos = append(lockInfos, lockInfo)
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    contractIds, err := amc.assetManagement.LockFungibleAssetBatch(ctx.GetStub(), assetAgreements, lockInfos)
+    if err == nil {
+        setBatchEvent(ctx, "LockFungibleAssetBatch", contractIds)
+    }
+    return contractIds, err
+}
+
+func (amc *AssetManagementContract) ClaimAssetBatch(ctx contractapi.TransactionContextInterface, assetAgreementsSerializedProto64 []string, claimInfosSerializedProto64 []string) (bool, error) {
+    if len(assetAgreementsSerializedProto64) != len(claimInfosSerializedProto64) {
+        return false, logThenErrorf("number of asset agreements (%d) and claim infos (%d) do not match", len(assetAgreementsSerializedProto64), len(claimInfosSerializedProto64))
+    }
+    assetAgreements := []*common.AssetExchangeAgreement{}
+    claimInfos := []*common.AssetClaim{}
+    for i := range assetAgreementsSerializedProto64 {
+        assetAgreement, err := amc.ValidateAndExtractAssetAgreement(assetAgreementsSerializedProto64[i])
+        if err != nil {
+            return false, err
+        }
+        claimInfo, err := amc.ValidateAndExtractClaimInfo(claimInfosSerializedProto64[i])
+        if err != nil {
+            return false, err
+        }
+        assetAgreements = append(assetAgreements, assetAgreement)
+        claimInfos = append(claimInfos, claimInfo)
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.ClaimAssetBatch(ctx.GetStub(), assetAgreements, claimInfos)
+    if retVal && err == nil {
+        setBatchEvent(ctx, "ClaimAssetBatch", assetAgreementsSerializedProto64)
+    }
+    return retVal, err
+}
+
+func (amc *AssetManagementContract) ClaimFungibleAssetBatch(ctx contractapi.TransactionContextInterface, contractIds []string, claimInfosSerializedProto64 []string) (bool, error) {
+    if len(contractIds) != len(claimInfosSerializedProto64) {
+        return false, logThenErrorf("number of contract ids (%d) and claim infos (%d) do not match", len(contractIds), len(claimInfosSerializedProto64))
+    }
+    claimInfos := []*common.AssetClaim{}
+    for i, contractId := range contractIds {
+        if len(contractId) == 0 {
+            return false, logThenErrorf("empty contract id")
+        }
+        claimInfo, err := amc.ValidateAndExtractClaimInfo(claimInfosSerializedProto64[i])
+        if err != nil {
+            return false, err
+        }
+        claimInfos = append(claimInfos, claimInfo)
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.ClaimFungibleAssetBatch(ctx.GetStub(), contractIds, claimInfos)
+    if retVal && err == nil {
+        setBatchEvent(ctx, "ClaimFungibleAssetBatch", contractIds)
+    }
+    return retVal, err
+}
+
+func (amc *AssetManagementContract) UnlockAssetBatch(ctx contractapi.TransactionContextInterface, assetAgreementsSerializedProto64 []string) (bool, error) {
+    assetAgreements := []*common.AssetExchangeAgreement{}
+    for _, assetAgreementSerializedProto64 := range assetAgreementsSerializedProto64 {
+        assetAgreement, err := amc.ValidateAndExtractAssetAgreement(assetAgreementSerializedProto64)
+        if err != nil {
+            return false, err
+        }
+        assetAgreements = append(assetAgreements, assetAgreement)
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.UnlockAssetBatch(ctx.GetStub(), assetAgreements)
+    if retVal && err == nil {
+        setBatchEvent(ctx, "UnlockAssetBatch", assetAgreementsSerializedProto64)
+    }
+    return retVal, err
+}
+
+func (amc *AssetManagementContract) UnlockFungibleAssetBatch(ctx contractapi.TransactionContextInterface, contractIds []string) (bool, error) {
+    for _, contractId := range contractIds {
+        if len(contractId) == 0 {
+            return false, logThenErrorf("empty contract id")
+        }
+    }
+
+    // The below 'SetEvent' should be the last in a given transaction (if this function is being called by another), otherwise it will be overridden
+    retVal, err := amc.assetManagement.UnlockFungibleAssetBatch(ctx.GetStub(), contractIds)
+    if retVal && err == nil {
+        setBatchEvent(ctx, "UnlockFungibleAssetBatch", contractIds)
+    }
+    return retVal, err
+}
+
+// helper function to emit a batch event carrying a JSON-encoded list
+func setBatchEvent(ctx contractapi.TransactionContextInterface, eventName string, batchItems []string) {
+    batchItemsBytes, err := json.Marshal(batchItems)
+    if err == nil {
+        err = ctx.GetStub().SetEvent(eventName, batchItemsBytes)
+    }
+    if err != nil {
+        logWarnings("Unable to set '" + eventName + "' event", err.Error())
+    }
+}
+
 
 // Ledger query functions
 
@@ -569,15 +1034,15 @@ func (amc *AssetManagementContract) GetTotalFungibleLockedAssets(ctx contractapi
     return amc.assetManagement.GetTotalFungibleLockedAssets(ctx.GetStub(), assetType)
 }
 
-func (amc *AssetManagementContract) GetAllLockedAssets(ctx contractapi.TransactionContextInterface, lockRecipient string, locker string) ([]string, error) {
+func (amc *AssetManagementContract) GetAllLockedAssets(ctx contractapi.TransactionContextInterface, lockRecipient string, locker string) ([]LockedAsset, error) {
     return amc.assetManagement.GetAllLockedAssets(ctx.GetStub(), lockRecipient, locker)
 }
 
-func (amc *AssetManagementContract) GetAllNonFungibleLockedAssets(ctx contractapi.TransactionContextInterface, lockRecipient string, locker string) ([]string, error) {
+func (amc *AssetManagementContract) GetAllNonFungibleLockedAssets(ctx contractapi.TransactionContextInterface, lockRecipient string, locker string) ([]LockedAsset, error) {
     return amc.assetManagement.GetAllNonFungibleLockedAssets(ctx.GetStub(), lockRecipient, locker)
 }
 
-func (amc *AssetManagementContract) GetAllFungibleLockedAssets(ctx contractapi.TransactionContextInterface, lockRecipient string, locker string) ([]string, error) {
+func (amc *AssetManagementContract) GetAllFungibleLockedAssets(ctx contractapi.TransactionContextInterface, lockRecipient string, locker string) ([]LockedAsset, error) {
     return amc.assetManagement.GetAllFungibleLockedAssets(ctx.GetStub(), lockRecipient, locker)
 }
 
@@ -599,7 +1064,16 @@ func (amc *AssetManagementContract) GetFungibleAssetTimeToRelease(ctx contractap
     return amc.assetManagement.GetFungibleAssetTimeToRelease(ctx.GetStub(), assetAgreement)
 }
 
-func (amc *AssetManagementContract) GetAllAssetsLockedUntil(ctx contractapi.TransactionContextInterface, lockExpiryTimeSecs uint64) ([]string, error) {
+func (amc *AssetManagementContract) GetHybridAssetTimeToRelease(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string) (uint64, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return 0, err
+    }
+
+    return amc.assetManagement.GetHybridAssetTimeToRelease(ctx.GetStub(), assetAgreement)
+}
+
+func (amc *AssetManagementContract) GetAllAssetsLockedUntil(ctx contractapi.TransactionContextInterface, lockExpiryTimeSecs uint64) ([]LockedAsset, error) {
     return amc.assetManagement.GetAllAssetsLockedUntil(ctx.GetStub(), lockExpiryTimeSecs)
 }
 
@@ -627,3 +1101,18 @@ func (amc *AssetManagementContract) GetHTLCHashPreImageByContractId(ctx contract
 	return amc.assetManagement.GetHTLCHashPreImageByContractId(ctx.GetStub(), contractId)
 }
 
+func (amc *AssetManagementContract) GetHybridHTLCHash(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string) (string, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return "", err
+    }
+    return amc.assetManagement.GetHybridHTLCHash(ctx.GetStub(), assetAgreement)
+}
+
+func (amc *AssetManagementContract) GetHybridHTLCHashPreImage(ctx contractapi.TransactionContextInterface, hybridAssetExchangeAgreementSerializedProto64 string) (string, error) {
+    assetAgreement, err := amc.ValidateAndExtractHybridAssetAgreement(hybridAssetExchangeAgreementSerializedProto64)
+    if err != nil {
+        return "", err
+    }
+    return amc.assetManagement.GetHybridHTLCHashPreImage(ctx.GetStub(), assetAgreement)
+}
@@ -7,11 +7,15 @@
 package assetmgmt_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/stretchr/testify/require"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	am "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/interfaces/asset-mgmt/v2"
 	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
 )
@@ -41,3 +45,158 @@ func TestContractIsFungibleAssetLocked(t *testing.T) {
 	require.False(t, isAssetLocked)
 	fmt.Printf("Test failed as expected with error: %+v\n", err)
 }
+
+func TestContractUnlockFungibleAssetBatch(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	amc := am.AssetManagementContract{}
+	amc.Configure(interopChaincodeId)
+
+	// Test failure under the scenario that one of the contractIds is empty
+	unlockSuccess, err := amc.UnlockFungibleAssetBatch(ctx, []string{"contract-id-1", ""})
+	require.EqualError(t, err, "empty contract id")
+	require.False(t, unlockSuccess)
+	require.Equal(t, 0, chaincodeStub.InvokeChaincodeCallCount())
+
+	// Test success: the contractIds are passed on as a JSON array and echoed in a single batch event
+	contractIds := []string{"contract-id-1", "contract-id-2"}
+	chaincodeStub.InvokeChaincodeReturns(shim.Success(nil))
+	unlockSuccess, err = amc.UnlockFungibleAssetBatch(ctx, contractIds)
+	require.NoError(t, err)
+	require.True(t, unlockSuccess)
+	_, iccArgs, _ := chaincodeStub.InvokeChaincodeArgsForCall(0)
+	require.Equal(t, "UnlockFungibleAssetBatch", string(iccArgs[0]))
+	require.Equal(t, `["contract-id-1","contract-id-2"]`, string(iccArgs[1]))
+	eventName, eventPayload := chaincodeStub.SetEventArgsForCall(0)
+	require.Equal(t, "UnlockFungibleAssetBatch", eventName)
+	eventContractIds := []string{}
+	require.NoError(t, json.Unmarshal(eventPayload, &eventContractIds))
+	require.Equal(t, contractIds, eventContractIds)
+}
+
+func TestContractLockHybridAsset(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	amc := am.AssetManagementContract{}
+	amc.Configure(interopChaincodeId)
+
+	assetAgreement := &common.HybridAssetExchangeAgreement{
+		AssetType: "bond",
+		Id:        "A001",
+		NumUnits:  10,
+		Recipient: "Bob",
+		Locker:    "Alice",
+	}
+	assetAgreementBytes, _ := proto.Marshal(assetAgreement)
+	lockInfoHTLC := &common.AssetLockHTLC{
+		HashMechanism: common.HashMechanism_SHA256,
+		HashBase64:    []byte(defaultHash),
+	}
+	lockInfoHTLCBytes, _ := proto.Marshal(lockInfoHTLC)
+	lockInfoBytes, _ := proto.Marshal(&common.AssetLock{
+		LockMechanism: common.LockMechanism_HTLC,
+		LockInfo:      lockInfoHTLCBytes,
+	})
+
+	// Test failure under the scenario that the agreement doesn't specify a number of units
+	zeroUnitsAgreementBytes, _ := proto.Marshal(&common.HybridAssetExchangeAgreement{
+		AssetType: "bond",
+		Id:        "A001",
+		Recipient: "Bob",
+	})
+	_, err := amc.LockHybridAsset(ctx, base64.StdEncoding.EncodeToString(zeroUnitsAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.EqualError(t, err, "invalid number of asset units")
+	require.Equal(t, 0, chaincodeStub.InvokeChaincodeCallCount())
+	require.Equal(t, 0, chaincodeStub.SetEventCallCount())
+
+	// Test success: the lock event carries both the asset ID and the number of units
+	chaincodeStub.InvokeChaincodeReturns(shim.Success([]byte("contract-id-1")))
+	contractId, err := amc.LockHybridAsset(ctx, base64.StdEncoding.EncodeToString(assetAgreementBytes), base64.StdEncoding.EncodeToString(lockInfoBytes))
+	require.NoError(t, err)
+	require.Equal(t, "contract-id-1", contractId)
+	_, iccArgs, _ := chaincodeStub.InvokeChaincodeArgsForCall(0)
+	require.Equal(t, "LockHybridAsset", string(iccArgs[0]))
+	eventName, eventPayload := chaincodeStub.SetEventArgsForCall(0)
+	require.Equal(t, "LockHybridAsset", eventName)
+	lockEvent := &common.AssetLockEvent{}
+	require.NoError(t, proto.Unmarshal(eventPayload, lockEvent))
+	require.Equal(t, common.AssetLockEventType_LOCKED, lockEvent.EventType)
+	require.Equal(t, "contract-id-1", lockEvent.ContractId)
+	require.Equal(t, "A001", lockEvent.AssetId)
+	require.Equal(t, uint64(10), lockEvent.NumUnits)
+}
+
+func TestContractLockAmendment(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	amc := am.AssetManagementContract{}
+	amc.Configure(interopChaincodeId)
+	recipientSignatureBase64 := base64.StdEncoding.EncodeToString([]byte("recipient-signature"))
+
+	// Test failure under the scenario that the recipient signature is not base64 encoded
+	_, err := amc.ExtendLockExpiry(ctx, "contract-id-1", 1000, "not-base64!")
+	require.Error(t, err)
+	require.Equal(t, 0, chaincodeStub.InvokeChaincodeCallCount())
+
+	// Test success: the decoded signature is passed on in base64 form, and the event carries the new expiry
+	chaincodeStub.InvokeChaincodeReturns(shim.Success(nil))
+	extendSuccess, err := amc.ExtendLockExpiry(ctx, "contract-id-1", 1000, recipientSignatureBase64)
+	require.NoError(t, err)
+	require.True(t, extendSuccess)
+	_, iccArgs, _ := chaincodeStub.InvokeChaincodeArgsForCall(0)
+	require.Equal(t, []string{"ExtendLockExpiry", "contract-id-1", "1000", recipientSignatureBase64}, []string{string(iccArgs[0]), string(iccArgs[1]), string(iccArgs[2]), string(iccArgs[3])})
+	eventName, eventPayload := chaincodeStub.SetEventArgsForCall(0)
+	require.Equal(t, "ExtendLockExpiry", eventName)
+	lockEvent := &common.AssetLockEvent{}
+	require.NoError(t, proto.Unmarshal(eventPayload, lockEvent))
+	require.Equal(t, common.AssetLockEventType_EXTENDED, lockEvent.EventType)
+	require.Equal(t, "contract-id-1", lockEvent.ContractId)
+	require.Equal(t, uint64(1000), lockEvent.ExpiryTimeSecs)
+
+	// Test success cancelling the lock
+	cancelSuccess, err := amc.CancelLock(ctx, "contract-id-1", recipientSignatureBase64)
+	require.NoError(t, err)
+	require.True(t, cancelSuccess)
+	_, iccArgs, _ = chaincodeStub.InvokeChaincodeArgsForCall(1)
+	require.Equal(t, "CancelLock", string(iccArgs[0]))
+	eventName, eventPayload = chaincodeStub.SetEventArgsForCall(1)
+	require.Equal(t, "CancelLock", eventName)
+	require.NoError(t, proto.Unmarshal(eventPayload, lockEvent))
+	require.Equal(t, common.AssetLockEventType_CANCELLED, lockEvent.EventType)
+	require.Equal(t, "contract-id-1", lockEvent.ContractId)
+}
+
+func TestContractPartialClaimFungibleAsset(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	amc := am.AssetManagementContract{}
+	amc.Configure(interopChaincodeId)
+	claimInfoHTLCBytes, _ := proto.Marshal(&common.AssetClaimHTLC{
+		HashMechanism:      common.HashMechanism_SHA256,
+		HashPreimageBase64: []byte("preimage"),
+	})
+	claimInfoBytes, _ := proto.Marshal(&common.AssetClaim{
+		LockMechanism: common.LockMechanism_HTLC,
+		ClaimInfo:     claimInfoHTLCBytes,
+	})
+	claimInfoBase64 := base64.StdEncoding.EncodeToString(claimInfoBytes)
+
+	// Test success: the event of a claim leaving some units locked is told apart from that of a full claim
+	chaincodeStub.InvokeChaincodeReturns(shim.Success([]byte("70")))
+	remainingUnits, err := amc.PartialClaimFungibleAsset(ctx, "contract-id-1", 30, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(70), remainingUnits)
+	eventName, eventPayload := chaincodeStub.SetEventArgsForCall(0)
+	require.Equal(t, "PartialClaimFungibleAsset", eventName)
+	claimEvent := &common.AssetLockEvent{}
+	require.NoError(t, proto.Unmarshal(eventPayload, claimEvent))
+	require.Equal(t, common.AssetLockEventType_PARTIALLY_CLAIMED, claimEvent.EventType)
+	require.Equal(t, "contract-id-1", claimEvent.ContractId)
+	require.Equal(t, uint64(30), claimEvent.NumUnits)
+
+	// Test success claiming the remaining units, which is reported as a full claim
+	chaincodeStub.InvokeChaincodeReturns(shim.Success([]byte("0")))
+	remainingUnits, err = amc.PartialClaimFungibleAsset(ctx, "contract-id-1", 70, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), remainingUnits)
+	_, eventPayload = chaincodeStub.SetEventArgsForCall(1)
+	require.NoError(t, proto.Unmarshal(eventPayload, claimEvent))
+	require.Equal(t, common.AssetLockEventType_CLAIMED, claimEvent.EventType)
+	require.Equal(t, uint64(70), claimEvent.NumUnits)
+}
@@ -38,6 +38,8 @@ type InteropCC struct {
     assetLockMap map[string]string
     fungibleAssetLockMap map[string]string
     fungibleAssetLockedCount map[string]int
+    hybridAssetLockMap map[string]string
+    fungibleAssetClaimedUnits map[string]uint64
 }
 
 func (cc *InteropCC) Init(stub shim.ChaincodeStubInterface) pb.Response {
@@ -45,6 +47,8 @@ func (cc *InteropCC) Init(stub shim.ChaincodeStubInterface) pb.Response {
     cc.assetLockMap = make(map[string]string)
     cc.fungibleAssetLockMap = make(map[string]string)
     cc.fungibleAssetLockedCount = make(map[string]int)
+    cc.hybridAssetLockMap = make(map[string]string)
+    cc.fungibleAssetClaimedUnits = make(map[string]uint64)
     return shim.Success(nil)
 }
 
@@ -172,6 +176,23 @@ func (cc *InteropCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
             return shim.Error(fmt.Sprintf("No asset is locked associated with contractId %s", contractId))
 	}
     }
+    if function == "ExtendLockExpiry" || function == "CancelLock" {     // Recipient signatures are not verified here
+        contractId := args[0]
+        lockMap := cc.assetLockMap
+        if _, contractExists := cc.fungibleAssetLockMap[contractId]; contractExists {
+            lockMap = cc.fungibleAssetLockMap
+        } else if _, contractExists := cc.assetLockMap[contractId]; !contractExists {
+            return shim.Error(fmt.Sprintf("No asset is locked associated with contractId %s", contractId))
+        }
+        // caller need to be the locker
+        if strings.Split(lockMap[contractId], ":")[2] != string(caller) {
+            return shim.Error(fmt.Sprintf("asset associated with contractId %s is not locked by %s", contractId, string(caller)))
+        }
+        if function == "CancelLock" {
+            delete(lockMap, contractId)
+        }
+        return shim.Success(nil)
+    }
     if function == "ClaimAsset" {
         assetAgreement := &common.AssetExchangeAgreement{}
         arg0, _ := base64.StdEncoding.DecodeString(args[0])
@@ -202,6 +223,41 @@ func (cc *InteropCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
             return shim.Error(fmt.Sprintf("No fungible asset is locked associated with contractId %s", contractId))
 	}
     }
+    if function == "PartialClaimFungibleAsset" {
+        contractId := args[0]
+        numUnits, _ := strconv.ParseUint(args[1], 10, 64)
+        if _, contractExists := cc.fungibleAssetLockMap[contractId]; !contractExists {
+            return shim.Error(fmt.Sprintf("No fungible asset is locked associated with contractId %s", contractId))
+        }
+        assetLockValSplit := strings.Split(cc.fungibleAssetLockMap[contractId], ":")
+        // caller need to be the recipient
+        if assetLockValSplit[3] != string(caller) {
+            return shim.Error(fmt.Sprintf("cannot claim fungible asset using contractId %s as caller is different from recipient", contractId))
+        }
+        lockedUnits, _ := strconv.ParseUint(assetLockValSplit[1], 10, 64)
+        if numUnits > lockedUnits {
+            return shim.Error(fmt.Sprintf("cannot claim %d units of the fungible asset associated with contractId %s as %d units are locked", numUnits, contractId, lockedUnits))
+        }
+        lockedUnits -= numUnits
+        if lockedUnits == 0 {
+            delete(cc.fungibleAssetLockMap, contractId)
+            delete(cc.fungibleAssetClaimedUnits, contractId)
+        } else {
+            assetLockValSplit[1] = strconv.FormatUint(lockedUnits, 10)
+            cc.fungibleAssetLockMap[contractId] = strings.Join(assetLockValSplit, ":")
+            cc.fungibleAssetClaimedUnits[contractId] += numUnits
+        }
+        return shim.Success([]byte(strconv.FormatUint(lockedUnits, 10)))
+    }
+    if function == "GetFungibleAssetLockStatus" {
+        contractId := args[0]
+        if _, contractExists := cc.fungibleAssetLockMap[contractId]; !contractExists {
+            return shim.Error(fmt.Sprintf("contractId %s is not associated with any currently locked asset", contractId))
+        }
+        lockedUnits, _ := strconv.ParseUint(strings.Split(cc.fungibleAssetLockMap[contractId], ":")[1], 10, 64)
+        lockStatusBytes, _ := json.Marshal(am.FungibleAssetLockStatus{ContractId: contractId, IsLocked: true, LockedUnits: lockedUnits, ClaimedUnits: cc.fungibleAssetClaimedUnits[contractId]})
+        return shim.Success(lockStatusBytes)
+    }
     if function == "ClaimAssetUsingContractId" {
         contractId := args[0]
 	if _, contractExists := cc.assetLockMap[contractId]; contractExists {
@@ -216,29 +272,67 @@ func (cc *InteropCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
             return shim.Error(fmt.Sprintf("No asset is locked associated with contractId %s", contractId))
 	}
     }
+    if function == "LockAssetBatch" || function == "ClaimAssetBatch" || function == "UnlockAssetBatch" {   // All-or-none bookkeeping over the non-fungible asset locks
+        assetAgreementsBytes64 := []string{}
+        _ = json.Unmarshal([]byte(args[0]), &assetAgreementsBytes64)
+        updatedAssetLockMap := make(map[string]string)
+        for contractId, val := range cc.assetLockMap {
+            updatedAssetLockMap[contractId] = val
+        }
+        contractIds := []string{}
+        for _, assetAgreementBytes64 := range assetAgreementsBytes64 {
+            assetAgreement := &common.AssetExchangeAgreement{}
+            assetAgreementBytes, _ := base64.StdEncoding.DecodeString(assetAgreementBytes64)
+            _ = proto.Unmarshal(assetAgreementBytes, assetAgreement)
+            key := assetAgreement.AssetType + ":" + assetAgreement.Id
+            contractId := generateSHA256HashInBase64Form(key)
+            if function == "LockAssetBatch" {
+                if updatedAssetLockMap[contractId] != "" {
+                    return shim.Error(fmt.Sprintf("Asset of type %s and ID %s is already locked", assetAgreement.AssetType, assetAgreement.Id))
+                }
+                updatedAssetLockMap[contractId] = key + ":" + string(caller) + ":" + assetAgreement.Recipient
+            } else {
+                expectedVal := key + ":" + string(caller) + ":" + assetAgreement.Recipient
+                if function == "ClaimAssetBatch" {
+                    expectedVal = key + ":" + assetAgreement.Locker + ":" + string(caller)
+                }
+                if updatedAssetLockMap[contractId] != expectedVal {
+                    return shim.Error(fmt.Sprintf("No matching lock for asset of type %s and ID %s", assetAgreement.AssetType, assetAgreement.Id))
+                }
+                delete(updatedAssetLockMap, contractId)
+            }
+            contractIds = append(contractIds, contractId)
+        }
+        cc.assetLockMap = updatedAssetLockMap
+        if function == "LockAssetBatch" {
+            contractIdsJSON, _ := json.Marshal(contractIds)
+            return shim.Success(contractIdsJSON)
+        }
+        return shim.Success(nil)
+    }
     if function == "GetAllLockedAssets" || function == "GetAllAssetsLockedUntil" {
-        assets := []string{}
+        assets := []am.LockedAsset{}
         for key, val := range cc.assetLockMap {
-            assets = append(assets, key + ":" + val)
+            assets = append(assets, am.LockedAsset{ContractId: key, AssetType: strings.Split(val, ":")[0]})
         }
         for key, val := range cc.fungibleAssetLockMap {
-            assets = append(assets, key + ":" + val)
+            assets = append(assets, am.LockedAsset{ContractId: key, AssetType: strings.Split(val, ":")[0], IsFungible: true})
         }
         assetsBytes, _ := json.Marshal(assets)
         return shim.Success(assetsBytes)
     }
     if function == "GetAllNonFungibleLockedAssets" {
-        assets := []string{}
+        assets := []am.LockedAsset{}
         for key, val := range cc.assetLockMap {
-            assets = append(assets, key + ":" + val)
+            assets = append(assets, am.LockedAsset{ContractId: key, AssetType: strings.Split(val, ":")[0]})
         }
         assetsBytes, _ := json.Marshal(assets)
         return shim.Success(assetsBytes)
     }
     if function == "GetAllFungibleLockedAssets" {
-        assets := []string{}
+        assets := []am.LockedAsset{}
         for key, val := range cc.fungibleAssetLockMap {
-            assets = append(assets, key + ":" + val)
+            assets = append(assets, am.LockedAsset{ContractId: key, AssetType: strings.Split(val, ":")[0], IsFungible: true})
         }
         assetsBytes, _ := json.Marshal(assets)
         return shim.Success(assetsBytes)
@@ -261,6 +355,50 @@ func (cc *InteropCC) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
     if function == "GetHTLCHashPreImageByContractId" {
         return shim.Success([]byte(defaultPreimage))
     }
+    if function == "LockHybridAsset" || function == "IsHybridAssetLocked" || function == "ClaimHybridAsset" || function == "UnlockHybridAsset" {
+        assetAgreement := &common.HybridAssetExchangeAgreement{}
+        arg0, _ := base64.StdEncoding.DecodeString(args[0])
+        _ = proto.Unmarshal([]byte(arg0), assetAgreement)
+        key := assetAgreement.AssetType + ":" + assetAgreement.Id + ":" + strconv.FormatUint(assetAgreement.NumUnits, 10)
+        contractId := generateSHA256HashInBase64Form(key)
+        if function == "LockHybridAsset" {
+            if cc.hybridAssetLockMap[contractId] != "" {
+                return shim.Error(fmt.Sprintf("%d units of asset of type %s and ID %s are already locked", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id))
+            }
+            cc.hybridAssetLockMap[contractId] = key + ":" + string(caller) + ":" + assetAgreement.Recipient
+            return shim.Success([]byte(contractId))
+        }
+        if function == "IsHybridAssetLocked" {
+            // a blank locker or recipient stands for the caller
+            if len(assetAgreement.Locker) == 0 {
+                assetAgreement.Locker = string(caller)
+            }
+            if len(assetAgreement.Recipient) == 0 {
+                assetAgreement.Recipient = string(caller)
+            }
+            return shim.Success([]byte(strconv.FormatBool(cc.hybridAssetLockMap[contractId] == key + ":" + assetAgreement.Locker + ":" + assetAgreement.Recipient)))
+        }
+        expectedVal := key + ":" + string(caller) + ":" + assetAgreement.Recipient
+        if function == "ClaimHybridAsset" {
+            expectedVal = key + ":" + assetAgreement.Locker + ":" + string(caller)
+        }
+        if cc.hybridAssetLockMap[contractId] == "" {
+            return shim.Error(fmt.Sprintf("no %d units of asset of type %s and ID %s are locked", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id))
+        } else if cc.hybridAssetLockMap[contractId] != expectedVal {
+            return shim.Error(fmt.Sprintf("no matching lock for %d units of asset of type %s and ID %s", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id))
+        }
+        delete(cc.hybridAssetLockMap, contractId)
+        return shim.Success(nil)
+    }
+    if function == "GetHybridAssetTimeToRelease" {
+        return shim.Success([]byte(strconv.Itoa(len(cc.hybridAssetLockMap))))
+    }
+    if function == "GetHybridHTLCHash" {
+        return shim.Success([]byte(defaultHash))
+    }
+    if function == "GetHybridHTLCHashPreImage" {
+        return shim.Success([]byte(defaultPreimage))
+    }
     return shim.Error(fmt.Sprintf("Invalid invoke function name: %s", function))
 }
 
@@ -1303,6 +1441,161 @@ func TestFungibleAssetClaim(t *testing.T) {
     require.Equal(t, retrievedPreimage, string(hashPreimage))
 }
 
+func TestFungibleAssetPartialClaim(t *testing.T) {
+    amcc, amstub := createAssetMgmtCCInstance()
+    recipient := "Bob"
+    locker := clientId
+    claimInfoHTLC := &common.AssetClaimHTLC {
+        HashMechanism: common.HashMechanism_SHA256,
+        HashPreimageBase64: []byte(defaultPreimage),
+    }
+    claimInfoBytes, _ := proto.Marshal(claimInfoHTLC)
+    claimInfo := &common.AssetClaim {
+        LockMechanism: common.LockMechanism_HTLC,
+        ClaimInfo: claimInfoBytes,
+    }
+    assetAgreement := &common.FungibleAssetExchangeAgreement {
+        AssetType: "cbdc",
+        NumUnits: 1000,
+        Recipient: recipient,
+        Locker: locker,
+    }
+    lockInfoHTLC := &common.AssetLockHTLC {
+        HashBase64: []byte(defaultHash),
+        ExpiryTimeSecs: 0,
+    }
+    lockInfoBytes, _ := proto.Marshal(lockInfoHTLC)
+    lockInfo := &common.AssetLock {
+        LockMechanism: common.LockMechanism_HTLC,
+        LockInfo: lockInfoBytes,
+    }
+
+    // Test failure when interop CC is not set
+    remainingUnits, err := amcc.PartialClaimFungibleAsset(amstub, "contract-id", 100, claimInfo)
+    require.Error(t, err)
+    require.Equal(t, uint64(0), remainingUnits)
+
+    _, istub := associateInteropCCInstance(amcc, amstub)
+    contractId, err := amcc.LockFungibleAsset(amstub, assetAgreement, lockInfo)
+    require.NoError(t, err)
+
+    // Test failure when claiming no units
+    setCreator(amstub, recipient)
+    setCreator(istub, recipient)
+    _, err = amcc.PartialClaimFungibleAsset(amstub, contractId, 0, claimInfo)
+    require.EqualError(t, err, "invalid number of asset units")
+
+    // Claim a tranche, after which the remaining units stay locked
+    remainingUnits, err = amcc.PartialClaimFungibleAsset(amstub, contractId, 300, claimInfo)
+    require.NoError(t, err)
+    require.Equal(t, uint64(700), remainingUnits)
+    lockSuccess, err := amcc.IsFungibleAssetLocked(amstub, contractId)
+    require.NoError(t, err)
+    require.True(t, lockSuccess)
+    lockStatus, err := amcc.GetFungibleAssetLockStatus(amstub, contractId)
+    require.NoError(t, err)
+    require.Equal(t, am.FungibleAssetLockStatus{ContractId: contractId, IsLocked: true, LockedUnits: 700, ClaimedUnits: 300}, lockStatus)
+
+    // Test failure when claiming more units than are locked
+    _, err = amcc.PartialClaimFungibleAsset(amstub, contractId, 701, claimInfo)
+    require.Error(t, err)
+
+    // Claim the remaining units, which removes the lock
+    remainingUnits, err = amcc.PartialClaimFungibleAsset(amstub, contractId, 700, claimInfo)
+    require.NoError(t, err)
+    require.Equal(t, uint64(0), remainingUnits)
+    lockSuccess, err = amcc.IsFungibleAssetLocked(amstub, contractId)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+    _, err = amcc.GetFungibleAssetLockStatus(amstub, contractId)
+    require.Error(t, err)
+}
+
+func TestLockAmendment(t *testing.T) {
+    amcc, amstub := createAssetMgmtCCInstance()
+    recipient := "Bob"
+    locker := clientId
+    recipientSignature := []byte("recipient-signature")
+    newExpiryTimeSecs := uint64(time.Now().Add(time.Hour).Unix())
+    assetAgreement := &common.AssetExchangeAgreement {
+        AssetType: "bond",
+        Id: "A001",
+        Recipient: recipient,
+        Locker: locker,
+    }
+    fungibleAssetAgreement := &common.FungibleAssetExchangeAgreement {
+        AssetType: "cbdc",
+        NumUnits: 1000,
+        Recipient: recipient,
+        Locker: locker,
+    }
+    lockInfoHTLC := &common.AssetLockHTLC {
+        HashBase64: []byte(defaultHash),
+        ExpiryTimeSecs: 0,
+    }
+    lockInfoBytes, _ := proto.Marshal(lockInfoHTLC)
+    lockInfo := &common.AssetLock {
+        LockMechanism: common.LockMechanism_HTLC,
+        LockInfo: lockInfoBytes,
+    }
+
+    // Test failure when interop CC is not set
+    extendSuccess, err := amcc.ExtendLockExpiry(amstub, "contract-id", newExpiryTimeSecs, recipientSignature)
+    require.Error(t, err)
+    require.False(t, extendSuccess)
+    cancelSuccess, err := amcc.CancelLock(amstub, "contract-id", recipientSignature)
+    require.Error(t, err)
+    require.False(t, cancelSuccess)
+
+    _, istub := associateInteropCCInstance(amcc, amstub)
+    contractId, err := amcc.LockAsset(amstub, assetAgreement, lockInfo)
+    require.NoError(t, err)
+    fungibleContractId, err := amcc.LockFungibleAsset(amstub, fungibleAssetAgreement, lockInfo)
+    require.NoError(t, err)
+
+    // Test failures when the contractId or the recipient signature is missing
+    _, err = amcc.ExtendLockExpiry(amstub, "", newExpiryTimeSecs, recipientSignature)
+    require.EqualError(t, err, "contractId cannot be empty")
+    _, err = amcc.ExtendLockExpiry(amstub, contractId, newExpiryTimeSecs, []byte{})
+    require.EqualError(t, err, "empty recipient signature")
+    _, err = amcc.CancelLock(amstub, contractId, nil)
+    require.EqualError(t, err, "empty recipient signature")
+
+    // Test failures when the caller is not the locker
+    setCreator(amstub, recipient)
+    setCreator(istub, recipient)
+    _, err = amcc.ExtendLockExpiry(amstub, contractId, newExpiryTimeSecs, recipientSignature)
+    require.Error(t, err)
+    _, err = amcc.CancelLock(amstub, fungibleContractId, recipientSignature)
+    require.Error(t, err)
+
+    // Extend and cancel the locks as the locker
+    setCreator(amstub, locker)
+    setCreator(istub, locker)
+    extendSuccess, err = amcc.ExtendLockExpiry(amstub, contractId, newExpiryTimeSecs, recipientSignature)
+    require.NoError(t, err)
+    require.True(t, extendSuccess)
+    extendSuccess, err = amcc.ExtendLockExpiry(amstub, fungibleContractId, newExpiryTimeSecs, recipientSignature)
+    require.NoError(t, err)
+    require.True(t, extendSuccess)
+    cancelSuccess, err = amcc.CancelLock(amstub, contractId, recipientSignature)
+    require.NoError(t, err)
+    require.True(t, cancelSuccess)
+    lockSuccess, err := amcc.IsAssetLocked(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+    cancelSuccess, err = amcc.CancelLock(amstub, fungibleContractId, recipientSignature)
+    require.NoError(t, err)
+    require.True(t, cancelSuccess)
+    lockSuccess, err = amcc.IsFungibleAssetLocked(amstub, fungibleContractId)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+
+    // Test failure when the lock no longer exists
+    _, err = amcc.CancelLock(amstub, contractId, recipientSignature)
+    require.Error(t, err)
+}
+
 func TestFungibleAssetCountFunctions(t *testing.T) {
     amcc, amstub := createAssetMgmtCCInstance()
     assetType := "cbdc"
@@ -1628,3 +1921,266 @@ func TestAssetTimeFunctions(t *testing.T) {
     require.NoError(t, err)
     require.Equal(t, 2, len(getListSuccess))
 }
+
+func TestAssetBatch(t *testing.T) {
+    amcc, amstub := createAssetMgmtCCInstance()
+    assetType := "bond"
+    recipient := "Bob"
+    locker := clientId
+    hash := []byte("MBQGA1UEBxMNU2FuIEZyYW5jaXNjbzEPMA0GA1UECxMGY2xpZW50MSQwIgYDVQQD")
+    hashPreimage := []byte("YW5jaXNjbzEeMBwGA1UE")
+    assetAgreements := []*common.AssetExchangeAgreement{
+        &common.AssetExchangeAgreement {
+            AssetType: assetType,
+            Id: "A001",
+            Recipient: recipient,
+            Locker: locker,
+        },
+        &common.AssetExchangeAgreement {
+            AssetType: assetType,
+            Id: "A002",
+            Recipient: recipient,
+            Locker: locker,
+        },
+    }
+    lockInfoHTLC := &common.AssetLockHTLC {
+        HashMechanism: common.HashMechanism_SHA256,
+        HashBase64: hash,
+        ExpiryTimeSecs: 0,
+    }
+    lockInfoBytes, _ := proto.Marshal(lockInfoHTLC)
+    lockInfo := &common.AssetLock {
+        LockMechanism: common.LockMechanism_HTLC,
+        LockInfo: lockInfoBytes,
+    }
+    lockInfos := []*common.AssetLock{ lockInfo, lockInfo }
+    claimInfoHTLC := &common.AssetClaimHTLC {
+        HashMechanism: common.HashMechanism_SHA256,
+        HashPreimageBase64: hashPreimage,
+    }
+    claimInfoBytes, _ := proto.Marshal(claimInfoHTLC)
+    claimInfo := &common.AssetClaim {
+        LockMechanism: common.LockMechanism_HTLC,
+        ClaimInfo: claimInfoBytes,
+    }
+    claimInfos := []*common.AssetClaim{ claimInfo, claimInfo }
+
+    // Test failure when interop CC is not set
+    contractIds, err := amcc.LockAssetBatch(amstub, assetAgreements, lockInfos)
+    require.Error(t, err)
+    require.Equal(t, 0, len(contractIds))
+
+    _, istub := associateInteropCCInstance(amcc, amstub)
+
+    // Test failure when the number of agreements and lock infos differ
+    contractIds, err = amcc.LockAssetBatch(amstub, assetAgreements, lockInfos[:1])
+    require.Error(t, err)
+    require.Equal(t, 0, len(contractIds))
+
+    // Test failure when any agreement in the batch is invalid
+    assetAgreements[1].Recipient = ""
+    contractIds, err = amcc.LockAssetBatch(amstub, assetAgreements, lockInfos)
+    require.Error(t, err)
+    require.Equal(t, 0, len(contractIds))
+    assetAgreements[1].Recipient = recipient
+
+    // Test success
+    contractIds, err = amcc.LockAssetBatch(amstub, assetAgreements, lockInfos)
+    require.NoError(t, err)
+    require.Equal(t, 2, len(contractIds))
+    for _, assetAgreement := range assetAgreements {
+        lockSuccess, err := amcc.IsAssetLocked(amstub, assetAgreement)
+        require.NoError(t, err)
+        require.True(t, lockSuccess)
+    }
+
+    // Test failure when locking a batch with an already locked asset: no asset in the batch is locked
+    newAssetAgreement := &common.AssetExchangeAgreement {
+        AssetType: assetType,
+        Id: "A003",
+        Recipient: recipient,
+        Locker: locker,
+    }
+    contractIds, err = amcc.LockAssetBatch(amstub, []*common.AssetExchangeAgreement{ newAssetAgreement, assetAgreements[0] }, lockInfos)
+    require.Error(t, err)
+    require.Equal(t, 0, len(contractIds))
+    lockSuccess, err := amcc.IsAssetLocked(amstub, newAssetAgreement)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+
+    // Test failure when claiming a batch with an unlocked asset: all the assets remain locked
+    setCreator(amstub, recipient)
+    setCreator(istub, recipient)
+    claimSuccess, err := amcc.ClaimAssetBatch(amstub, []*common.AssetExchangeAgreement{ assetAgreements[0], newAssetAgreement }, claimInfos)
+    require.Error(t, err)
+    require.False(t, claimSuccess)
+    lockSuccess, err = amcc.IsAssetLocked(amstub, assetAgreements[0])
+    require.NoError(t, err)
+    require.True(t, lockSuccess)
+
+    // Claim all the assets in the batch
+    claimSuccess, err = amcc.ClaimAssetBatch(amstub, assetAgreements, claimInfos)
+    require.NoError(t, err)
+    require.True(t, claimSuccess)
+    for _, assetAgreement := range assetAgreements {
+        lockSuccess, err = amcc.IsAssetLocked(amstub, assetAgreement)
+        require.NoError(t, err)
+        require.False(t, lockSuccess)
+    }
+    setCreator(amstub, locker)
+    setCreator(istub, locker)
+
+    // Lock and unlock a batch
+    contractIds, err = amcc.LockAssetBatch(amstub, assetAgreements, lockInfos)
+    require.NoError(t, err)
+    require.Equal(t, 2, len(contractIds))
+    unlockSuccess, err := amcc.UnlockAssetBatch(amstub, assetAgreements)
+    require.NoError(t, err)
+    require.True(t, unlockSuccess)
+    for _, assetAgreement := range assetAgreements {
+        lockSuccess, err = amcc.IsAssetLocked(amstub, assetAgreement)
+        require.NoError(t, err)
+        require.False(t, lockSuccess)
+    }
+}
+
+func TestHybridAsset(t *testing.T) {
+    amcc, amstub := createAssetMgmtCCInstance()
+    recipient := "Bob"
+    locker := clientId
+    assetAgreement := &common.HybridAssetExchangeAgreement {
+        AssetType: "bond",
+        Id: "A001",
+        NumUnits: 10,
+        Recipient: recipient,
+        Locker: locker,
+    }
+    lockInfoHTLC := &common.AssetLockHTLC {
+        HashMechanism: common.HashMechanism_SHA256,
+        HashBase64: []byte(defaultHash),
+        ExpiryTimeSecs: 0,
+    }
+    lockInfoBytes, _ := proto.Marshal(lockInfoHTLC)
+    lockInfo := &common.AssetLock {
+        LockMechanism: common.LockMechanism_HTLC,
+        LockInfo: lockInfoBytes,
+    }
+    claimInfoHTLC := &common.AssetClaimHTLC {
+        HashMechanism: common.HashMechanism_SHA256,
+        HashPreimageBase64: []byte(defaultPreimage),
+    }
+    claimInfoBytes, _ := proto.Marshal(claimInfoHTLC)
+    claimInfo := &common.AssetClaim {
+        LockMechanism: common.LockMechanism_HTLC,
+        ClaimInfo: claimInfoBytes,
+    }
+
+    // Test failure when interop CC is not set
+    contractId, err := amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.Error(t, err)
+    require.Equal(t, "", contractId)
+
+    _, istub := associateInteropCCInstance(amcc, amstub)
+
+    // Test failures with an invalid hybrid asset agreement
+    assetAgreement.Id = ""
+    contractId, err = amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.EqualError(t, err, "empty asset ID")
+    require.Equal(t, "", contractId)
+    assetAgreement.Id = "A001"
+    assetAgreement.NumUnits = 0
+    contractId, err = amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.EqualError(t, err, "invalid number of asset units")
+    require.Equal(t, "", contractId)
+    assetAgreement.NumUnits = 10
+    assetAgreement.Recipient = ""
+    contractId, err = amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.EqualError(t, err, "empty lock recipient")
+    require.Equal(t, "", contractId)
+    assetAgreement.Recipient = recipient
+
+    // Test success
+    contractId, err = amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.NoError(t, err)
+    require.NotEqual(t, "", contractId)
+    lockSuccess, err := amcc.IsHybridAssetLocked(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.True(t, lockSuccess)
+
+    // Test failure when locking the same units of the asset again
+    _, err = amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.Error(t, err)
+
+    // A different quantity of the same asset is a different lock
+    otherAgreement := &common.HybridAssetExchangeAgreement {
+        AssetType: assetAgreement.AssetType,
+        Id: assetAgreement.Id,
+        NumUnits: 5,
+        Recipient: recipient,
+        Locker: locker,
+    }
+    lockSuccess, err = amcc.IsHybridAssetLocked(amstub, otherAgreement)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+
+    // Queries pass a blank locker on to the interop chaincode, which stands for the caller
+    queryAgreement := &common.HybridAssetExchangeAgreement {
+        AssetType: assetAgreement.AssetType,
+        Id: assetAgreement.Id,
+        NumUnits: assetAgreement.NumUnits,
+        Recipient: recipient,
+    }
+    lockSuccess, err = amcc.IsHybridAssetLocked(amstub, queryAgreement)
+    require.NoError(t, err)
+    require.True(t, lockSuccess)
+    require.Empty(t, queryAgreement.Locker)
+    timeToRelease, err := amcc.GetHybridAssetTimeToRelease(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.Equal(t, uint64(1), timeToRelease)
+    hash, err := amcc.GetHybridHTLCHash(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.Equal(t, defaultHash, hash)
+
+    // Test failure when a query names the caller as both locker and recipient
+    _, err = amcc.IsHybridAssetLocked(amstub, &common.HybridAssetExchangeAgreement {
+        AssetType: assetAgreement.AssetType,
+        Id: assetAgreement.Id,
+        NumUnits: assetAgreement.NumUnits,
+    })
+    require.EqualError(t, err, "invalid query: locker identical to recipient")
+
+    // Test failure when claiming by someone other than the recipient
+    claimSuccess, err := amcc.ClaimHybridAsset(amstub, assetAgreement, claimInfo)
+    require.Error(t, err)
+    require.False(t, claimSuccess)
+
+    // Claim as the recipient
+    setCreator(amstub, recipient)
+    setCreator(istub, recipient)
+    claimSuccess, err = amcc.ClaimHybridAsset(amstub, assetAgreement, claimInfo)
+    require.NoError(t, err)
+    require.True(t, claimSuccess)
+    preimage, err := amcc.GetHybridHTLCHashPreImage(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.Equal(t, defaultPreimage, preimage)
+    lockSuccess, err = amcc.IsHybridAssetLocked(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+    setCreator(amstub, locker)
+    setCreator(istub, locker)
+
+    // Lock and unlock
+    _, err = amcc.LockHybridAsset(amstub, assetAgreement, lockInfo)
+    require.NoError(t, err)
+    unlockSuccess, err := amcc.UnlockHybridAsset(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.True(t, unlockSuccess)
+    lockSuccess, err = amcc.IsHybridAssetLocked(amstub, assetAgreement)
+    require.NoError(t, err)
+    require.False(t, lockSuccess)
+
+    // Test failure when unlocking an asset that is not locked
+    unlockSuccess, err = amcc.UnlockHybridAsset(amstub, assetAgreement)
+    require.Error(t, err)
+    require.False(t, unlockSuccess)
+}
@@ -12,7 +12,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"time"
+	"math"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
@@ -42,47 +44,59 @@ func LockAsset(ctx contractapi.TransactionContextInterface, callerChaincodeID, a
 		return "", logThenErrorf("error in locker validation: %+v", err)
 	}
 
-	lockInfo, expiryTimeSecs, err := getLockInfoAndExpiryTimeSecs(lockInfoBytesBase64)
+	assetLockKey, contractId, err := GenerateAssetLockKeyAndContractId(ctx, callerChaincodeID, assetAgreement)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
 
-	assetLockKey, contractId, err := GenerateAssetLockKeyAndContractId(ctx, callerChaincodeID, assetAgreement)
+	err = lockAssetCommon(ctx, callerChaincodeID, assetAgreement.Locker, assetAgreement.Recipient, assetLockKey, contractId, lockInfoBytesBase64,
+		fmt.Sprintf("asset of type %s and ID %s is already locked", assetAgreement.AssetType, assetAgreement.Id))
 	if err != nil {
-		return "", logThenErrorf(err.Error())
+		return "", err
+	}
+	return contractId, nil
+}
+
+// function to record the lock of a non-fungible asset (or of a number of units of a hybrid asset), under the given
+// asset-lock key and contractId, along with the map from the contractId to the asset-lock key
+func lockAssetCommon(ctx contractapi.TransactionContextInterface, callerChaincodeID, locker, recipient, assetLockKey, contractId, lockInfoBytesBase64, alreadyLockedMessage string) error {
+	lockInfo, timeSpec, expiryTimeSecs, err := getLockInfoAndExpiryTimeSecs(ctx, lockInfoBytesBase64)
+	if err != nil {
+		return logThenErrorf(err.Error())
 	}
 
-	assetLockVal := AssetLockValue{ContractId: contractId, Locker: assetAgreement.Locker, Recipient: assetAgreement.Recipient, LockInfo: lockInfo, ExpiryTimeSecs: expiryTimeSecs}
+	assetLockVal := AssetLockValue{ContractId: contractId, Locker: locker, Recipient: recipient, LockInfo: lockInfo, ExpiryTimeSecs: expiryTimeSecs, TimeSpec: timeSpec}
 
 	assetLockValBytes, err := ctx.GetStub().GetState(assetLockKey)
 	if err != nil {
-		return "", logThenErrorf(err.Error())
+		return logThenErrorf(err.Error())
 	}
 
 	if assetLockValBytes != nil {
-		return "", logThenErrorf("asset of type %s and ID %s is already locked", assetAgreement.AssetType, assetAgreement.Id)
+		return logThenErrorf("%s", alreadyLockedMessage)
 	}
 
 	assetLockValBytes, err = json.Marshal(assetLockVal)
 	if err != nil {
-		return "", logThenErrorf("marshal error: %+v", err)
+		return logThenErrorf("marshal error: %+v", err)
 	}
 
 	err = ctx.GetStub().PutState(assetLockKey, assetLockValBytes)
 	if err != nil {
-		return "", logThenErrorf(err.Error())
+		return logThenErrorf(err.Error())
 	}
 
 	assetLockKeyBytes, err := json.Marshal(assetLockKey)
 	if err != nil {
-		return "", logThenErrorf("marshal error: %+v", err)
+		return logThenErrorf("marshal error: %+v", err)
 	}
 
 	err = ctx.GetStub().PutState(generateContractIdMapKey(contractId), assetLockKeyBytes)
 	if err != nil {
-		return "", logThenErrorf(err.Error())
+		return logThenErrorf(err.Error())
 	}
-	return contractId, nil
+
+	return recordAssetLockTransition(ctx, callerChaincodeID, common.AssetLockEventType_LOCKED, contractId, assetLockKey, assetLockVal)
 }
 
 // LockFungibleAsset cc is used to record locking of a group of fungible assets of an asset-type on the ledger
@@ -107,7 +121,7 @@ func LockFungibleAsset(ctx contractapi.TransactionContextInterface, callerChainc
 		return "", logThenErrorf("error in locker validation: %+v", err)
 	}
 
-	lockInfo, expiryTimeSecs, err := getLockInfoAndExpiryTimeSecs(lockInfoBytesBase64)
+	lockInfo, timeSpec, expiryTimeSecs, err := getLockInfoAndExpiryTimeSecs(ctx, lockInfoBytesBase64)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
@@ -116,7 +130,7 @@ func LockFungibleAsset(ctx contractapi.TransactionContextInterface, callerChainc
 	contractId := GenerateFungibleAssetLockContractId(ctx, callerChaincodeID, assetAgreement)
 
 	assetLockVal := FungibleAssetLockValue{Type: assetAgreement.AssetType, NumUnits: assetAgreement.NumUnits, Locker: assetAgreement.Locker,
-		Recipient: assetAgreement.Recipient, LockInfo: lockInfo, ExpiryTimeSecs: expiryTimeSecs}
+		Recipient: assetAgreement.Recipient, LockInfo: lockInfo, ExpiryTimeSecs: expiryTimeSecs, TimeSpec: timeSpec}
 
 	assetLockValBytes, err := ctx.GetStub().GetState(contractId)
 	if err != nil {
@@ -137,6 +151,10 @@ func LockFungibleAsset(ctx contractapi.TransactionContextInterface, callerChainc
 		return "", logThenErrorf("failed to write to the world state: %+v", err)
 	}
 
+	err = recordAssetLockTransition(ctx, callerChaincodeID, common.AssetLockEventType_LOCKED, contractId, "", assetLockVal)
+	if err != nil {
+		return "", err
+	}
 	return contractId, nil
 }
 
@@ -186,7 +204,16 @@ func ClaimAsset(ctx contractapi.TransactionContextInterface, callerChaincodeID,
 		return "", logThenErrorf("cannot claim asset of type %s and ID %s as it is locked by %s for %s", assetAgreement.AssetType, assetAgreement.Id, assetLockVal.Locker, assetLockVal.Recipient)
 	}
 
-	return assetLockVal.ContractId, claimAssetCommon(ctx, assetLockVal.LockInfo, assetLockVal.ExpiryTimeSecs, assetLockVal.Recipient, assetLockKey, assetLockVal.ContractId, claimInfoBytesBase64)
+	err = claimAssetCommon(ctx, assetLockVal.LockInfo, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Recipient, assetLockKey, assetLockVal.ContractId, claimInfoBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	err = recordAssetLockTransition(ctx, "", common.AssetLockEventType_CLAIMED, assetLockVal.ContractId, assetLockKey, assetLockVal)
+	if err != nil {
+		return "", err
+	}
+	return assetLockVal.ContractId, nil
 }
 
 // ClaimFungibleAsset cc is used to record claim of a fungible asset on the ledger
@@ -197,7 +224,53 @@ func ClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId,
 		return logThenErrorf(err.Error())
 	}
 	
-	return claimAssetCommon(ctx, assetLockVal.LockInfo, assetLockVal.ExpiryTimeSecs, assetLockVal.Recipient, "", contractId, claimInfoBytesBase64)
+	err = claimAssetCommon(ctx, assetLockVal.LockInfo, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Recipient, "", contractId, claimInfoBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	return recordAssetLockTransition(ctx, "", common.AssetLockEventType_CLAIMED, contractId, "", assetLockVal)
+}
+
+// PartialClaimFungibleAsset cc is used to record claim of some of the units of a group of fungible assets locked on
+// the ledger; the remaining units stay locked under the same contractId, and their number is returned
+func PartialClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string, numUnits uint64, claimInfoBytesBase64 string) (uint64, error) {
+
+	assetLockVal, err := fetchFungibleAssetLocked(ctx, contractId)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	if numUnits == 0 || numUnits > assetLockVal.NumUnits {
+		return 0, logThenErrorf("cannot claim %d units of the fungible asset associated with contractId %s as %d units are locked", numUnits, contractId, assetLockVal.NumUnits)
+	}
+
+	// claiming all the remaining units is a regular claim, which removes the lock
+	if numUnits == assetLockVal.NumUnits {
+		return 0, ClaimFungibleAsset(ctx, contractId, claimInfoBytesBase64)
+	}
+
+	err = validateClaim(ctx, assetLockVal.LockInfo, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Recipient, contractId, claimInfoBytesBase64)
+	if err != nil {
+		return 0, err
+	}
+
+	assetLockVal.NumUnits -= numUnits
+	assetLockVal.ClaimedUnits += numUnits
+	assetLockValBytes, err := json.Marshal(assetLockVal)
+	if err != nil {
+		return 0, logThenErrorf("marshal error: %s", err)
+	}
+	err = ctx.GetStub().PutState(generateContractIdMapKey(contractId), assetLockValBytes)
+	if err != nil {
+		return 0, logThenErrorf("failed to write to the world state: %+v", err)
+	}
+
+	err = recordFungibleAssetPartialClaim(ctx, contractId, assetLockVal)
+	if err != nil {
+		return 0, err
+	}
+	return assetLockVal.NumUnits, nil
 }
 
 // ClaimAsset cc is used to record claim of an asset on the ledger (this uses the contractId)
@@ -208,12 +281,46 @@ func ClaimAssetUsingContractId(ctx contractapi.TransactionContextInterface, cont
 		return logThenErrorf(err.Error())
 	}
 	
-	return claimAssetCommon(ctx, assetLockVal.GetLockInfo(), assetLockVal.GetExpiryTimeSecs(), assetLockVal.GetRecipient(), assetLockKey, contractId, claimInfoBytesBase64)
+	err = claimAssetCommon(ctx, assetLockVal.GetLockInfo(), assetLockVal.GetTimeSpec(), assetLockVal.GetExpiryTimeSecs(), assetLockVal.GetRecipient(), assetLockKey, contractId, claimInfoBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	return recordAssetLockTransition(ctx, "", common.AssetLockEventType_CLAIMED, contractId, assetLockKey, assetLockVal)
 }
 
 // Common Claim function for both fungible and non-fungible assets, 
 // with or without contractId
-func claimAssetCommon(ctx contractapi.TransactionContextInterface, lockInfo interface{}, expiryTimeSecs uint64, recipient, assetLockKey, contractId, claimInfoBytesBase64 string) error {
+func claimAssetCommon(ctx contractapi.TransactionContextInterface, lockInfo interface{}, timeSpec common.TimeSpec, expiryTimeSecs uint64, recipient, assetLockKey, contractId, claimInfoBytesBase64 string) error {
+
+	err := validateClaim(ctx, lockInfo, timeSpec, expiryTimeSecs, recipient, contractId, claimInfoBytesBase64)
+	if err != nil {
+		return err
+	}
+
+	if assetLockKey != "" {
+		err := ctx.GetStub().DelState(assetLockKey)
+		if err != nil {
+			return logThenErrorf("failed to delete lock for the asset associated with the contractId %s: %+v", contractId, err)
+		}
+		
+		err = ctx.GetStub().PutState(generateAssetLockMapKey(assetLockKey), []byte(contractId))
+		if err != nil {
+			return logThenErrorf("failed to write to the world state: %+v", err)
+		}
+	}
+
+	err = ctx.GetStub().DelState(generateContractIdMapKey(contractId))
+	if err != nil {
+		return logThenErrorf("failed to delete the contractId %s as part of asset claim: %+v", contractId, err)
+	}
+
+	return nil
+}
+
+// function to check that the transaction creator can claim the asset associated with the contractId using the claim
+// info, recording the hash preimage on the ledger for HTLCs
+func validateClaim(ctx contractapi.TransactionContextInterface, lockInfo interface{}, timeSpec common.TimeSpec, expiryTimeSecs uint64, recipient, contractId, claimInfoBytesBase64 string) error {
 
 	txCreatorECertBase64, err := getECertOfTxCreatorBase64(ctx)
 	if err != nil {
@@ -230,8 +337,11 @@ func claimAssetCommon(ctx contractapi.TransactionContextInterface, lockInfo inte
 	}
 
 	// Check if expiry time is elapsed
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs >= expiryTimeSecs {
+	beforeExpiry, err := isBeforeExpiry(ctx, timeSpec, expiryTimeSecs)
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+	if !beforeExpiry {
 		return logThenErrorf("cannot claim asset associated with contractId %s as the expiry time is already elapsed", contractId)
 	}
 
@@ -254,25 +364,16 @@ func claimAssetCommon(ctx contractapi.TransactionContextInterface, lockInfo inte
 		if err != nil {
 			return logThenErrorf("failed to write to the world state: %+v", err)
 		}
-	}
-
-	if assetLockKey != "" {
-		err = ctx.GetStub().DelState(assetLockKey)
+	} else if claimInfo.LockMechanism == common.LockMechanism_SIGNATURE {
+		isApproved, err := validateApproverSignatures(claimInfo, lockInfo, contractId)
 		if err != nil {
-			return logThenErrorf("failed to delete lock for the asset associated with the contractId %s: %+v", contractId, err)
+			return logThenErrorf("claim asset associated with contractId %s failed with error: %v", contractId, err)
 		}
-		
-		err = ctx.GetStub().PutState(generateAssetLockMapKey(assetLockKey), []byte(contractId))
-		if err != nil {
-			return logThenErrorf("failed to write to the world state: %+v", err)
+		if !isApproved {
+			return logThenErrorf("cannot claim asset associated with contractId %s as it is not signed by enough approvers", contractId)
 		}
 	}
 
-	err = ctx.GetStub().DelState(generateContractIdMapKey(contractId))
-	if err != nil {
-		return logThenErrorf("failed to delete the contractId %s as part of asset claim: %+v", contractId, err)
-	}
-
 	return nil
 }
 
@@ -323,7 +424,16 @@ func UnlockAsset(ctx contractapi.TransactionContextInterface, callerChaincodeID,
 	}
 
 	// Check if expiry time is elapsed
-	return assetLockVal.ContractId, unlockAssetCommon(ctx, assetLockVal.ExpiryTimeSecs, assetLockVal.Locker, assetLockKey, assetLockVal.ContractId)
+	err = unlockAssetCommon(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Locker, assetLockKey, assetLockVal.ContractId)
+	if err != nil {
+		return "", err
+	}
+
+	err = recordAssetLockTransition(ctx, "", common.AssetLockEventType_UNLOCKED, assetLockVal.ContractId, assetLockKey, assetLockVal)
+	if err != nil {
+		return "", err
+	}
+	return assetLockVal.ContractId, nil
 }
 
 // UnlockFungibleAsset cc is used to record unlocking of a fungible asset on the ledger
@@ -334,7 +444,12 @@ func UnlockFungibleAsset(ctx contractapi.TransactionContextInterface, contractId
 		return logThenErrorf(err.Error())
 	}
 	
-	return unlockAssetCommon(ctx, assetLockVal.ExpiryTimeSecs, assetLockVal.Locker, "", contractId)
+	err = unlockAssetCommon(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Locker, "", contractId)
+	if err != nil {
+		return err
+	}
+
+	return recordAssetLockTransition(ctx, "", common.AssetLockEventType_UNLOCKED, contractId, "", assetLockVal)
 }
 
 // UnlockAssetUsingContractId cc is used to record unlocking of an asset on the ledger (this uses the contractId)
@@ -345,12 +460,17 @@ func UnlockAssetUsingContractId(ctx contractapi.TransactionContextInterface, con
 		return logThenErrorf(err.Error())
 	}
 	
-	return unlockAssetCommon(ctx, assetLockVal.GetExpiryTimeSecs(), assetLockVal.GetLocker(), assetLockKey, contractId)
+	err = unlockAssetCommon(ctx, assetLockVal.GetTimeSpec(), assetLockVal.GetExpiryTimeSecs(), assetLockVal.GetLocker(), assetLockKey, contractId)
+	if err != nil {
+		return err
+	}
+
+	return recordAssetLockTransition(ctx, "", common.AssetLockEventType_UNLOCKED, contractId, assetLockKey, assetLockVal)
 }
 
 // Common unlock functions for both fungible and non-fungible assets,
 // with or without contractId
-func unlockAssetCommon(ctx contractapi.TransactionContextInterface, expiryTimeSecs uint64, locker, assetLockKey, contractId string) error {
+func unlockAssetCommon(ctx contractapi.TransactionContextInterface, timeSpec common.TimeSpec, expiryTimeSecs uint64, locker, assetLockKey, contractId string) error {
 	
 	txCreatorECertBase64, err := getECertOfTxCreatorBase64(ctx)
 	if err != nil {
@@ -362,20 +482,28 @@ func unlockAssetCommon(ctx contractapi.TransactionContextInterface, expiryTimeSe
 		return logThenErrorf("asset is not locked for %s to unlock", txCreatorECertBase64)
 	}
 
-	// Check if expiry time is elapsed
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs < expiryTimeSecs {
+	// Check if expiry time (plus the grace window) is elapsed
+	expiryElapsed, err := isExpiryElapsed(ctx, timeSpec, expiryTimeSecs)
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+	if !expiryElapsed {
 		return logThenErrorf("cannot unlock asset associated with the contractId %s as the expiry time is not yet elapsed", contractId)
 	}
 
+	return deleteAssetLock(ctx, assetLockKey, contractId)
+}
+
+// function to delete the lock on an asset (non-fungible, fungible or hybrid) from the ledger
+func deleteAssetLock(ctx contractapi.TransactionContextInterface, assetLockKey, contractId string) error {
 	if assetLockKey != "" {
-		err = ctx.GetStub().DelState(assetLockKey)
+		err := ctx.GetStub().DelState(assetLockKey)
 		if err != nil {
 			return logThenErrorf("failed to delete lock for the asset associated with the contractId %s: %v", contractId, err)
 		}
 	}
 
-	err = ctx.GetStub().DelState(generateContractIdMapKey(contractId))
+	err := ctx.GetStub().DelState(generateContractIdMapKey(contractId))
 	if err != nil {
 		return logThenErrorf("failed to delete the contractId %s as part of asset unlock: %v", contractId, err)
 	}
@@ -383,6 +511,76 @@ func unlockAssetCommon(ctx contractapi.TransactionContextInterface, expiryTimeSe
 	return nil
 }
 
+// Lock Amendment Functions
+// ExtendLockExpiry cc is used by the locker to extend the expiry of a lock, with the agreement of the recipient
+func ExtendLockExpiry(ctx contractapi.TransactionContextInterface, contractId string, newExpiryTimeSecs uint64, recipientSignatureBase64 string) error {
+	assetLockKey, assetLockVal, err := fetchLockStateUsingContractId(ctx, contractId)
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	err = validateLockAmendment(ctx, assetLockVal, contractId, GenerateExtendLockExpiryMessage(contractId, newExpiryTimeSecs), recipientSignatureBase64)
+	if err != nil {
+		return err
+	}
+
+	// Check if expiry time (plus the grace window) is elapsed
+	expiryElapsed, err := isExpiryElapsed(ctx, assetLockVal.GetTimeSpec(), assetLockVal.GetExpiryTimeSecs())
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+	if expiryElapsed {
+		return logThenErrorf("cannot extend the expiry of the lock associated with the contractId %s as the expiry time is already elapsed", contractId)
+	}
+	previousExpiryTimeSecs := assetLockVal.GetExpiryTimeSecs()
+	if newExpiryTimeSecs <= previousExpiryTimeSecs {
+		return logThenErrorf("new expiry %d of the lock associated with the contractId %s is not later than its current expiry %d", newExpiryTimeSecs, contractId, previousExpiryTimeSecs)
+	}
+
+	var extendedAssetLockVal AssetLockInterface
+	var assetLockValKey string
+	switch lockVal := assetLockVal.(type) {
+	case AssetLockValue:
+		lockVal.ExpiryTimeSecs = newExpiryTimeSecs
+		extendedAssetLockVal, assetLockValKey = lockVal, assetLockKey
+	case FungibleAssetLockValue:
+		lockVal.ExpiryTimeSecs = newExpiryTimeSecs
+		extendedAssetLockVal, assetLockValKey = lockVal, generateContractIdMapKey(contractId)
+	default:
+		return logThenErrorf("unexpected lock type %T for the contractId %s", assetLockVal, contractId)
+	}
+	extendedAssetLockValBytes, err := json.Marshal(extendedAssetLockVal)
+	if err != nil {
+		return logThenErrorf("marshal error: %s", err)
+	}
+	err = ctx.GetStub().PutState(assetLockValKey, extendedAssetLockValBytes)
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	return recordAssetLockExtension(ctx, contractId, assetLockKey, extendedAssetLockVal, previousExpiryTimeSecs)
+}
+
+// CancelLock cc is used by the locker to release a lock before its expiry, with the agreement of the recipient
+func CancelLock(ctx contractapi.TransactionContextInterface, contractId string, recipientSignatureBase64 string) error {
+	assetLockKey, assetLockVal, err := fetchLockStateUsingContractId(ctx, contractId)
+	if err != nil {
+		return logThenErrorf(err.Error())
+	}
+
+	err = validateLockAmendment(ctx, assetLockVal, contractId, GenerateCancelLockMessage(contractId), recipientSignatureBase64)
+	if err != nil {
+		return err
+	}
+
+	err = deleteAssetLock(ctx, assetLockKey, contractId)
+	if err != nil {
+		return err
+	}
+
+	return recordAssetLockTransition(ctx, "", common.AssetLockEventType_CANCELLED, contractId, assetLockKey, assetLockVal)
+}
+
 // IsLocked Query Functions
 // IsAssetLocked cc is used to query the ledger and findout if an asset is locked or not
 func IsAssetLocked(ctx contractapi.TransactionContextInterface, callerChaincodeID, assetAgreementBytesBase64 string) (bool, error) {
@@ -422,8 +620,11 @@ func IsAssetLocked(ctx contractapi.TransactionContextInterface, callerChaincodeI
 	log.Infof("assetLockVal: %+v", assetLockVal)
 
 	// Check if expiry time is elapsed
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs >= assetLockVal.ExpiryTimeSecs {
+	beforeExpiry, err := isBeforeExpiry(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	if !beforeExpiry {
 		return false, nil
 	}
 
@@ -455,14 +656,40 @@ func IsFungibleAssetLocked(ctx contractapi.TransactionContextInterface, contract
 	}
 
 	// Check if expiry time is elapsed
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs >= assetLockVal.ExpiryTimeSecs {
+	beforeExpiry, err := isBeforeExpiry(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	if !beforeExpiry {
 		return false, nil
 	}
 
 	return true, nil
 }
 
+// GetFungibleAssetLockStatus cc is used to query the number of units of a fungible asset lock that are still locked,
+// and the number of those claimed so far
+func GetFungibleAssetLockStatus(ctx contractapi.TransactionContextInterface, contractId string) (*FungibleAssetLockStatus, error) {
+
+	assetLockVal, err := fetchFungibleAssetLocked(ctx, contractId)
+	if err != nil {
+		return nil, logThenErrorf(err.Error())
+	}
+
+	// Check if expiry time is elapsed
+	beforeExpiry, err := isBeforeExpiry(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs)
+	if err != nil {
+		return nil, logThenErrorf(err.Error())
+	}
+
+	return &FungibleAssetLockStatus{
+		ContractId:   contractId,
+		IsLocked:     beforeExpiry,
+		LockedUnits:  assetLockVal.NumUnits,
+		ClaimedUnits: assetLockVal.ClaimedUnits,
+	}, nil
+}
+
 // IsAssetLockedQueryUsingContractId cc is used to query the ledger and find out if an asset is locked or not (this uses the contractId)
 func IsAssetLockedQueryUsingContractId(ctx contractapi.TransactionContextInterface, contractId string) (bool, error) {
 
@@ -479,12 +706,324 @@ func IsAssetLockedQueryUsingContractId(ctx contractapi.TransactionContextInterfa
 	}
 
 	// Check if expiry time is elapsed
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs >= assetLockVal.GetExpiryTimeSecs() {
+	beforeExpiry, err := isBeforeExpiry(ctx, assetLockVal.GetTimeSpec(), assetLockVal.GetExpiryTimeSecs())
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	if !beforeExpiry {
 		return false, nil
 	}
 
 	return true, nil
 }
 
+// Batch Functions
+// All the operations in a batch are part of a single transaction, so either all of them get recorded or none does.
+// As the ledger reads in a transaction do not reflect its own writes, each batch must refer to distinct contracts.
+
+// LockAssetBatch cc is used to record locking of a list of assets on the ledger
+func LockAssetBatch(ctx contractapi.TransactionContextInterface, callerChaincodeID string, assetAgreementsBytesBase64, lockInfosBytesBase64 []string) ([]string, error) {
+	if len(assetAgreementsBytesBase64) != len(lockInfosBytesBase64) {
+		return []string{}, logThenErrorf("number of asset agreements (%d) and lock infos (%d) do not match", len(assetAgreementsBytesBase64), len(lockInfosBytesBase64))
+	}
+	contractIds := []string{}
+	for i := range assetAgreementsBytesBase64 {
+		contractId, err := LockAsset(ctx, callerChaincodeID, assetAgreementsBytesBase64[i], lockInfosBytesBase64[i])
+		if err != nil {
+			return []string{}, logThenErrorf("failed to lock asset %d of the batch: %s", i, err)
+		}
+		contractIds = append(contractIds, contractId)
+	}
+	err := checkDistinctContractIds(contractIds)
+	if err != nil {
+		return []string{}, err
+	}
+	return contractIds, nil
+}
+
+// LockFungibleAssetBatch cc is used to record locking of a list of groups of fungible assets on the ledger
+func LockFungibleAssetBatch(ctx contractapi.TransactionContextInterface, callerChaincodeID string, fungibleAssetAgreementsBytesBase64, lockInfosBytesBase64 []string) ([]string, error) {
+	if len(fungibleAssetAgreementsBytesBase64) != len(lockInfosBytesBase64) {
+		return []string{}, logThenErrorf("number of asset agreements (%d) and lock infos (%d) do not match", len(fungibleAssetAgreementsBytesBase64), len(lockInfosBytesBase64))
+	}
+	contractIds := []string{}
+	for i := range fungibleAssetAgreementsBytesBase64 {
+		contractId, err := LockFungibleAsset(ctx, callerChaincodeID, fungibleAssetAgreementsBytesBase64[i], lockInfosBytesBase64[i])
+		if err != nil {
+			return []string{}, logThenErrorf("failed to lock fungible asset %d of the batch: %s", i, err)
+		}
+		contractIds = append(contractIds, contractId)
+	}
+	err := checkDistinctContractIds(contractIds)
+	if err != nil {
+		return []string{}, err
+	}
+	return contractIds, nil
+}
 
+// ClaimAssetBatch cc is used to record claim of a list of assets on the ledger
+func ClaimAssetBatch(ctx contractapi.TransactionContextInterface, callerChaincodeID string, assetAgreementsBytesBase64, claimInfosBytesBase64 []string) ([]string, error) {
+	if len(assetAgreementsBytesBase64) != len(claimInfosBytesBase64) {
+		return []string{}, logThenErrorf("number of asset agreements (%d) and claim infos (%d) do not match", len(assetAgreementsBytesBase64), len(claimInfosBytesBase64))
+	}
+	contractIds := []string{}
+	for i := range assetAgreementsBytesBase64 {
+		contractId, err := ClaimAsset(ctx, callerChaincodeID, assetAgreementsBytesBase64[i], claimInfosBytesBase64[i])
+		if err != nil {
+			return []string{}, logThenErrorf("failed to claim asset %d of the batch: %s", i, err)
+		}
+		contractIds = append(contractIds, contractId)
+	}
+	err := checkDistinctContractIds(contractIds)
+	if err != nil {
+		return []string{}, err
+	}
+	return contractIds, nil
+}
+
+// ClaimFungibleAssetBatch cc is used to record claim of a list of groups of fungible assets on the ledger
+func ClaimFungibleAssetBatch(ctx contractapi.TransactionContextInterface, contractIds, claimInfosBytesBase64 []string) error {
+	if len(contractIds) != len(claimInfosBytesBase64) {
+		return logThenErrorf("number of contractIds (%d) and claim infos (%d) do not match", len(contractIds), len(claimInfosBytesBase64))
+	}
+	err := checkDistinctContractIds(contractIds)
+	if err != nil {
+		return err
+	}
+	for i, contractId := range contractIds {
+		err := ClaimFungibleAsset(ctx, contractId, claimInfosBytesBase64[i])
+		if err != nil {
+			return logThenErrorf("failed to claim fungible asset %d of the batch: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// UnlockAssetBatch cc is used to record unlocking of a list of assets on the ledger
+func UnlockAssetBatch(ctx contractapi.TransactionContextInterface, callerChaincodeID string, assetAgreementsBytesBase64 []string) ([]string, error) {
+	contractIds := []string{}
+	for i := range assetAgreementsBytesBase64 {
+		contractId, err := UnlockAsset(ctx, callerChaincodeID, assetAgreementsBytesBase64[i])
+		if err != nil {
+			return []string{}, logThenErrorf("failed to unlock asset %d of the batch: %s", i, err)
+		}
+		contractIds = append(contractIds, contractId)
+	}
+	err := checkDistinctContractIds(contractIds)
+	if err != nil {
+		return []string{}, err
+	}
+	return contractIds, nil
+}
+
+// UnlockFungibleAssetBatch cc is used to record unlocking of a list of groups of fungible assets on the ledger
+func UnlockFungibleAssetBatch(ctx contractapi.TransactionContextInterface, contractIds []string) error {
+	err := checkDistinctContractIds(contractIds)
+	if err != nil {
+		return err
+	}
+	for i, contractId := range contractIds {
+		err := UnlockFungibleAsset(ctx, contractId)
+		if err != nil {
+			return logThenErrorf("failed to unlock fungible asset %d of the batch: %s", i, err)
+		}
+	}
+	return nil
+}
+
+// Lock Expiry Query Functions
+// GetAssetTimeToRelease cc is used to query the resolved expiry of the lock on a non-fungible asset
+// (an epoch time in seconds);
+// a blank locker or recipient stands for the caller
+func GetAssetTimeToRelease(ctx contractapi.TransactionContextInterface, callerChaincodeID, assetType, assetId, recipient, locker string) (uint64, error) {
+	recipient, err := resolveLockQueryParty(ctx, recipient)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	locker, err = resolveLockQueryParty(ctx, locker)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	assetAgreement := &common.AssetExchangeAgreement{AssetType: assetType, Id: assetId, Recipient: recipient, Locker: locker}
+	assetLockKey, _, err := GenerateAssetLockKeyAndContractId(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	assetLockValBytes, err := ctx.GetStub().GetState(assetLockKey)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	if assetLockValBytes == nil {
+		return 0, logThenErrorf("no asset of type %s and ID %s is locked", assetType, assetId)
+	}
+
+	assetLockVal := AssetLockValue{}
+	err = json.Unmarshal(assetLockValBytes, &assetLockVal)
+	if err != nil {
+		return 0, logThenErrorf("unmarshal error: %s", err)
+	}
+	if assetLockVal.Locker != locker || assetLockVal.Recipient != recipient {
+		return 0, logThenErrorf("asset of type %s and ID %s is not locked by %s for %s", assetType, assetId, locker, recipient)
+	}
+
+	return assetLockVal.ExpiryTimeSecs, nil
+}
+
+// GetFungibleAssetTimeToRelease cc is used to query the resolved expiry of the lock, made by the calling chaincode, on
+// 'numUnits' units of a fungible asset type by 'locker' for 'recipient' (a blank party stands for the caller); if
+// several such locks exist, the earliest expiry is returned
+func GetFungibleAssetTimeToRelease(ctx contractapi.TransactionContextInterface, callerChaincodeID, assetType string, numUnits uint64, recipient, locker string) (uint64, error) {
+	lockedAssets, err := fetchLockedAssetsByParties(ctx, callerChaincodeID, recipient, locker, func(lockedAsset LockedAsset) bool {
+		return lockedAsset.IsFungible && lockedAsset.AssetType == assetType && lockedAsset.NumUnits == numUnits
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(lockedAssets) == 0 {
+		return 0, logThenErrorf("no %d units of asset type %s are locked", numUnits, assetType)
+	}
+
+	timeToRelease := lockedAssets[0].ExpiryTimeSecs
+	for _, lockedAsset := range lockedAssets[1:] {
+		if lockedAsset.ExpiryTimeSecs < timeToRelease {
+			timeToRelease = lockedAsset.ExpiryTimeSecs
+		}
+	}
+	return timeToRelease, nil
+}
+
+// GetAllAssetsLockedUntil cc is used to query the locks made by the calling chaincode, in which the caller is either
+// the locker or the recipient, that expire at or before the given epoch time, in the order of expiry
+func GetAllAssetsLockedUntil(ctx contractapi.TransactionContextInterface, callerChaincodeID string, lockExpiryTimeSecs uint64) ([]LockedAsset, error) {
+	caller, err := getECertOfTxCreatorBase64(ctx)
+	if err != nil {
+		return []LockedAsset{}, logThenErrorf(err.Error())
+	}
+
+	// the end key of the range (exclusive) precedes the index entries of all locks that expire after the given time
+	endKey := expiryIndexPrefix + string(utf8.MaxRune)
+	if lockExpiryTimeSecs < math.MaxUint64 {
+		endKey = generateExpiryIndexKey(lockExpiryTimeSecs+1, "")
+	}
+	resultsIterator, err := ctx.GetStub().GetStateByRange(expiryIndexPrefix, endKey)
+	if err != nil {
+		return []LockedAsset{}, logThenErrorf("failed to retrieve lock index entries from the world state: %+v", err)
+	}
+	defer resultsIterator.Close()
+
+	lockedAssets := []LockedAsset{}
+	for resultsIterator.HasNext() {
+		queryResponse, err := resultsIterator.Next()
+		if err != nil {
+			return []LockedAsset{}, logThenErrorf(err.Error())
+		}
+		lockIndexVal := lockIndexValue{}
+		err = json.Unmarshal(queryResponse.Value, &lockIndexVal)
+		if err != nil {
+			return []LockedAsset{}, logThenErrorf("unmarshal error: %s", err)
+		}
+		if lockIndexVal.ChaincodeId != callerChaincodeID {
+			continue
+		}
+		if lockIndexVal.Locker == caller || lockIndexVal.Recipient == caller {
+			lockedAssets = append(lockedAssets, lockIndexVal.LockedAsset)
+		}
+	}
+
+	return lockedAssets, nil
+}
+
+// Expired Lock Functions
+// GetExpiredLocks cc is used to query, one page at a time, the locks whose expiry (plus the grace window) has elapsed;
+// each page covers up to 'pageSize' lock contracts on the ledger, of which only the expired ones are reported, and the
+// returned bookmark is used to fetch the next page (an empty bookmark fetches the first page, and an empty returned
+// bookmark means there are no more pages)
+func GetExpiredLocks(ctx contractapi.TransactionContextInterface, pageSize int32, bookmark string) (*ExpiredLocksPage, error) {
+	if pageSize <= 0 {
+		return nil, logThenErrorf("invalid page size %d", pageSize)
+	}
+
+	resultsIterator, responseMetadata, err := ctx.GetStub().GetStateByRangeWithPagination(contractIdPrefix, contractIdPrefix+string(utf8.MaxRune), pageSize, bookmark)
+	if err != nil {
+		return nil, logThenErrorf("failed to retrieve lock contracts from the world state: %+v", err)
+	}
+	defer resultsIterator.Close()
+
+	expiredLocksPage := &ExpiredLocksPage{ExpiredLocks: []ExpiredLock{}}
+	for resultsIterator.HasNext() {
+		queryResponse, err := resultsIterator.Next()
+		if err != nil {
+			return nil, logThenErrorf(err.Error())
+		}
+		contractId := strings.TrimPrefix(queryResponse.Key, contractIdPrefix)
+		assetLockKey, assetLockVal, err := fetchLockStateFromContractIdMapEntry(ctx, contractId, queryResponse.Value)
+		if err != nil {
+			return nil, err
+		}
+		expiredLock, err := getExpiredLock(ctx, contractId, assetLockKey, assetLockVal)
+		if err != nil {
+			return nil, err
+		}
+		if expiredLock != nil {
+			expiredLocksPage.ExpiredLocks = append(expiredLocksPage.ExpiredLocks, *expiredLock)
+		}
+	}
+	if responseMetadata != nil && int32(responseMetadata.FetchedRecordsCount) == pageSize {
+		expiredLocksPage.Bookmark = responseMetadata.Bookmark
+	}
+
+	return expiredLocksPage, nil
+}
+
+// ReportExpiredLocks cc is used to report, irrespective of their lockers, up to 'maxCount' locks whose expiry (plus the
+// grace window) has elapsed and which were not reported before; the locks are visited in the order of their contractIds,
+// and the reported ones are marked as such, returned and listed in an expiry event. The locks are left in place, as only
+// an unlock by the locker (through the application chaincode that holds the locked assets) can return the assets.
+func ReportExpiredLocks(ctx contractapi.TransactionContextInterface, maxCount uint32) ([]ExpiredLock, error) {
+	if maxCount == 0 {
+		return []ExpiredLock{}, logThenErrorf("maximum number of locks to report must be positive")
+	}
+
+	resultsIterator, err := ctx.GetStub().GetStateByRange(contractIdPrefix, contractIdPrefix+string(utf8.MaxRune))
+	if err != nil {
+		return []ExpiredLock{}, logThenErrorf("failed to retrieve lock contracts from the world state: %+v", err)
+	}
+	defer resultsIterator.Close()
+
+	expiredLocks := []ExpiredLock{}
+	expiryEvents := []*common.AssetLockEvent{}
+	for resultsIterator.HasNext() && len(expiredLocks) < int(maxCount) {
+		queryResponse, err := resultsIterator.Next()
+		if err != nil {
+			return []ExpiredLock{}, logThenErrorf(err.Error())
+		}
+		contractId := strings.TrimPrefix(queryResponse.Key, contractIdPrefix)
+		assetLockKey, assetLockVal, err := fetchLockStateFromContractIdMapEntry(ctx, contractId, queryResponse.Value)
+		if err != nil {
+			return []ExpiredLock{}, err
+		}
+		expiredLock, err := getExpiredLock(ctx, contractId, assetLockKey, assetLockVal)
+		if err != nil {
+			return []ExpiredLock{}, err
+		}
+		if expiredLock == nil {
+			continue
+		}
+		expiryReported, err := markExpiryReported(ctx, contractId, assetLockKey, assetLockVal)
+		if err != nil {
+			return []ExpiredLock{}, err
+		}
+		if expiryReported {
+			continue
+		}
+		expiryEvent, err := newAssetLockEvent(ctx, common.AssetLockEventType_EXPIRED, contractId, assetLockKey, assetLockVal)
+		if err != nil {
+			return []ExpiredLock{}, err
+		}
+		expiredLocks = append(expiredLocks, *expiredLock)
+		expiryEvents = append(expiryEvents, expiryEvent)
+	}
+
+	return expiredLocks, emitAssetLockExpiryEvents(ctx, expiryEvents...)
+}
@@ -9,18 +9,26 @@
 package assetexchange
 
 import (
+    "crypto"
+    "crypto/ecdsa"
+    "crypto/ed25519"
+    "crypto/rsa"
     "crypto/sha256"
     "crypto/sha512"
+    "crypto/x509"
     "encoding/base64"
     "encoding/json"
+    "encoding/pem"
     "errors"
     "fmt"
 
     "github.com/golang/protobuf/proto"
     "github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+    "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
     "github.com/hyperledger/fabric-contract-api-go/contractapi"
     mspProtobuf "github.com/hyperledger/fabric-protos-go/msp"
     log "github.com/sirupsen/logrus"
+    "golang.org/x/crypto/sha3"
 )
 
 // helper functions to log and return errors
@@ -48,6 +56,32 @@ func GenerateSHA512HashInBase64Form(preimage string) string {
     return shaHashBase64
 }
 
+// function to generate a "Keccak-256" hash (as used by Ethereum) in base64 format for a given preimage
+func GenerateKeccak256HashInBase64Form(preimage string) string {
+    hasher := sha3.NewLegacyKeccak256()
+    hasher.Write([]byte(preimage))
+    shaHash := hasher.Sum(nil)
+    shaHashBase64 := base64.StdEncoding.EncodeToString(shaHash)
+    return shaHashBase64
+}
+
+// function to generate a "SHA3-256" hash in base64 format for a given preimage
+func GenerateSHA3_256HashInBase64Form(preimage string) string {
+    hasher := sha3.New256()
+    hasher.Write([]byte(preimage))
+    shaHash := hasher.Sum(nil)
+    shaHashBase64 := base64.StdEncoding.EncodeToString(shaHash)
+    return shaHashBase64
+}
+
+// functions to generate the hash of a given preimage in base64 format, for each of the supported hash mechanisms
+var hashGenerators = map[common.HashMechanism]func(string) string{
+    common.HashMechanism_SHA256:    GenerateSHA256HashInBase64Form,
+    common.HashMechanism_SHA512:    GenerateSHA512HashInBase64Form,
+    common.HashMechanism_KECCAK256: GenerateKeccak256HashInBase64Form,
+    common.HashMechanism_SHA3_256:  GenerateSHA3_256HashInBase64Form,
+}
+
 // function to get the caller identity from the transaction context
 func getECertOfTxCreatorBase64(ctx contractapi.TransactionContextInterface) (string, error) {
 
@@ -69,42 +103,75 @@ func getECertOfTxCreatorBase64(ctx contractapi.TransactionContextInterface) (str
     return eCertBytesBase64, nil
 }
 
+// function to check if the transaction timestamp precedes the expiry time (i.e., the lock can still be claimed);
+// lock expiry is always recorded as an EPOCH time
+func isBeforeExpiry(ctx contractapi.TransactionContextInterface, timeSpec common.TimeSpec, expiryTimeSecs uint64) (bool, error) {
+    if timeSpec != common.TimeSpec_EPOCH {
+        return false, logThenErrorf("unsupported time spec of lock expiry: %s", timeSpec.String())
+    }
+    return utils.IsBeforeExpiry(ctx.GetStub(), expiryTimeSecs)
+}
+
+// function to check if the transaction timestamp is past the expiry time plus the grace window (i.e., the lock can be unlocked)
+func isExpiryElapsed(ctx contractapi.TransactionContextInterface, timeSpec common.TimeSpec, expiryTimeSecs uint64) (bool, error) {
+    if timeSpec != common.TimeSpec_EPOCH {
+        return false, logThenErrorf("unsupported time spec of lock expiry: %s", timeSpec.String())
+    }
+    return utils.IsExpiryElapsed(ctx.GetStub(), expiryTimeSecs)
+}
+
+// function to resolve the expiry of a lock at lock time: a DURATION is converted into an absolute EPOCH time using
+// the transaction timestamp, whereas EPOCH times are recorded as is; other time specs are rejected
+func resolveLockExpiry(ctx contractapi.TransactionContextInterface, timeSpec common.TimeSpec, expiryTimeSecs uint64) (common.TimeSpec, uint64, error) {
+    switch timeSpec {
+    case common.TimeSpec_EPOCH:
+        return timeSpec, expiryTimeSecs, nil
+    case common.TimeSpec_DURATION:
+        currentTimeSecs, err := utils.GetTxTimestampSecs(ctx.GetStub())
+        if err != nil {
+            return timeSpec, 0, err
+        }
+        return common.TimeSpec_EPOCH, currentTimeSecs + expiryTimeSecs, nil
+    default:
+        return timeSpec, 0, logThenErrorf("unsupported time spec: %s", timeSpec.String())
+    }
+}
+
 /*
- * Function to validate the locker in asset agreement.
- * If locker is not set, it will be set to the caller.
- * If the locker is set already, it ensures that the locker is same as the creator of the transaction.
+ * Function to validate a party (locker or recipient) of an asset agreement of any kind.
+ * If the party is not set, it will be set to the caller.
+ * If the party is set already, it ensures that the party is same as the creator of the transaction.
  */
-func validateAndSetLockerOfAssetAgreement(ctx contractapi.TransactionContextInterface, assetAgreement *common.AssetExchangeAgreement) error {
+func validateAndSetPartyOfAgreement(ctx contractapi.TransactionContextInterface, party *string, partyRole, agreementKind string) error {
     txCreatorECertBase64, err := getECertOfTxCreatorBase64(ctx)
     if err != nil {
         return logThenErrorf(err.Error())
     }
-    if len(assetAgreement.Locker) == 0 {
-        assetAgreement.Locker = txCreatorECertBase64
-    } else if assetAgreement.Locker != txCreatorECertBase64 {
-        return logThenErrorf("locker %s in the asset agreement is not same as the transaction creator %s", assetAgreement.Locker, txCreatorECertBase64)
+    if len(*party) == 0 {
+        *party = txCreatorECertBase64
+    } else if *party != txCreatorECertBase64 {
+        return logThenErrorf("%s %s in the %s is not same as the transaction creator %s", partyRole, *party, agreementKind, txCreatorECertBase64)
     }
 
     return nil
 }
 
+/*
+ * Function to validate the locker in asset agreement.
+ * If locker is not set, it will be set to the caller.
+ * If the locker is set already, it ensures that the locker is same as the creator of the transaction.
+ */
+func validateAndSetLockerOfAssetAgreement(ctx contractapi.TransactionContextInterface, assetAgreement *common.AssetExchangeAgreement) error {
+    return validateAndSetPartyOfAgreement(ctx, &assetAgreement.Locker, "locker", "asset agreement")
+}
+
 /*
  * Function to validate the locker in fungible asset agreement.
  * If locker is not set, it will be set to the caller.
  * If the locker is set already, it ensures that the locker is same as the creator of the transaction.
  */
 func validateAndSetLockerOfFungibleAssetAgreement(ctx contractapi.TransactionContextInterface, assetAgreement *common.FungibleAssetExchangeAgreement) error {
-    txCreatorECertBase64, err := getECertOfTxCreatorBase64(ctx)
-    if err != nil {
-        return logThenErrorf(err.Error())
-    }
-    if len(assetAgreement.Locker) == 0 {
-        assetAgreement.Locker = txCreatorECertBase64
-    } else if assetAgreement.Locker != txCreatorECertBase64 {
-        return logThenErrorf("locker %s in the fungible asset agreement is not same as the transaction creator %s", assetAgreement.Locker, txCreatorECertBase64)
-    }
-
-    return nil
+    return validateAndSetPartyOfAgreement(ctx, &assetAgreement.Locker, "locker", "fungible asset agreement")
 }
 
 /*
@@ -113,31 +180,22 @@ func validateAndSetLockerOfFungibleAssetAgreement(ctx contractapi.TransactionCon
  * If the recipient is set already, it ensures that the recipient is same as the creator of the transaction.
  */
 func validateAndSetRecipientOfAssetAgreement(ctx contractapi.TransactionContextInterface, assetAgreement *common.AssetExchangeAgreement) error {
-    txCreatorECertBase64, err := getECertOfTxCreatorBase64(ctx)
-    if err != nil {
-        return logThenErrorf(err.Error())
-    }
-    if len(assetAgreement.Recipient) == 0 {
-        assetAgreement.Recipient = txCreatorECertBase64
-    } else if assetAgreement.Recipient != txCreatorECertBase64 {
-        return logThenErrorf("recipient %s in the asset agreement is not same as the transaction creator %s", assetAgreement.Recipient, txCreatorECertBase64)
-    }
-
-    return nil
+    return validateAndSetPartyOfAgreement(ctx, &assetAgreement.Recipient, "recipient", "asset agreement")
 }
 
-func getLockInfoAndExpiryTimeSecs(lockInfoBytesBase64 string) (interface{}, uint64, error) {
+func getLockInfoAndExpiryTimeSecs(ctx contractapi.TransactionContextInterface, lockInfoBytesBase64 string) (interface{}, common.TimeSpec, uint64, error) {
     var lockInfoVal interface{}
+    var timeSpec common.TimeSpec
     var expiryTimeSecs uint64
 
     lockInfoBytes, err := base64.StdEncoding.DecodeString(lockInfoBytesBase64)
     if err != nil {
-        return lockInfoVal, 0, fmt.Errorf("error in base64 decode of lock information: %+v", err)
+        return lockInfoVal, timeSpec, 0, fmt.Errorf("error in base64 decode of lock information: %+v", err)
     }
     lockInfo := &common.AssetLock{}
     err = proto.Unmarshal([]byte(lockInfoBytes), lockInfo)
     if err != nil {
-        return lockInfoVal, 0, logThenErrorf(err.Error())
+        return lockInfoVal, timeSpec, 0, logThenErrorf(err.Error())
     }
 
     // process lock details here (lockInfo.LockInfo contains value based on the lock mechanism used)
@@ -145,20 +203,41 @@ func getLockInfoAndExpiryTimeSecs(lockInfoBytesBase64 string) (interface{}, uint
         lockInfoHTLC := &common.AssetLockHTLC{}
         err := proto.Unmarshal(lockInfo.LockInfo, lockInfoHTLC)
         if err != nil {
-            return lockInfoVal, 0, logThenErrorf("unmarshal error: %s", err)
+            return lockInfoVal, timeSpec, 0, logThenErrorf("unmarshal error: %s", err)
         }
         //display the passed hash lock information
         log.Infof("lockInfoHTLC: %+v", lockInfoHTLC)
+        if _, isSupported := hashGenerators[lockInfoHTLC.HashMechanism]; !isSupported {
+            return lockInfoVal, timeSpec, 0, logThenErrorf("hashMechanism %d is not supported currently", lockInfoHTLC.HashMechanism)
+        }
         lockInfoVal = HashLock{HashMechanism: lockInfoHTLC.HashMechanism, HashBase64: string(lockInfoHTLC.HashBase64)}
         // process time lock details here
-        if lockInfoHTLC.TimeSpec != common.TimeSpec_EPOCH {
-            return lockInfoVal, 0, logThenErrorf("only EPOCH time is supported at present")
+        timeSpec, expiryTimeSecs, err = resolveLockExpiry(ctx, lockInfoHTLC.TimeSpec, lockInfoHTLC.ExpiryTimeSecs)
+        if err != nil {
+            return lockInfoVal, timeSpec, 0, err
+        }
+    } else if lockInfo.LockMechanism == common.LockMechanism_SIGNATURE {
+        lockInfoSignature := &common.AssetLockSignature{}
+        err := proto.Unmarshal(lockInfo.LockInfo, lockInfoSignature)
+        if err != nil {
+            return lockInfoVal, timeSpec, 0, logThenErrorf("unmarshal error: %s", err)
+        }
+        //display the passed signature lock information
+        log.Infof("lockInfoSignature: %+v", lockInfoSignature)
+        err = validateSignatureLock(lockInfoSignature)
+        if err != nil {
+            return lockInfoVal, timeSpec, 0, err
+        }
+        lockInfoVal = SignatureLock{Approvers: lockInfoSignature.Approvers, Threshold: lockInfoSignature.Threshold}
+        // process time lock details here
+        timeSpec, expiryTimeSecs, err = resolveLockExpiry(ctx, lockInfoSignature.TimeSpec, lockInfoSignature.ExpiryTimeSecs)
+        if err != nil {
+            return lockInfoVal, timeSpec, 0, err
         }
-        expiryTimeSecs = lockInfoHTLC.ExpiryTimeSecs
     } else {
-        return lockInfoVal, 0, logThenErrorf("lock mechanism is not supported")
+        return lockInfoVal, timeSpec, 0, logThenErrorf("lock mechanism is not supported")
     }
-    return lockInfoVal, expiryTimeSecs, nil
+    return lockInfoVal, timeSpec, expiryTimeSecs, nil
 }
 
 /*
@@ -172,15 +251,12 @@ func checkIfCorrectPreimage(preimageBase64 string, hashBase64 string, hashMechan
         return false, logThenErrorf("base64 decode preimage error: %s", err)
     }
 
-    shaHashBase64 := ""
-    if hashMechanism == common.HashMechanism_SHA256 {
-        shaHashBase64 = GenerateSHA256HashInBase64Form(string(preimage))
-    } else if hashMechanism == common.HashMechanism_SHA512 {
-        shaHashBase64 = GenerateSHA512HashInBase64Form(string(preimage))
-    } else {
+    generateHashInBase64Form, isSupported := hashGenerators[hashMechanism]
+    if !isSupported {
         log.Infof("hashMechanism %d is not supported currently", hashMechanism)
         return false, nil
     }
+    shaHashBase64 := generateHashInBase64Form(string(preimage))
     if shaHashBase64 == hashBase64 {
         log.Infof("%s: preimage %s is passed correctly", funName, preimage)
     } else {
@@ -217,6 +293,157 @@ func validateHashPreimage(claimInfo *common.AssetClaim, lockInfo interface{}) (b
     return checkIfCorrectPreimage(string(claimInfoHTLC.HashPreimageBase64), lockInfoVal.HashBase64, lockInfoVal.HashMechanism)
 }
 
+// function to check that a signature lock has distinct, well-formed approver certificates and an attainable threshold
+func validateSignatureLock(lockInfoSignature *common.AssetLockSignature) error {
+    if len(lockInfoSignature.Approvers) == 0 {
+        return logThenErrorf("no approvers specified in the signature lock")
+    }
+    if lockInfoSignature.Threshold == 0 || int(lockInfoSignature.Threshold) > len(lockInfoSignature.Approvers) {
+        return logThenErrorf("invalid threshold %d for %d approvers in the signature lock", lockInfoSignature.Threshold, len(lockInfoSignature.Approvers))
+    }
+    approvers := map[string]bool{}
+    for _, approver := range lockInfoSignature.Approvers {
+        if approvers[approver] {
+            return logThenErrorf("duplicate approver %s in the signature lock", approver)
+        }
+        approvers[approver] = true
+        _, err := parseCertBase64(approver)
+        if err != nil {
+            return logThenErrorf("invalid approver certificate in the signature lock: %+v", err)
+        }
+    }
+    return nil
+}
+
+// function to parse a base64 encoded PEM certificate (the form in which ECerts are recorded in asset locks)
+func parseCertBase64(certBase64 string) (*x509.Certificate, error) {
+    certPEM, err := base64.StdEncoding.DecodeString(certBase64)
+    if err != nil {
+        return nil, fmt.Errorf("base64 decode error: %+v", err)
+    }
+    certBlock, _ := pem.Decode(certPEM)
+    if certBlock == nil {
+        return nil, fmt.Errorf("certificate not in PEM format")
+    }
+    return x509.ParseCertificate(certBlock.Bytes)
+}
+
+// function to verify a signature over a message using the public key in the certificate; ECDSA (ASN.1 encoded) and
+// RSA (PKCS #1 v1.5) signatures are over the SHA-256 hash of the message, whereas Ed25519 signatures are over the message itself
+func verifySignatureUsingCert(cert *x509.Certificate, message, signature []byte) error {
+    messageHash := sha256.Sum256(message)
+    switch publicKey := cert.PublicKey.(type) {
+    case *ecdsa.PublicKey:
+        if !ecdsa.VerifyASN1(publicKey, messageHash[:], signature) {
+            return fmt.Errorf("ECDSA signature verification failed")
+        }
+    case *rsa.PublicKey:
+        err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, messageHash[:], signature)
+        if err != nil {
+            return fmt.Errorf("RSA signature verification failed: %+v", err)
+        }
+    case ed25519.PublicKey:
+        if !ed25519.Verify(publicKey, message, signature) {
+            return fmt.Errorf("Ed25519 signature verification failed")
+        }
+    default:
+        return fmt.Errorf("unsupported public key type %T", cert.PublicKey)
+    }
+    return nil
+}
+
+// function to generate the message that an approver of a signature lock signs to approve the claim of the lock; the
+// message names the claim besides the contractId, so that the signature cannot be replayed for another purpose
+func GenerateClaimSignatureLockMessage(contractId string) []byte {
+    return []byte(fmt.Sprintf("ClaimSignatureLock:%s", contractId))
+}
+
+// functions to generate the messages that the recipient of a lock signs to agree to the extension of its expiry, or to
+// its cancellation; each message names the amendment and the contractId, so that a signature applies to a single lock
+func GenerateExtendLockExpiryMessage(contractId string, newExpiryTimeSecs uint64) []byte {
+    return []byte(fmt.Sprintf("ExtendLockExpiry:%s:%d", contractId, newExpiryTimeSecs))
+}
+func GenerateCancelLockMessage(contractId string) []byte {
+    return []byte(fmt.Sprintf("CancelLock:%s", contractId))
+}
+
+// function to check that the transaction creator is the locker of the lock with the given contractId, and that the
+// recipient of the lock agreed to its amendment by signing the message
+func validateLockAmendment(ctx contractapi.TransactionContextInterface, assetLockVal AssetLockInterface, contractId string, message []byte, recipientSignatureBase64 string) error {
+    txCreatorECertBase64, err := getECertOfTxCreatorBase64(ctx)
+    if err != nil {
+        return logThenErrorf("unable to get the transaction creator information: %+v", err)
+    }
+    if assetLockVal.GetLocker() != txCreatorECertBase64 {
+        return logThenErrorf("asset associated with contractId %s is not locked by %s", contractId, txCreatorECertBase64)
+    }
+
+    recipientSignature, err := base64.StdEncoding.DecodeString(recipientSignatureBase64)
+    if err != nil {
+        return logThenErrorf("error in base64 decode of the recipient signature: %+v", err)
+    }
+    recipientCert, err := parseCertBase64(assetLockVal.GetRecipient())
+    if err != nil {
+        return logThenErrorf("invalid recipient certificate: %+v", err)
+    }
+    err = verifySignatureUsingCert(recipientCert, message, recipientSignature)
+    if err != nil {
+        return logThenErrorf("recipient of the lock associated with contractId %s has not agreed to its amendment: %+v", contractId, err)
+    }
+    return nil
+}
+
+/*
+ * Function to check if the claim carries valid signatures over the claim message of the contractId (see
+ * GenerateClaimSignatureLockMessage) from at least the threshold number of distinct approvers listed in the signature
+ * lock.
+ */
+func validateApproverSignatures(claimInfo *common.AssetClaim, lockInfo interface{}, contractId string) (bool, error) {
+    claimInfoSignature := &common.AssetClaimSignature{}
+    err := proto.Unmarshal(claimInfo.ClaimInfo, claimInfoSignature)
+    if err != nil {
+        return false, logThenErrorf("unmarshal claimInfo.ClaimInfo error: %s", err)
+    }
+    lockInfoVal := SignatureLock{}
+    lockInfoBytes, err := json.Marshal(lockInfo)
+    if err != nil {
+        return false, logThenErrorf("marshal lockInfo error: %s", err)
+    }
+    err = json.Unmarshal(lockInfoBytes, &lockInfoVal)
+    if err != nil {
+        return false, logThenErrorf("unmarshal lockInfoBytes error: %s", err)
+    }
+    log.Infof("SignatureLock: %+v\n", lockInfoVal)
+    if len(lockInfoVal.Approvers) == 0 {
+        return false, logThenErrorf("asset is not locked using a signature lock")
+    }
+
+    isApprover := map[string]bool{}
+    for _, approver := range lockInfoVal.Approvers {
+        isApprover[approver] = true
+    }
+    approved := map[string]bool{}
+    for _, approverSignature := range claimInfoSignature.Signatures {
+        if !isApprover[approverSignature.Approver] {
+            log.Infof("ignoring signature from %s who is not an approver of the lock", approverSignature.Approver)
+            continue
+        }
+        cert, err := parseCertBase64(approverSignature.Approver)
+        if err != nil {
+            return false, logThenErrorf("invalid approver certificate: %+v", err)
+        }
+        err = verifySignatureUsingCert(cert, GenerateClaimSignatureLockMessage(contractId), approverSignature.Signature)
+        if err != nil {
+            log.Infof("signature from approver %s is not valid: %+v", approverSignature.Approver, err)
+            continue
+        }
+        approved[approverSignature.Approver] = true
+    }
+    log.Infof("contractId %s approved by %d of the %d required approvers", contractId, len(approved), lockInfoVal.Threshold)
+
+    return uint32(len(approved)) >= lockInfoVal.Threshold, nil
+}
+
 // fetches common.AssetClaim from the input parameter and checks if the lock mechanism is valid or not
 func getClaimInfo(claimInfoBytesBase64 string) (*common.AssetClaim, error) {
     claimInfo := &common.AssetClaim{}
@@ -231,7 +458,7 @@ func getClaimInfo(claimInfoBytesBase64 string) (*common.AssetClaim, error) {
         return claimInfo, logThenErrorf("unmarshal error: %s", err)
     }
     // check if a valid lock mechanism is provided
-    if claimInfo.LockMechanism != common.LockMechanism_HTLC {
+    if claimInfo.LockMechanism != common.LockMechanism_HTLC && claimInfo.LockMechanism != common.LockMechanism_SIGNATURE {
         return claimInfo, logThenErrorf("lock mechanism is not supported")
     }
 
@@ -308,3 +535,135 @@ func fetchLockStateUsingContractId(ctx contractapi.TransactionContextInterface,
     }
     return assetLockKey, assetLockVal, nil
 }
+
+// function to fetch the asset-lock value referred to by an entry in the contractId map: the entry holds the asset-key
+// for non-fungible assets (which is returned too), and the lock value itself for fungible assets
+func fetchLockStateFromContractIdMapEntry(ctx contractapi.TransactionContextInterface, contractId string, contractIdMapValue []byte) (string, AssetLockInterface, error) {
+    var storedAssetLockKey string
+    if json.Unmarshal(contractIdMapValue, &storedAssetLockKey) == nil {
+        assetLockKey, assetLockVal, err := fetchAssetLockedUsingContractId(ctx, contractId)
+        if err != nil {
+            return "", assetLockVal, err
+        }
+        return assetLockKey, assetLockVal, nil
+    }
+
+    assetLockVal := FungibleAssetLockValue{}
+    err := json.Unmarshal(contractIdMapValue, &assetLockVal)
+    if err != nil {
+        return "", assetLockVal, logThenErrorf("unmarshal error: %s", err)
+    }
+    return "", assetLockVal, nil
+}
+
+// function to return the details of a lock if its expiry (plus the grace window) has elapsed, and nil otherwise
+func getExpiredLock(ctx contractapi.TransactionContextInterface, contractId, assetLockKey string, assetLockVal AssetLockInterface) (*ExpiredLock, error) {
+    expiryElapsed, err := isExpiryElapsed(ctx, assetLockVal.GetTimeSpec(), assetLockVal.GetExpiryTimeSecs())
+    if err != nil {
+        return nil, logThenErrorf(err.Error())
+    }
+    if !expiryElapsed {
+        return nil, nil
+    }
+
+    _, isFungible := assetLockVal.(FungibleAssetLockValue)
+    return &ExpiredLock{
+        ContractId:     contractId,
+        Locker:         assetLockVal.GetLocker(),
+        Recipient:      assetLockVal.GetRecipient(),
+        ExpiryTimeSecs: assetLockVal.GetExpiryTimeSecs(),
+        TimeSpec:       assetLockVal.GetTimeSpec(),
+        IsFungible:     isFungible,
+        assetLockKey:   assetLockKey,
+        assetLockVal:   assetLockVal,
+    }, nil
+}
+
+// function to mark a lock as reported by ReportExpiredLocks, leaving the lock otherwise unchanged; it returns true,
+// without writing to the ledger, if the lock was already reported
+func markExpiryReported(ctx contractapi.TransactionContextInterface, contractId, assetLockKey string, assetLockVal AssetLockInterface) (bool, error) {
+    var reportedAssetLockVal AssetLockInterface
+    var assetLockValKey string
+    switch lockVal := assetLockVal.(type) {
+    case AssetLockValue:
+        if lockVal.ExpiryReported {
+            return true, nil
+        }
+        lockVal.ExpiryReported = true
+        reportedAssetLockVal, assetLockValKey = lockVal, assetLockKey
+    case FungibleAssetLockValue:
+        if lockVal.ExpiryReported {
+            return true, nil
+        }
+        lockVal.ExpiryReported = true
+        reportedAssetLockVal, assetLockValKey = lockVal, generateContractIdMapKey(contractId)
+    default:
+        return false, logThenErrorf("unexpected lock type %T for the contractId %s", assetLockVal, contractId)
+    }
+    reportedAssetLockValBytes, err := json.Marshal(reportedAssetLockVal)
+    if err != nil {
+        return false, logThenErrorf("marshal error: %s", err)
+    }
+    err = ctx.GetStub().PutState(assetLockValKey, reportedAssetLockValBytes)
+    if err != nil {
+        return false, logThenErrorf("failed to write to the world state: %+v", err)
+    }
+    return false, nil
+}
+
+// function to build the event recording a state transition of the lock with the given contractId
+func newAssetLockEvent(ctx contractapi.TransactionContextInterface, eventType common.AssetLockEventType, contractId, assetLockKey string, assetLockVal AssetLockInterface) (*common.AssetLockEvent, error) {
+    lockedAsset, err := newLockedAsset(ctx, contractId, assetLockKey, assetLockVal)
+    if err != nil {
+        return nil, err
+    }
+    return &common.AssetLockEvent{
+        EventType:      eventType,
+        ContractId:     lockedAsset.ContractId,
+        AssetType:      lockedAsset.AssetType,
+        AssetId:        lockedAsset.AssetId,
+        NumUnits:       lockedAsset.NumUnits,
+        Locker:         lockedAsset.Locker,
+        Recipient:      lockedAsset.Recipient,
+        ExpiryTimeSecs: lockedAsset.ExpiryTimeSecs,
+        TimeSpec:       lockedAsset.TimeSpec,
+    }, nil
+}
+
+// function to emit the event for the expired locks reported by ReportExpiredLocks: an 'AssetLockEvent' for a single
+// lock, or an 'AssetLockEvents' for several. ReportExpiredLocks is invoked directly on this chaincode; the events of the
+// lock state transitions made on behalf of an application chaincode are emitted by the latter, as the events set by a
+// chaincode called through 'InvokeChaincode' are dropped.
+func emitAssetLockExpiryEvents(ctx contractapi.TransactionContextInterface, lockEvents ...*common.AssetLockEvent) error {
+    if len(lockEvents) == 0 {
+        return nil
+    }
+
+    eventName := assetLockEventsName
+    var eventPayload proto.Message = &common.AssetLockEvents{Events: lockEvents}
+    if len(lockEvents) == 1 {
+        eventName = assetLockExpiredEventName
+        eventPayload = lockEvents[0]
+    }
+    eventPayloadBytes, err := proto.Marshal(eventPayload)
+    if err != nil {
+        return logThenErrorf("marshal error: %+v", err)
+    }
+    err = ctx.GetStub().SetEvent(eventName, eventPayloadBytes)
+    if err != nil {
+        return logThenErrorf("failed to set the '%s' event: %+v", eventName, err)
+    }
+    return nil
+}
+
+// function to check that a batch refers to each contractId at most once
+func checkDistinctContractIds(contractIds []string) error {
+    seen := map[string]bool{}
+    for _, contractId := range contractIds {
+        if seen[contractId] {
+            return logThenErrorf("contractId %s appears more than once in the batch", contractId)
+        }
+        seen[contractId] = true
+    }
+    return nil
+}
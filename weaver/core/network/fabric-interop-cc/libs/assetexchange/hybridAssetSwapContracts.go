@@ -0,0 +1,285 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// hybridAssetSwapContracts contains the lock, claim, unlock and query functions for hybrid assets, which reuse the
+// asset-lock records and common logic of non-fungible assets
+package assetexchange
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+	log "github.com/sirupsen/logrus"
+)
+
+// Hybrid assets have both an identity and a quantity (e.g., a number of units of a specific bond). A lock on a hybrid
+// asset is recorded like that on a non-fungible asset, except that its asset-lock key also covers the number of units,
+// so the functions that work using the contractId apply to hybrid asset locks too.
+
+// Lock Functions
+// LockHybridAsset cc is used to record locking of a number of units of a hybrid asset on the ledger
+func LockHybridAsset(ctx contractapi.TransactionContextInterface, callerChaincodeID, hybridAssetAgreementBytesBase64, lockInfoBytesBase64 string) (string, error) {
+
+	assetAgreement, err := getHybridAssetAgreement(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	if len(assetAgreement.Id) == 0 || assetAgreement.NumUnits == 0 {
+		return "", logThenErrorf("hybrid asset agreement must specify both an asset ID and a positive number of units")
+	}
+
+	err = validateAndSetPartyOfAgreement(ctx, &assetAgreement.Locker, "locker", "hybrid asset agreement")
+	if err != nil {
+		return "", logThenErrorf("error in locker validation: %+v", err)
+	}
+
+	assetLockKey, contractId, err := GenerateHybridAssetLockKeyAndContractId(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	err = lockAssetCommon(ctx, callerChaincodeID, assetAgreement.Locker, assetAgreement.Recipient, assetLockKey, contractId, lockInfoBytesBase64,
+		fmt.Sprintf("%d units of asset of type %s and ID %s are already locked", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id))
+	if err != nil {
+		return "", err
+	}
+	return contractId, nil
+}
+
+// Claim Functions
+// ClaimHybridAsset cc is used to record claim of a number of units of a hybrid asset on the ledger
+func ClaimHybridAsset(ctx contractapi.TransactionContextInterface, callerChaincodeID, hybridAssetAgreementBytesBase64, claimInfoBytesBase64 string) (string, error) {
+
+	assetAgreement, err := getHybridAssetAgreement(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateAndSetPartyOfAgreement(ctx, &assetAgreement.Recipient, "recipient", "hybrid asset agreement")
+	if err != nil {
+		return "", logThenErrorf("error in recipient validation: %+v", err)
+	}
+
+	assetLockKey, assetLockVal, err := fetchHybridAssetLocked(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return "", err
+	}
+
+	if assetLockVal.Locker != assetAgreement.Locker || assetLockVal.Recipient != assetAgreement.Recipient {
+		return "", logThenErrorf("cannot claim %d units of asset of type %s and ID %s as they are locked by %s for %s", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id, assetLockVal.Locker, assetLockVal.Recipient)
+	}
+
+	err = claimAssetCommon(ctx, assetLockVal.LockInfo, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Recipient, assetLockKey, assetLockVal.ContractId, claimInfoBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	err = recordAssetLockTransition(ctx, "", common.AssetLockEventType_CLAIMED, assetLockVal.ContractId, assetLockKey, assetLockVal)
+	if err != nil {
+		return "", err
+	}
+	return assetLockVal.ContractId, nil
+}
+
+// Unlock Functions
+// UnlockHybridAsset cc is used to record unlocking of a number of units of a hybrid asset on the ledger
+func UnlockHybridAsset(ctx contractapi.TransactionContextInterface, callerChaincodeID, hybridAssetAgreementBytesBase64 string) (string, error) {
+
+	assetAgreement, err := getHybridAssetAgreement(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	err = validateAndSetPartyOfAgreement(ctx, &assetAgreement.Locker, "locker", "hybrid asset agreement")
+	if err != nil {
+		return "", logThenErrorf("error in validation of asset agreement parties: %+v", err)
+	}
+
+	assetLockKey, assetLockVal, err := fetchHybridAssetLocked(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return "", err
+	}
+
+	if assetLockVal.Locker != assetAgreement.Locker || assetLockVal.Recipient != assetAgreement.Recipient {
+		return "", logThenErrorf("cannot unlock %d units of asset of type %s and ID %s as they are locked by %s for %s", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id, assetLockVal.Locker, assetLockVal.Recipient)
+	}
+
+	err = unlockAssetCommon(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs, assetLockVal.Locker, assetLockKey, assetLockVal.ContractId)
+	if err != nil {
+		return "", err
+	}
+
+	err = recordAssetLockTransition(ctx, "", common.AssetLockEventType_UNLOCKED, assetLockVal.ContractId, assetLockKey, assetLockVal)
+	if err != nil {
+		return "", err
+	}
+	return assetLockVal.ContractId, nil
+}
+
+// Query Functions
+// IsHybridAssetLocked cc is used to query the ledger and find out if a number of units of a hybrid asset are locked or not;
+// a blank locker or recipient stands for the caller
+func IsHybridAssetLocked(ctx contractapi.TransactionContextInterface, callerChaincodeID, hybridAssetAgreementBytesBase64 string) (bool, error) {
+
+	assetAgreement, err := getHybridAssetAgreement(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return false, err
+	}
+	assetAgreement.Recipient, err = resolveLockQueryParty(ctx, assetAgreement.Recipient)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	assetAgreement.Locker, err = resolveLockQueryParty(ctx, assetAgreement.Locker)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+
+	assetLockKey, err := generateHybridAssetLockKey(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+
+	assetLockValBytes, err := ctx.GetStub().GetState(assetLockKey)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+
+	if assetLockValBytes == nil {
+		return false, nil
+	}
+
+	assetLockVal := AssetLockValue{}
+	err = json.Unmarshal(assetLockValBytes, &assetLockVal)
+	if err != nil {
+		return false, logThenErrorf("unmarshal error: %s", err)
+	}
+	log.Infof("assetLockVal: %+v", assetLockVal)
+
+	// Check if expiry time is elapsed
+	beforeExpiry, err := isBeforeExpiry(ctx, assetLockVal.TimeSpec, assetLockVal.ExpiryTimeSecs)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	if !beforeExpiry {
+		return false, nil
+	}
+
+	// '*' for recipient or locker in the query implies that the query seeks status for an arbitrary recipient or locker respectively
+	if (assetAgreement.Locker == "*" || assetLockVal.Locker == assetAgreement.Locker) && (assetAgreement.Recipient == "*" || assetLockVal.Recipient == assetAgreement.Recipient) {
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// GetHybridAssetTimeToRelease cc is used to query the resolved expiry of the lock on a number of units of a hybrid asset
+// (an epoch time in seconds);
+// a blank locker or recipient stands for the caller
+func GetHybridAssetTimeToRelease(ctx contractapi.TransactionContextInterface, callerChaincodeID, assetType, assetId string, numUnits uint64, recipient, locker string) (uint64, error) {
+	recipient, err := resolveLockQueryParty(ctx, recipient)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	locker, err = resolveLockQueryParty(ctx, locker)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	assetAgreement := &common.HybridAssetExchangeAgreement{AssetType: assetType, Id: assetId, NumUnits: numUnits, Recipient: recipient, Locker: locker}
+	_, assetLockVal, err := fetchHybridAssetLocked(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return 0, err
+	}
+	if assetLockVal.Locker != locker || assetLockVal.Recipient != recipient {
+		return 0, logThenErrorf("%d units of asset of type %s and ID %s are not locked by %s for %s", numUnits, assetType, assetId, locker, recipient)
+	}
+
+	return assetLockVal.ExpiryTimeSecs, nil
+}
+
+// GetHybridHTLCHash cc is used to query the hash lock of the lock on a number of units of a hybrid asset
+func GetHybridHTLCHash(ctx contractapi.TransactionContextInterface, callerChaincodeID, hybridAssetAgreementBytesBase64 string) (string, error) {
+	assetAgreement, err := getHybridAssetAgreement(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	_, assetLockVal, err := fetchHybridAssetLocked(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return "", err
+	}
+
+	return getHTLCHashHelper(ctx, assetLockVal.LockInfo)
+}
+
+// GetHybridHTLCHashPreImage cc is used to query the hash preimage revealed by the claim of a number of units of a hybrid asset
+func GetHybridHTLCHashPreImage(ctx contractapi.TransactionContextInterface, callerChaincodeID, hybridAssetAgreementBytesBase64 string) (string, error) {
+	assetAgreement, err := getHybridAssetAgreement(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return "", err
+	}
+
+	claimAssetLockKey, err := GenerateClaimHybridAssetLockKey(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	contractIdBytes, err := ctx.GetStub().GetState(claimAssetLockKey)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	if contractIdBytes == nil {
+		return "", logThenErrorf("key %s is not associated with any claimed asset", claimAssetLockKey)
+	}
+
+	return getHashPreImageHelper(ctx, generateClaimContractIdMapKey(string(contractIdBytes)))
+}
+
+// function to decode the hybrid asset agreement from its base64 encoded protobuf serialization
+func getHybridAssetAgreement(hybridAssetAgreementBytesBase64 string) (*common.HybridAssetExchangeAgreement, error) {
+	hybridAssetAgreementBytes, err := base64.StdEncoding.DecodeString(hybridAssetAgreementBytesBase64)
+	if err != nil {
+		return nil, logThenErrorf("error in base64 decode of hybrid asset agreement: %+v", err)
+	}
+
+	assetAgreement := &common.HybridAssetExchangeAgreement{}
+	err = proto.Unmarshal(hybridAssetAgreementBytes, assetAgreement)
+	if err != nil {
+		return nil, logThenErrorf("unmarshal error: %s", err)
+	}
+	//display the requested hybrid asset agreement
+	log.Infof("hybridAssetExchangeAgreement: %+v", assetAgreement)
+
+	return assetAgreement, nil
+}
+
+// function to fetch the asset-lock <key, value> of a number of units of a hybrid asset from the ledger
+func fetchHybridAssetLocked(ctx contractapi.TransactionContextInterface, callerChaincodeID string, assetAgreement *common.HybridAssetExchangeAgreement) (string, AssetLockValue, error) {
+	assetLockVal := AssetLockValue{}
+	assetLockKey, err := generateHybridAssetLockKey(ctx, callerChaincodeID, assetAgreement)
+	if err != nil {
+		return "", assetLockVal, logThenErrorf(err.Error())
+	}
+
+	assetLockValBytes, err := ctx.GetStub().GetState(assetLockKey)
+	if err != nil {
+		return "", assetLockVal, logThenErrorf(err.Error())
+	}
+
+	if assetLockValBytes == nil {
+		return "", assetLockVal, logThenErrorf("no %d units of asset of type %s and ID %s are locked", assetAgreement.NumUnits, assetAgreement.AssetType, assetAgreement.Id)
+	}
+
+	err = json.Unmarshal(assetLockValBytes, &assetLockVal)
+	if err != nil {
+		return "", assetLockVal, logThenErrorf("unmarshal error: %s", err)
+	}
+	return assetLockKey, assetLockVal, nil
+}
@@ -53,6 +53,40 @@ func GenerateClaimAssetLockKey(ctx contractapi.TransactionContextInterface, chai
     return claimAssetKeyPrefix + assetLockKey, nil
 }
 
+/*
+ * Function to generate asset-lock key (which is combination of asset-type, asset-id and number of units)
+ * and contract-id (which is a hash on asset-lock key) for the hybrid asset locking on the ledger
+ */
+func GenerateHybridAssetLockKeyAndContractId(ctx contractapi.TransactionContextInterface, chaincodeId string, assetAgreement *common.HybridAssetExchangeAgreement) (string, string, error) {
+    assetLockKey, err := generateHybridAssetLockKey(ctx, chaincodeId, assetAgreement)
+    if err != nil {
+        return "", "", err
+    }
+
+    contractId := GenerateSHA256HashInBase64Form(assetLockKey + ctx.GetStub().GetTxID())
+    return assetLockKey, contractId, nil
+}
+
+/*
+ * Function to generate the key mapping a claimed hybrid asset lock to its contract-id
+ */
+func GenerateClaimHybridAssetLockKey(ctx contractapi.TransactionContextInterface, chaincodeId string, assetAgreement *common.HybridAssetExchangeAgreement) (string, error) {
+    assetLockKey, err := generateHybridAssetLockKey(ctx, chaincodeId, assetAgreement)
+    if err != nil {
+        return "", err
+    }
+    return claimAssetKeyPrefix + assetLockKey, nil
+}
+
+// function to return the asset-lock key of a hybrid asset, a composite key over <chaincodeId, asset-type, asset-id, num-units>
+func generateHybridAssetLockKey(ctx contractapi.TransactionContextInterface, chaincodeId string, assetAgreement *common.HybridAssetExchangeAgreement) (string, error) {
+    assetLockKey, err := ctx.GetStub().CreateCompositeKey(hybridAssetLockObjectType, []string{chaincodeId, assetAgreement.AssetType, assetAgreement.Id, strconv.FormatUint(assetAgreement.NumUnits, 10)})
+    if err != nil {
+        return "", logThenErrorf("error while creating composite key: %+v", err)
+    }
+    return assetLockKey, nil
+}
+
 /*
  * Function to generate contract-id for fungible asset-locking on the ledger (which is
  * a hash on the attributes of the fungible asset exchange agreement)
@@ -0,0 +1,347 @@
+/*
+ * Copyright IBM Corp. All Rights Reserved.
+ *
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+// lockQueries contains the code that maintains the lock indexes (by locker, recipient, asset type and expiry) and the
+// lock listing queries answered from them
+package assetexchange
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+// function to update the lock indexes for a state transition of the lock with the given contractId: a new lock is
+// added to the indexes (recording the chaincode that made it), whereas a claimed or unlocked lock is removed from them
+func recordAssetLockTransition(ctx contractapi.TransactionContextInterface, callerChaincodeID string, eventType common.AssetLockEventType, contractId, assetLockKey string, assetLockVal AssetLockInterface) error {
+	lockedAsset, err := newLockedAsset(ctx, contractId, assetLockKey, assetLockVal)
+	if err != nil {
+		return err
+	}
+	lockIndexKeys, err := getLockIndexKeys(ctx, lockedAsset)
+	if err != nil {
+		return err
+	}
+
+	if eventType == common.AssetLockEventType_LOCKED {
+		lockIndexValBytes, err := json.Marshal(lockIndexValue{ChaincodeId: callerChaincodeID, LockedAsset: lockedAsset})
+		if err != nil {
+			return logThenErrorf("marshal error: %s", err)
+		}
+		for _, lockIndexKey := range lockIndexKeys {
+			err = ctx.GetStub().PutState(lockIndexKey, lockIndexValBytes)
+			if err != nil {
+				return logThenErrorf("failed to write to the world state: %+v", err)
+			}
+		}
+	} else {
+		for _, lockIndexKey := range lockIndexKeys {
+			err = ctx.GetStub().DelState(lockIndexKey)
+			if err != nil {
+				return logThenErrorf("failed to delete the index entries of the contractId %s: %+v", contractId, err)
+			}
+		}
+	}
+	return nil
+}
+
+// function to update the index entries of the fungible asset lock with the given contractId after a partial claim,
+// its value holding the units that remain locked
+func recordFungibleAssetPartialClaim(ctx contractapi.TransactionContextInterface, contractId string, assetLockVal FungibleAssetLockValue) error {
+	lockedAsset, err := newLockedAsset(ctx, contractId, "", assetLockVal)
+	if err != nil {
+		return err
+	}
+	return updateLockIndexes(ctx, lockedAsset, lockedAsset)
+}
+
+// function to move the index entries (including the one by expiry) of the lock with the given contractId, whose value
+// holds the extended expiry, from the previous expiry
+func recordAssetLockExtension(ctx contractapi.TransactionContextInterface, contractId, assetLockKey string, assetLockVal AssetLockInterface, previousExpiryTimeSecs uint64) error {
+	extendedLockedAsset, err := newLockedAsset(ctx, contractId, assetLockKey, assetLockVal)
+	if err != nil {
+		return err
+	}
+	lockedAsset := extendedLockedAsset
+	lockedAsset.ExpiryTimeSecs = previousExpiryTimeSecs
+	return updateLockIndexes(ctx, lockedAsset, extendedLockedAsset)
+}
+
+// function to describe a lock as reported by the lock queries; the asset type and ID of a non-fungible asset (and the
+// number of units of a hybrid asset) are recovered from its asset-lock key
+func newLockedAsset(ctx contractapi.TransactionContextInterface, contractId, assetLockKey string, assetLockVal AssetLockInterface) (LockedAsset, error) {
+	lockedAsset := LockedAsset{
+		ContractId:     contractId,
+		Locker:         assetLockVal.GetLocker(),
+		Recipient:      assetLockVal.GetRecipient(),
+		ExpiryTimeSecs: assetLockVal.GetExpiryTimeSecs(),
+		TimeSpec:       assetLockVal.GetTimeSpec(),
+	}
+	if fungibleAssetLockVal, isFungible := assetLockVal.(FungibleAssetLockValue); isFungible {
+		lockedAsset.AssetType = fungibleAssetLockVal.Type
+		lockedAsset.NumUnits = fungibleAssetLockVal.NumUnits
+		lockedAsset.ClaimedUnits = fungibleAssetLockVal.ClaimedUnits
+		lockedAsset.IsFungible = true
+	} else if assetLockKey != "" {
+		// the asset-lock key is a composite key over <chaincodeId, asset-type, asset-id>, followed by the number of
+		// units for hybrid assets
+		_, assetLockKeyAttributes, err := ctx.GetStub().SplitCompositeKey(assetLockKey)
+		if err != nil {
+			return LockedAsset{}, logThenErrorf("error while splitting composite key: %+v", err)
+		}
+		if len(assetLockKeyAttributes) >= 3 {
+			lockedAsset.AssetType = assetLockKeyAttributes[1]
+			lockedAsset.AssetId = assetLockKeyAttributes[2]
+		}
+		if len(assetLockKeyAttributes) == 4 {
+			lockedAsset.NumUnits, err = strconv.ParseUint(assetLockKeyAttributes[3], 10, 64)
+			if err != nil {
+				return LockedAsset{}, logThenErrorf("invalid number of units in asset-lock key: %+v", err)
+			}
+		}
+	}
+	return lockedAsset, nil
+}
+
+// function to replace the index entries of a lock with those of its updated state, keeping the chaincode that made
+// the lock; locks made before the lock indexes were maintained are left unindexed until they are backfilled
+func updateLockIndexes(ctx contractapi.TransactionContextInterface, lockedAsset, updatedLockedAsset LockedAsset) error {
+	lockIndexKeys, err := getLockIndexKeys(ctx, lockedAsset)
+	if err != nil {
+		return err
+	}
+	lockIndexValBytes, err := ctx.GetStub().GetState(lockIndexKeys[0])
+	if err != nil {
+		return logThenErrorf("failed to retrieve from the world state: %+v", err)
+	}
+	if lockIndexValBytes == nil {
+		// the lock was made before the lock indexes were maintained
+		return nil
+	}
+	lockIndexVal := lockIndexValue{}
+	err = json.Unmarshal(lockIndexValBytes, &lockIndexVal)
+	if err != nil {
+		return logThenErrorf("unmarshal error: %s", err)
+	}
+	for _, lockIndexKey := range lockIndexKeys {
+		err = ctx.GetStub().DelState(lockIndexKey)
+		if err != nil {
+			return logThenErrorf("failed to delete the index entries of the contractId %s: %+v", lockedAsset.ContractId, err)
+		}
+	}
+
+	lockIndexVal.LockedAsset = updatedLockedAsset
+	lockIndexValBytes, err = json.Marshal(lockIndexVal)
+	if err != nil {
+		return logThenErrorf("marshal error: %s", err)
+	}
+	updatedLockIndexKeys, err := getLockIndexKeys(ctx, updatedLockedAsset)
+	if err != nil {
+		return err
+	}
+	for _, lockIndexKey := range updatedLockIndexKeys {
+		err = ctx.GetStub().PutState(lockIndexKey, lockIndexValBytes)
+		if err != nil {
+			return logThenErrorf("failed to write to the world state: %+v", err)
+		}
+	}
+	return nil
+}
+
+// function to return the keys of the index entries of a lock: by locker, by recipient and by asset type, and (for
+// time bound locks only) by expiry, where the expiry is zero padded so that the keys sort in the order of expiry
+func getLockIndexKeys(ctx contractapi.TransactionContextInterface, lockedAsset LockedAsset) ([]string, error) {
+	lockIndexKeys := []string{}
+	for _, indexAttribute := range [][2]string{
+		{lockerIndexName, lockedAsset.Locker},
+		{recipientIndexName, lockedAsset.Recipient},
+		{assetTypeIndexName, lockedAsset.AssetType},
+	} {
+		lockIndexKey, err := ctx.GetStub().CreateCompositeKey(indexAttribute[0], []string{indexAttribute[1], lockedAsset.ContractId})
+		if err != nil {
+			return []string{}, logThenErrorf("error while creating composite key: %+v", err)
+		}
+		lockIndexKeys = append(lockIndexKeys, lockIndexKey)
+	}
+	lockIndexKeys = append(lockIndexKeys, generateExpiryIndexKey(lockedAsset.ExpiryTimeSecs, lockedAsset.ContractId))
+	return lockIndexKeys, nil
+}
+
+// function to return the key of the entry of a lock in the index by expiry
+func generateExpiryIndexKey(expiryTimeSecs uint64, contractId string) string {
+	return fmt.Sprintf("%s%020d_%s", expiryIndexPrefix, expiryTimeSecs, contractId)
+}
+
+// function to fetch the locks made by the given chaincode from an index, keeping only those that satisfy the filter
+func fetchLockedAssetsFromIndex(ctx contractapi.TransactionContextInterface, callerChaincodeID, indexName, attribute string, filter func(LockedAsset) bool) ([]LockedAsset, error) {
+	resultsIterator, err := ctx.GetStub().GetStateByPartialCompositeKey(indexName, []string{attribute})
+	if err != nil {
+		return []LockedAsset{}, logThenErrorf("failed to retrieve lock index entries from the world state: %+v", err)
+	}
+	defer resultsIterator.Close()
+
+	lockedAssets := []LockedAsset{}
+	for resultsIterator.HasNext() {
+		queryResponse, err := resultsIterator.Next()
+		if err != nil {
+			return []LockedAsset{}, logThenErrorf(err.Error())
+		}
+		lockIndexVal := lockIndexValue{}
+		err = json.Unmarshal(queryResponse.Value, &lockIndexVal)
+		if err != nil {
+			return []LockedAsset{}, logThenErrorf("unmarshal error: %s", err)
+		}
+		if lockIndexVal.ChaincodeId == callerChaincodeID && filter(lockIndexVal.LockedAsset) {
+			lockedAssets = append(lockedAssets, lockIndexVal.LockedAsset)
+		}
+	}
+	return lockedAssets, nil
+}
+
+// function to resolve a party (locker or recipient) of a lock query, where a blank party stands for the caller
+func resolveLockQueryParty(ctx contractapi.TransactionContextInterface, party string) (string, error) {
+	if len(party) > 0 {
+		return party, nil
+	}
+	return getECertOfTxCreatorBase64(ctx)
+}
+
+// function to fetch the locks made by the given chaincode, by 'locker' for 'recipient' (a blank party stands for the
+// caller), keeping only those that satisfy the filter; the index by recipient is read when only the recipient is given,
+// and the index by locker otherwise
+func fetchLockedAssetsByParties(ctx contractapi.TransactionContextInterface, callerChaincodeID, recipient, locker string, filter func(LockedAsset) bool) ([]LockedAsset, error) {
+	indexName, attribute := lockerIndexName, locker
+	if len(locker) == 0 && len(recipient) > 0 {
+		indexName, attribute = recipientIndexName, recipient
+	}
+	recipient, err := resolveLockQueryParty(ctx, recipient)
+	if err != nil {
+		return []LockedAsset{}, logThenErrorf(err.Error())
+	}
+	locker, err = resolveLockQueryParty(ctx, locker)
+	if err != nil {
+		return []LockedAsset{}, logThenErrorf(err.Error())
+	}
+	if indexName == lockerIndexName {
+		attribute = locker
+	}
+	return fetchLockedAssetsFromIndex(ctx, callerChaincodeID, indexName, attribute, func(lockedAsset LockedAsset) bool {
+		return lockedAsset.Locker == locker && lockedAsset.Recipient == recipient && filter(lockedAsset)
+	})
+}
+
+// BackfillLockIndexes cc is used to add the locks made by the given chaincode before the lock indexes were maintained
+// to the lock indexes, one page at a time; each page covers up to 'pageSize' lock contracts on the ledger, of which only
+// the unindexed ones are added, and the returned bookmark is used to backfill the next page (an empty bookmark starts
+// from the first page, and an empty returned bookmark means there are no more pages). As the locks on fungible assets
+// do not record the chaincode that made them, all the unindexed ones are attributed to the given chaincode.
+func BackfillLockIndexes(ctx contractapi.TransactionContextInterface, chaincodeId string, pageSize int32, bookmark string) (string, error) {
+	if pageSize <= 0 {
+		return "", logThenErrorf("invalid page size %d", pageSize)
+	}
+
+	resultsIterator, responseMetadata, err := ctx.GetStub().GetStateByRangeWithPagination(contractIdPrefix, contractIdPrefix+string(utf8.MaxRune), pageSize, bookmark)
+	if err != nil {
+		return "", logThenErrorf("failed to retrieve lock contracts from the world state: %+v", err)
+	}
+	defer resultsIterator.Close()
+
+	for resultsIterator.HasNext() {
+		queryResponse, err := resultsIterator.Next()
+		if err != nil {
+			return "", logThenErrorf(err.Error())
+		}
+		contractId := strings.TrimPrefix(queryResponse.Key, contractIdPrefix)
+		assetLockKey, assetLockVal, err := fetchLockStateFromContractIdMapEntry(ctx, contractId, queryResponse.Value)
+		if err != nil {
+			return "", err
+		}
+		if assetLockKey != "" {
+			// the asset-lock key of a non-fungible (or hybrid) asset lock starts with the chaincode that made the lock
+			_, assetLockKeyAttributes, err := ctx.GetStub().SplitCompositeKey(assetLockKey)
+			if err != nil {
+				return "", logThenErrorf("error while splitting composite key: %+v", err)
+			}
+			if len(assetLockKeyAttributes) == 0 || assetLockKeyAttributes[0] != chaincodeId {
+				continue
+			}
+		}
+		lockedAsset, err := newLockedAsset(ctx, contractId, assetLockKey, assetLockVal)
+		if err != nil {
+			return "", err
+		}
+		lockIndexKeys, err := getLockIndexKeys(ctx, lockedAsset)
+		if err != nil {
+			return "", err
+		}
+		lockIndexValBytes, err := ctx.GetStub().GetState(lockIndexKeys[0])
+		if err != nil {
+			return "", logThenErrorf("failed to retrieve from the world state: %+v", err)
+		}
+		if lockIndexValBytes != nil {
+			continue
+		}
+		err = recordAssetLockTransition(ctx, chaincodeId, common.AssetLockEventType_LOCKED, contractId, assetLockKey, assetLockVal)
+		if err != nil {
+			return "", err
+		}
+	}
+	if responseMetadata != nil && int32(responseMetadata.FetchedRecordsCount) == pageSize {
+		return responseMetadata.Bookmark, nil
+	}
+	return "", nil
+}
+
+// Lock Listing Query Functions
+// GetAllLockedAssets cc is used to query the locks (on both non-fungible and fungible assets) made by the calling
+// chaincode, by 'locker' for 'recipient'; a blank locker or recipient stands for the caller
+func GetAllLockedAssets(ctx contractapi.TransactionContextInterface, callerChaincodeID, recipient, locker string) ([]LockedAsset, error) {
+	return fetchLockedAssetsByParties(ctx, callerChaincodeID, recipient, locker, func(LockedAsset) bool {
+		return true
+	})
+}
+
+// GetAllNonFungibleLockedAssets cc is used to query the locks on non-fungible assets made by the calling chaincode, by
+// 'locker' for 'recipient'; a blank locker or recipient stands for the caller
+func GetAllNonFungibleLockedAssets(ctx contractapi.TransactionContextInterface, callerChaincodeID, recipient, locker string) ([]LockedAsset, error) {
+	return fetchLockedAssetsByParties(ctx, callerChaincodeID, recipient, locker, func(lockedAsset LockedAsset) bool {
+		return !lockedAsset.IsFungible
+	})
+}
+
+// GetAllFungibleLockedAssets cc is used to query the locks on groups of fungible assets made by the calling chaincode,
+// by 'locker' for 'recipient'; a blank locker or recipient stands for the caller
+func GetAllFungibleLockedAssets(ctx contractapi.TransactionContextInterface, callerChaincodeID, recipient, locker string) ([]LockedAsset, error) {
+	return fetchLockedAssetsByParties(ctx, callerChaincodeID, recipient, locker, func(lockedAsset LockedAsset) bool {
+		return lockedAsset.IsFungible
+	})
+}
+
+// GetTotalFungibleLockedAssets cc is used to query the total number of units of a fungible asset type that are locked
+// by the calling chaincode
+func GetTotalFungibleLockedAssets(ctx contractapi.TransactionContextInterface, callerChaincodeID, assetType string) (uint64, error) {
+	lockedAssets, err := fetchLockedAssetsFromIndex(ctx, callerChaincodeID, assetTypeIndexName, assetType, func(lockedAsset LockedAsset) bool {
+		return lockedAsset.IsFungible
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var totalNumUnits uint64
+	for _, lockedAsset := range lockedAssets {
+		if lockedAsset.NumUnits > math.MaxUint64-totalNumUnits {
+			return 0, logThenErrorf("total number of locked units of asset type %s overflows", assetType)
+		}
+		totalNumUnits += lockedAsset.NumUnits
+	}
+	return totalNumUnits, nil
+}
@@ -17,6 +17,7 @@ type AssetLockInterface interface {
     GetRecipient() string
     GetLockInfo() interface{}
     GetExpiryTimeSecs() uint64
+    GetTimeSpec() common.TimeSpec
 }
 
 // Object used to capture the HashLock details used in Asset Locking
@@ -25,13 +26,25 @@ type HashLock struct {
     HashBase64 string `json:"hashBase64"`
 }
 
-// Object used in the map, <asset-type, asset-id> --> <contractId, locker, recipient, ...> (for non-fungible assets)
+// Object used to capture the SignatureLock details used in Asset Locking
+// (a claim needs valid signatures from at least 'Threshold' of the 'Approvers')
+type SignatureLock struct {
+    Approvers []string `json:"approvers"`
+    Threshold uint32   `json:"threshold"`
+}
+
+// Object used in the map, <asset-type, asset-id> --> <contractId, locker, recipient, ...> (for non-fungible assets),
+// and in the map, <asset-type, asset-id, num-units> --> <contractId, locker, recipient, ...> (for hybrid assets)
+// 'ExpiryTimeSecs' holds the resolved expiry, an epoch time in seconds, and 'ExpiryReported' is set once
+// ReportExpiredLocks has reported the lock as expired
 type AssetLockValue struct {
-    ContractId     string      `json:"contractId"`
-    Locker         string      `json:"locker"`
-    Recipient      string      `json:"recipient"`
-    LockInfo       interface{} `json:"lockInfo"`
-    ExpiryTimeSecs uint64      `json:"expiryTimeSecs"`
+    ContractId     string          `json:"contractId"`
+    Locker         string          `json:"locker"`
+    Recipient      string          `json:"recipient"`
+    LockInfo       interface{}     `json:"lockInfo"`
+    ExpiryTimeSecs uint64          `json:"expiryTimeSecs"`
+    TimeSpec       common.TimeSpec `json:"timeSpec,omitempty"`
+    ExpiryReported bool            `json:"expiryReported,omitempty"`
 }
 
 func (a AssetLockValue) GetLocker() string {
@@ -46,15 +59,22 @@ func (a AssetLockValue) GetLockInfo() interface{} {
 func (a AssetLockValue) GetExpiryTimeSecs() uint64 {
     return a.ExpiryTimeSecs
 }
+func (a AssetLockValue) GetTimeSpec() common.TimeSpec {
+    return a.TimeSpec
+}
 
 // Object used in the map, contractId --> <asset-type, num-units, locker, ...> (for fungible assets)
+// 'NumUnits' holds the units that are still locked, and 'ClaimedUnits' those claimed so far through partial claims
 type FungibleAssetLockValue struct {
-    Type           string      `json:"type"`
-    NumUnits       uint64      `json:"numUnits"`
-    Locker         string      `json:"locker"`
-    Recipient      string      `json:"recipient"`
-    LockInfo       interface{} `json:"lockInfo"`
-    ExpiryTimeSecs uint64      `json:"expiryTimeSecs"`
+    Type           string          `json:"type"`
+    NumUnits       uint64          `json:"numUnits"`
+    ClaimedUnits   uint64          `json:"claimedUnits,omitempty"`
+    Locker         string          `json:"locker"`
+    Recipient      string          `json:"recipient"`
+    LockInfo       interface{}     `json:"lockInfo"`
+    ExpiryTimeSecs uint64          `json:"expiryTimeSecs"`
+    TimeSpec       common.TimeSpec `json:"timeSpec,omitempty"`
+    ExpiryReported bool            `json:"expiryReported,omitempty"`
 }
 
 func (a FungibleAssetLockValue) GetLocker() string {
@@ -69,6 +89,57 @@ func (a FungibleAssetLockValue) GetLockInfo() interface{} {
 func (a FungibleAssetLockValue) GetExpiryTimeSecs() uint64 {
     return a.ExpiryTimeSecs
 }
+func (a FungibleAssetLockValue) GetTimeSpec() common.TimeSpec {
+    return a.TimeSpec
+}
+
+// Object used to report a lock whose expiry (plus the grace window) has elapsed, and which can hence be unlocked by its
+// locker
+type ExpiredLock struct {
+    ContractId     string          `json:"contractId"`
+    Locker         string          `json:"locker"`
+    Recipient      string          `json:"recipient"`
+    ExpiryTimeSecs uint64          `json:"expiryTimeSecs"`
+    TimeSpec       common.TimeSpec `json:"timeSpec,omitempty"`
+    IsFungible     bool            `json:"isFungible"`
+    assetLockKey   string          // asset-key of a non-fungible asset lock
+    assetLockVal   AssetLockInterface
+}
+
+// Object used to return a page of expired locks, along with the bookmark to fetch the next page
+type ExpiredLocksPage struct {
+    ExpiredLocks []ExpiredLock `json:"expiredLocks"`
+    Bookmark     string        `json:"bookmark"`
+}
+
+// Object used to report a lock (on a non-fungible asset or a group of fungible assets) in lock queries
+type LockedAsset struct {
+    ContractId     string          `json:"contractId"`
+    AssetType      string          `json:"assetType"`
+    AssetId        string          `json:"assetId,omitempty"`
+    NumUnits       uint64          `json:"numUnits,omitempty"`
+    ClaimedUnits   uint64          `json:"claimedUnits,omitempty"`
+    Locker         string          `json:"locker"`
+    Recipient      string          `json:"recipient"`
+    ExpiryTimeSecs uint64          `json:"expiryTimeSecs"`
+    TimeSpec       common.TimeSpec `json:"timeSpec,omitempty"`
+    IsFungible     bool            `json:"isFungible"`
+}
+
+// Object used to report the units of a lock on a group of fungible assets that are still locked, and those claimed
+// so far; 'IsLocked' is false once the expiry has elapsed, after which the locked units can only return to the locker
+type FungibleAssetLockStatus struct {
+    ContractId   string `json:"contractId"`
+    IsLocked     bool   `json:"isLocked"`
+    LockedUnits  uint64 `json:"lockedUnits"`
+    ClaimedUnits uint64 `json:"claimedUnits"`
+}
+
+// Object used as the value of the entries in the lock indexes, which also records the chaincode that made the lock
+type lockIndexValue struct {
+    ChaincodeId string `json:"chaincodeId"`
+    LockedAsset
+}
 
 const (
     assetKeyPrefix    = "AssetKey_"   // prefix for the map, asset-key --> asset-object
@@ -76,4 +147,11 @@ const (
     contractIdPrefix  = "ContractId_" // prefix for the map, contractId --> asset-key
     claimAssetKeyPrefix = "ClaimAssetKey_"
     claimContractIdPrefix = "ClaimContractId_"
+    assetLockExpiredEventName = "AssetLockExpired" // name of the event for an expired lock reported by ReportExpiredLocks
+    assetLockEventsName = "AssetLockEvents"        // name of the event for several expired locks reported by ReportExpiredLocks
+    lockerIndexName    = "LockByLocker"    // composite key <locker, contractId> --> lock index value
+    recipientIndexName = "LockByRecipient" // composite key <recipient, contractId> --> lock index value
+    assetTypeIndexName = "LockByAssetType" // composite key <asset-type, contractId> --> lock index value
+    expiryIndexPrefix  = "LockByExpiry_"   // prefix for the (range queryable) map, <expiry, contractId> --> lock index value
+    hybridAssetLockObjectType = "HybridAssetExchangeContract" // composite key <chaincodeId, asset-type, asset-id, num-units> --> asset lock value
 )
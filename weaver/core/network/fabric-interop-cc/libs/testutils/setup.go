@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 	"github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -53,6 +54,10 @@ func prepMocks(orgMSP, clientID string) (*mocks.TransactionContext, *mocks.Chain
 	chaincodeStub := &mocks.ChaincodeStub{}
 	transactionContext := &mocks.TransactionContext{}
 	transactionContext.GetStubReturns(chaincodeStub)
+	// transaction timestamp defaults to the current time, as set by a client in a real proposal
+	chaincodeStub.GetTxTimestampCalls(func() (*timestamppb.Timestamp, error) {
+		return timestamppb.Now(), nil
+	})
 
 	clientIdentity := &mocks.ClientIdentity{}
 	clientIdentity.GetMSPIDReturns(orgMSP, nil)
@@ -15,7 +15,6 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
@@ -144,6 +143,84 @@ func GetECertOfTxCreatorBase64(ctx contractapi.TransactionContextInterface) (str
 }
 
 
+///////////////////////////////////////////////////////
+//////             TIME FUNCTIONS              ////////
+///////////////////////////////////////////////////////
+
+func GetExpiryGraceWindowKey() string {
+	return "expiryGraceWindowSecs"
+}
+
+// GetTxTimestampSecs returns the transaction timestamp (seconds since epoch) from the proposal.
+// Unlike the local clock of a peer, this is the same on every endorsing peer, so expiry checks based on it are deterministic.
+func GetTxTimestampSecs(stub shim.ChaincodeStubInterface) (uint64, error) {
+	txTimestamp, err := stub.GetTxTimestamp()
+	if err != nil {
+		return 0, fmt.Errorf("unable to get the transaction timestamp: %+v", err)
+	}
+	if txTimestamp == nil {
+		return 0, fmt.Errorf("transaction timestamp not set")
+	}
+	return uint64(txTimestamp.GetSeconds()), nil
+}
+
+// GetExpiryGraceWindowSecs returns the grace window (in seconds) recorded on the ledger, or 0 if none is configured.
+// The grace window is added to an expiry time before an expired lock or pledge can be released back to its owner,
+// to protect claims submitted with a transaction timestamp close to the expiry time.
+// The window is read from the calling chaincode's namespace: the interop chaincode's window covers locks, whereas
+// pledges, which an application chaincode records, are covered by the window that chaincode sets.
+func GetExpiryGraceWindowSecs(stub shim.ChaincodeStubInterface) (uint64, error) {
+	graceWindowBytes, err := stub.GetState(GetExpiryGraceWindowKey())
+	if err != nil {
+		return 0, fmt.Errorf("failed to read expiry grace window from world state: %v", err)
+	}
+	if graceWindowBytes == nil {
+		return 0, nil
+	}
+	graceWindowSecs, err := strconv.ParseUint(string(graceWindowBytes), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiry grace window on ledger: %v", err)
+	}
+	return graceWindowSecs, nil
+}
+
+// SetExpiryGraceWindowSecs records the grace window (in seconds) on the ledger. Only a network admin can set it.
+func SetExpiryGraceWindowSecs(ctx contractapi.TransactionContextInterface, graceWindowSecs uint64) error {
+	if isAdmin, err := IsClientNetworkAdmin(ctx); err != nil {
+		return fmt.Errorf("Admin client check error: %s", err)
+	} else if !isAdmin {
+		return fmt.Errorf("Caller not a network admin; access denied")
+	}
+	return ctx.GetStub().PutState(GetExpiryGraceWindowKey(), []byte(strconv.FormatUint(graceWindowSecs, 10)))
+}
+
+// IsBeforeExpiry checks whether the transaction timestamp precedes the given expiry time,
+// i.e., whether a lock or pledge can still be claimed.
+func IsBeforeExpiry(stub shim.ChaincodeStubInterface, expiryTimeSecs uint64) (bool, error) {
+	currentTimeSecs, err := GetTxTimestampSecs(stub)
+	if err != nil {
+		return false, err
+	}
+	return currentTimeSecs < expiryTimeSecs, nil
+}
+
+// IsExpiryElapsed checks whether the transaction timestamp is past the given expiry time plus the grace window,
+// i.e., whether a lock or pledge can be released back to its owner.
+func IsExpiryElapsed(stub shim.ChaincodeStubInterface, expiryTimeSecs uint64) (bool, error) {
+	currentTimeSecs, err := GetTxTimestampSecs(stub)
+	if err != nil {
+		return false, err
+	}
+	if currentTimeSecs < expiryTimeSecs {
+		return false, nil
+	}
+	graceWindowSecs, err := GetExpiryGraceWindowSecs(stub)
+	if err != nil {
+		return false, err
+	}
+	return currentTimeSecs >= expiryTimeSecs + graceWindowSecs, nil
+}
+
 ///////////////////////////////////////////////////////
 //////        ASSET TRANSFER FUNCTIONS         ////////
 ///////////////////////////////////////////////////////
@@ -227,6 +304,29 @@ func unmarshalAssetClaimStatus(claimStatusBase64 string) (*common.AssetClaimStat
 	return claimStatus, nil
 }
 
+// names of the events for the asset pledge state transitions
+var assetPledgeEventNames = map[common.AssetPledgeEventType]string{
+	common.AssetPledgeEventType_PLEDGED:        "AssetPledged",
+	common.AssetPledgeEventType_REMOTE_CLAIMED: "AssetRemoteClaimed",
+	common.AssetPledgeEventType_RECLAIMED:      "AssetReclaimed",
+}
+
+// EmitAssetPledgeEvent records a state transition of an asset pledge in a chaincode event. It is left to the
+// application chaincode, which knows the type, ID (or quantity) and parties of the pledged asset, to call this once
+// it has processed the transition, as a transaction carries a single event.
+func EmitAssetPledgeEvent(ctx contractapi.TransactionContextInterface, pledgeEvent *common.AssetPledgeEvent) error {
+	pledgeEventBytes, err := proto.Marshal(pledgeEvent)
+	if err != nil {
+		return err
+	}
+	eventName := assetPledgeEventNames[pledgeEvent.EventType]
+	err = ctx.GetStub().SetEvent(eventName, pledgeEventBytes)
+	if err != nil {
+		return fmt.Errorf("failed to set the '%s' event: %v", eventName, err)
+	}
+	return nil
+}
+
 // PledgeAsset locks an asset for transfer to a different ledger/network.
 func PledgeAsset(ctx contractapi.TransactionContextInterface, assetJSON []byte, assetType, assetIdOrQuantity, remoteNetworkId, recipientCert string, expiryTimeSecs uint64) (string, error) {
 	if assetIdOrQuantity == "" {
@@ -260,8 +360,11 @@ func PledgeAsset(ctx contractapi.TransactionContextInterface, assetJSON []byte,
 	}
 
 	// Make sure the pledge has an expiry time in the future
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs >= expiryTimeSecs {
+	isBeforeExpiry, err := IsBeforeExpiry(ctx.GetStub(), expiryTimeSecs)
+	if err != nil {
+		return "", err
+	}
+	if !isBeforeExpiry {
 		return "", fmt.Errorf("expiry time cannot be less than current time")
 	}
 
@@ -306,8 +409,11 @@ func ClaimRemoteAsset(ctx contractapi.TransactionContextInterface, pledgeId, rem
 	}
 
 	// Make sure the pledge has not expired (we assume the expiry timestamp set by the remote network)
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs >= pledge.ExpiryTimeSecs {
+	isBeforeExpiry, err := IsBeforeExpiry(ctx.GetStub(), pledge.ExpiryTimeSecs)
+	if err != nil {
+		return nil, err
+	}
+	if !isBeforeExpiry {
 		return nil, fmt.Errorf("cannot claim asset with pledgeId %s as the expiry time has elapsed", pledgeId)
 	}
 	// Match the pledge recipient with the client
@@ -342,14 +448,18 @@ func ClaimRemoteAsset(ctx contractapi.TransactionContextInterface, pledgeId, rem
 
 	claimKey := getAssetClaimKey(pledgeId)
 	lookupClaimBytes, err := ctx.GetStub().GetState(claimKey)
-	if err != nil {								// No Record of claim
-		return pledge.AssetDetails, ctx.GetStub().PutState(claimKey, claimBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read asset claim status from world state: %v", err)
 	}
-
-	lookupClaimStatus := &common.AssetClaimStatus{}
-	err = proto.Unmarshal(lookupClaimBytes, lookupClaimStatus)
-	if lookupClaimStatus.ClaimStatus {			// Previous claim was successful
-		return nil, fmt.Errorf("asset has already been claimed")
+	if lookupClaimBytes != nil {				// Record of claim exists
+		lookupClaimStatus := &common.AssetClaimStatus{}
+		err = proto.Unmarshal(lookupClaimBytes, lookupClaimStatus)
+		if err != nil {
+			return nil, err
+		}
+		if lookupClaimStatus.ClaimStatus {		// Previous claim was successful
+			return nil, fmt.Errorf("asset has already been claimed")
+		}
 	}
 
 	// Else proceed to claim
@@ -375,8 +485,11 @@ func ReclaimAsset(ctx contractapi.TransactionContextInterface, pledgeId, recipie
 	if err != nil {
 		return nil, nil, err
 	}
-	currentTimeSecs := uint64(time.Now().Unix())
-	if currentTimeSecs < pledge.ExpiryTimeSecs {
+	isExpiryElapsed, err := IsExpiryElapsed(ctx.GetStub(), pledge.ExpiryTimeSecs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isExpiryElapsed {
 		return nil, nil, fmt.Errorf("cannot reclaim asset with pledgeId %s as the expiry time is not yet elapsed", pledgeId)
 	}
 
@@ -506,6 +619,11 @@ func GetAssetPledgeDetails(ctx contractapi.TransactionContextInterface, pledgeId
 func GetAssetClaimStatus(ctx contractapi.TransactionContextInterface, pledgeId, recipientCert, pledgerNetworkId string, pledgeExpiryTimeSecs uint64, blankAssetJSON []byte) ([]byte, string, string, error) {
 	// (Optional) Ensure that this function is being called by the relay via the Fabric Interop CC
 
+	// The pledge is reported as expired only once the grace window has elapsed too, so that no claim can still be committed
+	isExpiryElapsed, err := IsExpiryElapsed(ctx.GetStub(), pledgeExpiryTimeSecs)
+	if err != nil {
+		return nil, "", "", err
+	}
 	claimStatus := &common.AssetClaimStatus{
 		AssetDetails: blankAssetJSON,
 		LocalNetworkID: "",
@@ -513,7 +631,7 @@ func GetAssetClaimStatus(ctx contractapi.TransactionContextInterface, pledgeId,
 		Recipient: "",
 		ClaimStatus: false,
 		ExpiryTimeSecs: pledgeExpiryTimeSecs,
-		ExpirationStatus: (uint64(time.Now().Unix()) >= pledgeExpiryTimeSecs),
+		ExpirationStatus: isExpiryElapsed,
 	}
 	claimStatusBytes64, err := marshalAssetClaimStatus(claimStatus)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 	log "github.com/sirupsen/logrus"
+	am "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/interfaces/asset-mgmt/v2"
 	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
 )
 
@@ -130,6 +131,11 @@ func (s *SmartContract) IsFungibleAssetLocked(ctx contractapi.TransactionContext
 	return s.amc.IsFungibleAssetLocked(ctx, contractId)
 }
 
+// Get the number of tokens locked using contractId that are still locked, and the number of those claimed so far
+func (s *SmartContract) GetFungibleAssetLockStatus(ctx contractapi.TransactionContextInterface, contractId string) (am.FungibleAssetLockStatus, error) {
+	return s.amc.GetFungibleAssetLockStatus(ctx, contractId)
+}
+
 func (s *SmartContract) ClaimAsset(ctx contractapi.TransactionContextInterface, assetAgreementSerializedProto64 string, claimInfoSerializedProto64 string) (bool, error) {
 	assetAgreement, err := s.amc.ValidateAndExtractAssetAgreement(assetAgreementSerializedProto64)
 	if err != nil {
@@ -214,6 +220,11 @@ func (s *SmartContract) ClaimAssetUsingContractId(ctx contractapi.TransactionCon
 }
 
 func (s *SmartContract) ClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId, claimInfoSerializedProto64 string) (bool, error) {
+	// Fetch the number of tokens still locked before the claim removes the lock, as some may have been claimed in tranches
+	lockStatus, err := s.amc.GetFungibleAssetLockStatus(ctx, contractId)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
 	claimed, err := s.amc.ClaimFungibleAsset(ctx, contractId, claimInfoSerializedProto64)
 	if err != nil {
 		return false, logThenErrorf(err.Error())
@@ -225,13 +236,13 @@ func (s *SmartContract) ClaimFungibleAsset(ctx contractapi.TransactionContextInt
 			return false, logThenErrorf(err.Error())
 		}
 
-		// Fetch the contracted token asset type and numUnits from the ledger
-		assetType, numUnits, err := s.amc.FetchFromContractIdFungibleAssetLookupMap(ctx, contractId)
+		// Fetch the contracted token asset type from the ledger
+		assetType, _, err := s.amc.FetchFromContractIdFungibleAssetLookupMap(ctx, contractId)
 		if err != nil {
 			return false, logThenErrorf(err.Error())
 		}
 
-		err = s.IssueTokenAssets(ctx, assetType, numUnits, recipientECertBase64)
+		err = s.IssueTokenAssets(ctx, assetType, lockStatus.LockedUnits, recipientECertBase64)
 		if err != nil {
 			return false, logThenErrorf(err.Error())
 		}
@@ -245,6 +256,41 @@ func (s *SmartContract) ClaimFungibleAsset(ctx contractapi.TransactionContextInt
 	}
 }
 
+// Claim numUnits of the tokens locked using contractId, leaving the rest locked; the lookup map is updated to hold the
+// number of tokens that remain locked, and is deleted once none do
+func (s *SmartContract) PartialClaimFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string, numUnits uint64, claimInfoSerializedProto64 string) (uint64, error) {
+	remainingUnits, err := s.amc.PartialClaimFungibleAsset(ctx, contractId, numUnits, claimInfoSerializedProto64)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	// Add the claimed tokens into the wallet of the claimant
+	recipientECertBase64, err := wutils.GetECertOfTxCreatorBase64(ctx)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	// Fetch the contracted token asset type from the ledger
+	assetType, _, err := s.amc.FetchFromContractIdFungibleAssetLookupMap(ctx, contractId)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	err = s.IssueTokenAssets(ctx, assetType, numUnits, recipientECertBase64)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	if remainingUnits == 0 {
+		err = s.amc.DeleteFungibleAssetLookupMap(ctx, contractId)
+	} else {
+		err = s.amc.ContractIdFungibleAssetsLookupMap(ctx, assetType, remainingUnits, contractId)
+	}
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+	return remainingUnits, nil
+}
+
 func (s *SmartContract) UnlockAsset(ctx contractapi.TransactionContextInterface, assetAgreementSerializedProto64 string) (bool, error) {
 	assetAgreement, err := s.amc.ValidateAndExtractAssetAgreement(assetAgreementSerializedProto64)
 	if err != nil {
@@ -285,6 +331,11 @@ func (s *SmartContract) UnlockAssetUsingContractId(ctx contractapi.TransactionCo
 }
 
 func (s *SmartContract) UnlockFungibleAsset(ctx contractapi.TransactionContextInterface, contractId string) (bool, error) {
+	// Fetch the number of tokens still locked before the unlock removes the lock, as some may have been claimed in tranches
+	lockStatus, err := s.amc.GetFungibleAssetLockStatus(ctx, contractId)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
 	unlocked, err := s.amc.UnlockFungibleAsset(ctx, contractId)
 	if err != nil {
 		return false, logThenErrorf(err.Error())
@@ -296,13 +347,13 @@ func (s *SmartContract) UnlockFungibleAsset(ctx contractapi.TransactionContextIn
 			return false, logThenErrorf(err.Error())
 		}
 
-		// Fetch the contracted token asset type and numUnits from the ledger
-		assetType, numUnits, err := s.amc.FetchFromContractIdFungibleAssetLookupMap(ctx, contractId)
+		// Fetch the contracted token asset type from the ledger
+		assetType, _, err := s.amc.FetchFromContractIdFungibleAssetLookupMap(ctx, contractId)
 		if err != nil {
 			return false, logThenErrorf(err.Error())
 		}
 
-		err = s.IssueTokenAssets(ctx, assetType, numUnits, lockerECertBase64)
+		err = s.IssueTokenAssets(ctx, assetType, lockStatus.LockedUnits, lockerECertBase64)
 		if err != nil {
 			return false, logThenErrorf(err.Error())
 		}
@@ -316,6 +367,64 @@ func (s *SmartContract) UnlockFungibleAsset(ctx contractapi.TransactionContextIn
 	}
 }
 
+// Extend the expiry of the lock made using contractId, by agreement of the recipient
+func (s *SmartContract) ExtendLockExpiry(ctx contractapi.TransactionContextInterface, contractId string, newExpiryTimeSecs uint64, recipientSignatureBase64 string) (bool, error) {
+	return s.amc.ExtendLockExpiry(ctx, contractId, newExpiryTimeSecs, recipientSignatureBase64)
+}
+
+// Cancel the lock made using contractId, by agreement of the recipient, releasing the asset back to the locker right away
+func (s *SmartContract) CancelLock(ctx contractapi.TransactionContextInterface, contractId string, recipientSignatureBase64 string) (bool, error) {
+	isFungible, err := s.amc.IsFungibleAssetLocked(ctx, contractId)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	var lockStatus am.FungibleAssetLockStatus
+	if isFungible {
+		// Fetch the number of tokens still locked before the cancellation removes the lock
+		lockStatus, err = s.amc.GetFungibleAssetLockStatus(ctx, contractId)
+		if err != nil {
+			return false, logThenErrorf(err.Error())
+		}
+	}
+	cancelled, err := s.amc.CancelLock(ctx, contractId, recipientSignatureBase64)
+	if err != nil {
+		return false, logThenErrorf(err.Error())
+	}
+	if !cancelled {
+		return false, logThenErrorf("cancellation of the lock using contractId %s failed", contractId)
+	}
+
+	if isFungible {
+		// Add the tokens that were still locked back into the wallet of the locker
+		lockerECertBase64, err := wutils.GetECertOfTxCreatorBase64(ctx)
+		if err != nil {
+			return false, logThenErrorf(err.Error())
+		}
+
+		// Fetch the contracted token asset type from the ledger
+		assetType, _, err := s.amc.FetchFromContractIdFungibleAssetLookupMap(ctx, contractId)
+		if err != nil {
+			return false, logThenErrorf(err.Error())
+		}
+
+		err = s.IssueTokenAssets(ctx, assetType, lockStatus.LockedUnits, lockerECertBase64)
+		if err != nil {
+			return false, logThenErrorf(err.Error())
+		}
+		err = s.amc.DeleteFungibleAssetLookupMap(ctx, contractId)
+		if err != nil {
+			return false, logThenErrorf(err.Error())
+		}
+	} else {
+		// The bond asset never left the locker, so only the lookup maps are deleted
+		err = s.amc.DeleteAssetLookupMapsOnlyUsingContractId(ctx, contractId)
+		if err != nil {
+			return false, logThenErrorf(err.Error())
+		}
+	}
+	return true, nil
+}
+
 
 func (s *SmartContract) GetHTLCHash(ctx contractapi.TransactionContextInterface, assetAgreementBytesBase64 string) (string, error) {
 	return s.amc.GetHTLCHash(ctx, assetAgreementBytesBase64)
@@ -19,6 +19,7 @@ import (
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/stretchr/testify/require"
 	sa "github.com/hyperledger-cacti/cacti/weaver/samples/fabric/simpleassettransfer"
+	am "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/interfaces/asset-mgmt/v2"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	wtest "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/testutils"
 )
@@ -203,7 +204,13 @@ func TestExchangeBondAssetWithTokenAsset(t *testing.T) {
 		LockMechanism: common.LockMechanism_HTLC,
 	}
 	claimInfoBytes, _ := proto.Marshal(claimInfo)
-	chaincodeStub.InvokeChaincodeReturnsOnCall(3, shim.Success(nil))
+	tokensLockStatusBytes, _ := json.Marshal(am.FungibleAssetLockStatus{
+		ContractId: tokensContractId,
+		IsLocked: true,
+		LockedUnits: numTokens,
+	})
+	chaincodeStub.InvokeChaincodeReturnsOnCall(3, shim.Success(tokensLockStatusBytes))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(4, shim.Success(nil))
 	chaincodeStub.GetCreatorReturnsOnCall(3, []byte(getCreatorInContext("locker")), nil)
 	chaincodeStub.GetCreatorReturnsOnCall(4, []byte(getCreatorInContext("locker")), nil)
 	tokenAssetType = sa.TokenAssetType {
@@ -226,9 +233,9 @@ func TestExchangeBondAssetWithTokenAsset(t *testing.T) {
 
 	// Claim bond asset in network1 by Bob
 	fmt.Println("*** Claim bond asset in network1 by Bob ***")
-	chaincodeStub.InvokeChaincodeReturnsOnCall(4, shim.Success(nil))
-	chaincodeStub.InvokeChaincodeReturnsOnCall(5, shim.Success([]byte("true")))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(5, shim.Success(nil))
 	chaincodeStub.InvokeChaincodeReturnsOnCall(6, shim.Success([]byte("true")))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(7, shim.Success([]byte("true")))
 	chaincodeStub.GetCreatorReturnsOnCall(5, []byte(getCreatorInContext("recipient")), nil)
 	chaincodeStub.GetStateReturnsOnCall(12, bondAssetBytes, nil)
 	chaincodeStub.GetStateReturnsOnCall(13, []byte(bondContractId), nil)
@@ -236,3 +243,143 @@ func TestExchangeBondAssetWithTokenAsset(t *testing.T) {
 	require.NoError(t, err)
 
 }
+
+// test case for claiming token assets in tranches
+func TestPartialClaimFungibleAsset(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	sc := sa.SmartContract{}
+	sc.ConfigureInterop("interopcc")
+
+	tokenType := "cbdc"
+	tokensContractId := "tokens-contract"
+	tokensLookupMapKey := "FungibleAssetContract_" + tokensContractId
+	tokensRecipientWalletKey := "W_" + getLockerECertBase64()
+	tokenAssetTypeBytes, _ := json.Marshal(sa.TokenAssetType{
+		Issuer: "network2",
+		Value: 1,
+	})
+	contractedTokenAssetBytes, _ := json.Marshal(ContractedFungibleAsset{
+		Type: tokenType,
+		NumUnits: 10,
+	})
+	chaincodeStub.GetStateReturnsForKey("FAT_" + tokenType, tokenAssetTypeBytes, nil)
+	chaincodeStub.GetStateReturnsForKey(tokensLookupMapKey, contractedTokenAssetBytes, nil)
+	chaincodeStub.GetStateReturnsForKey(tokensRecipientWalletKey, nil, nil)
+	chaincodeStub.GetCreatorReturns([]byte(getCreatorInContext("locker")), nil)
+
+	claimInfoHTLCBytes, _ := proto.Marshal(&common.AssetClaimHTLC {
+		HashPreimageBase64: []byte(base64.StdEncoding.EncodeToString([]byte("abcd"))),
+	})
+	claimInfoBytes, _ := proto.Marshal(&common.AssetClaim{
+		ClaimInfo: claimInfoHTLCBytes,
+		LockMechanism: common.LockMechanism_HTLC,
+	})
+	claimInfoBase64 := base64.StdEncoding.EncodeToString(claimInfoBytes)
+
+	// Claim 3 of the 10 locked tokens: the claimant gets the 3 tokens, and the lookup map keeps the 7 still locked
+	chaincodeStub.InvokeChaincodeReturns(shim.Success([]byte("7")))
+	remainingUnits, err := sc.PartialClaimFungibleAsset(ctx, tokensContractId, 3, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), remainingUnits)
+	require.Equal(t, 2, chaincodeStub.PutStateCallCount())
+	walletKey, walletBytes := chaincodeStub.PutStateArgsForCall(0)
+	require.Equal(t, tokensRecipientWalletKey, walletKey)
+	var wallet sa.TokenWallet
+	require.NoError(t, json.Unmarshal(walletBytes, &wallet))
+	require.Equal(t, uint64(3), wallet.WalletMap[tokenType])
+	lookupMapKey, lookupMapBytes := chaincodeStub.PutStateArgsForCall(1)
+	require.Equal(t, tokensLookupMapKey, lookupMapKey)
+	var contractedTokenAsset ContractedFungibleAsset
+	require.NoError(t, json.Unmarshal(lookupMapBytes, &contractedTokenAsset))
+	require.Equal(t, tokenType, contractedTokenAsset.Type)
+	require.Equal(t, uint64(7), contractedTokenAsset.NumUnits)
+
+	// Claim the remaining 7 tokens: the lookup map is deleted
+	chaincodeStub.InvokeChaincodeReturns(shim.Success([]byte("0")))
+	remainingUnits, err = sc.PartialClaimFungibleAsset(ctx, tokensContractId, 7, claimInfoBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), remainingUnits)
+	require.Equal(t, 3, chaincodeStub.PutStateCallCount())
+	require.Equal(t, 1, chaincodeStub.DelStateCallCount())
+	require.Equal(t, tokensLookupMapKey, chaincodeStub.DelStateArgsForCall(0))
+
+	// Test failure when the interop chaincode rejects the claim: no tokens are issued
+	chaincodeStub.InvokeChaincodeReturns(shim.Error("cannot claim 8 units of the fungible asset"))
+	_, err = sc.PartialClaimFungibleAsset(ctx, tokensContractId, 8, claimInfoBase64)
+	require.Error(t, err)
+	require.Equal(t, 3, chaincodeStub.PutStateCallCount())
+}
+
+// test case for cancelling locks by agreement of the recipient
+func TestCancelLock(t *testing.T) {
+	ctx, chaincodeStub := wtest.PrepMockStub()
+	sc := sa.SmartContract{}
+	sc.ConfigureInterop("interopcc")
+	recipientSignatureBase64 := base64.StdEncoding.EncodeToString([]byte("recipient-signature"))
+
+	tokenType := "cbdc"
+	tokensContractId := "tokens-contract"
+	tokensLookupMapKey := "FungibleAssetContract_" + tokensContractId
+	tokensLockerWalletKey := "W_" + getRecipientECertBase64()
+	tokenAssetTypeBytes, _ := json.Marshal(sa.TokenAssetType{
+		Issuer: "network2",
+		Value: 1,
+	})
+	contractedTokenAssetBytes, _ := json.Marshal(ContractedFungibleAsset{
+		Type: tokenType,
+		NumUnits: 10,
+	})
+	chaincodeStub.GetStateReturnsForKey("FAT_" + tokenType, tokenAssetTypeBytes, nil)
+	chaincodeStub.GetStateReturnsForKey(tokensLookupMapKey, contractedTokenAssetBytes, nil)
+	chaincodeStub.GetStateReturnsForKey(tokensLockerWalletKey, nil, nil)
+	chaincodeStub.GetCreatorReturns([]byte(getCreatorInContext("recipient")), nil)
+
+	// Test failure when the interop chaincode rejects the cancellation: no tokens are returned
+	tokensLockStatusBytes, _ := json.Marshal(am.FungibleAssetLockStatus{
+		ContractId: tokensContractId,
+		IsLocked: true,
+		LockedUnits: 6,
+		ClaimedUnits: 4,
+	})
+	chaincodeStub.InvokeChaincodeReturnsOnCall(0, shim.Success([]byte("true")))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(1, shim.Success(tokensLockStatusBytes))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(2, shim.Error("invalid recipient signature"))
+	_, err := sc.CancelLock(ctx, tokensContractId, recipientSignatureBase64)
+	require.Error(t, err)
+	require.Equal(t, 0, chaincodeStub.PutStateCallCount())
+
+	// Cancel a lock on tokens, 4 of which were claimed: the 6 still locked go back to the locker
+	chaincodeStub.InvokeChaincodeReturnsOnCall(3, shim.Success([]byte("true")))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(4, shim.Success(tokensLockStatusBytes))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(5, shim.Success(nil))
+	cancelled, err := sc.CancelLock(ctx, tokensContractId, recipientSignatureBase64)
+	require.NoError(t, err)
+	require.True(t, cancelled)
+	_, iccArgs, _ := chaincodeStub.InvokeChaincodeArgsForCall(5)
+	require.Equal(t, "CancelLock", string(iccArgs[0]))
+	require.Equal(t, 1, chaincodeStub.PutStateCallCount())
+	walletKey, walletBytes := chaincodeStub.PutStateArgsForCall(0)
+	require.Equal(t, tokensLockerWalletKey, walletKey)
+	var wallet sa.TokenWallet
+	require.NoError(t, json.Unmarshal(walletBytes, &wallet))
+	require.Equal(t, uint64(6), wallet.WalletMap[tokenType])
+	require.Equal(t, 1, chaincodeStub.DelStateCallCount())
+	require.Equal(t, tokensLookupMapKey, chaincodeStub.DelStateArgsForCall(0))
+
+	// Cancel a lock on a bond: the bond stays with the locker, and only the lookup maps are deleted
+	bondContractId := "bond-contract"
+	contractedBondAssetBytes, _ := json.Marshal(am.ContractedAsset{
+		Type: "bond",
+		Id: "b01",
+	})
+	chaincodeStub.GetStateReturnsForKey("AssetContract_" + bondContractId, contractedBondAssetBytes, nil)
+	chaincodeStub.InvokeChaincodeReturnsOnCall(6, shim.Success([]byte("false")))
+	chaincodeStub.InvokeChaincodeReturnsOnCall(7, shim.Success(nil))
+	cancelled, err = sc.CancelLock(ctx, bondContractId, recipientSignatureBase64)
+	require.NoError(t, err)
+	require.True(t, cancelled)
+	require.Equal(t, 8, chaincodeStub.InvokeChaincodeCallCount())
+	require.Equal(t, 1, chaincodeStub.PutStateCallCount())
+	require.Equal(t, 3, chaincodeStub.DelStateCallCount())
+	require.Equal(t, "AssetContract_" + bondContractId, chaincodeStub.DelStateArgsForCall(2))
+}
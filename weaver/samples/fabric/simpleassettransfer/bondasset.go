@@ -197,7 +197,14 @@ func (s *SmartContract) PledgeAsset(ctx contractapi.TransactionContextInterface,
 			return pledgeId, err
 		}
 		err = createAssetPledgeIdMap(ctx, pledgeId, assetType, id, remoteNetworkId, recipientCert)
-		return pledgeId, err
+		if err != nil {
+			return pledgeId, err
+		}
+		pledge, err := getLocalAssetPledge(ctx, assetJSON, remoteNetworkId, recipientCert, expiryTimeSecs)
+		if err != nil {
+			return pledgeId, err
+		}
+		return pledgeId, emitAssetPledgeEvent(ctx, common.AssetPledgeEventType_PLEDGED, pledgeId, assetType, id, asset.Owner, pledge)
 	} else {
 		return "", err
 	}
@@ -239,7 +246,15 @@ func (s *SmartContract) ClaimRemoteAsset(ctx contractapi.TransactionContextInter
 	}
 
 	// Recreate the asset in this network and chaincode using app-specific logic: make the recipient the owner of the asset
-	return s.CreateAsset(ctx, assetType, id, claimer, asset.Owner, asset.FaceValue, asset.MaturityDate.Format(time.RFC822))
+	err = s.CreateAsset(ctx, assetType, id, claimer, asset.Owner, asset.FaceValue, asset.MaturityDate.Format(time.RFC822))
+	if err != nil {
+		return err
+	}
+	pledge, err := getAssetPledge(pledgeBytes64)
+	if err != nil {
+		return err
+	}
+	return emitAssetPledgeEvent(ctx, common.AssetPledgeEventType_REMOTE_CLAIMED, pledgeId, assetType, id, owner, pledge)
 }
 
 // ReclaimAsset gets back the ownership of an asset pledged for transfer to a different ledger/network.
@@ -280,7 +295,15 @@ func (s *SmartContract) ReclaimAsset(ctx contractapi.TransactionContextInterface
 	if err != nil {
 		return err
 	}
-	return delAssetPledgeIdMap(ctx, pledgeAsset.Type, pledgeAsset.ID)
+	err = delAssetPledgeIdMap(ctx, pledgeAsset.Type, pledgeAsset.ID)
+	if err != nil {
+		return err
+	}
+	pledge, err := getReclaimedAssetPledge(pledgeAssetDetails, recipientCert, claimStatusBytes64)
+	if err != nil {
+		return err
+	}
+	return emitAssetPledgeEvent(ctx, common.AssetPledgeEventType_RECLAIMED, pledgeId, pledgeAsset.Type, pledgeAsset.ID, pledgeAsset.Owner, pledge)
 }
 
 // GetAssetPledgeStatus returns the asset pledge status.
@@ -382,6 +382,20 @@ func TestPledgeAsset(t *testing.T) {
 	chaincodeStub.DelStateReturns(nil)
 	pledgeId, err = simpleAsset.PledgeAsset(transactionContext, defaultAssetType, defaultAssetId, destNetworkID, getRecipientECertBase64(), expiry)
 	require.NoError(t, err)     // Asset pledge is recorded
+
+	eventName, eventBytes := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, "AssetPledged", eventName)
+	pledgeEvent := &common.AssetPledgeEvent{}
+	err = proto.Unmarshal(eventBytes, pledgeEvent)
+	require.NoError(t, err)
+	require.Equal(t, common.AssetPledgeEventType_PLEDGED, pledgeEvent.EventType)
+	require.Equal(t, pledgeId, pledgeEvent.PledgeId)
+	require.Equal(t, defaultAssetType, pledgeEvent.AssetType)
+	require.Equal(t, defaultAssetId, pledgeEvent.AssetIdOrQuantity)
+	require.Equal(t, getLockerECertBase64(), pledgeEvent.Pledger)
+	require.Equal(t, getRecipientECertBase64(), pledgeEvent.Recipient)
+	require.Equal(t, sourceNetworkID, pledgeEvent.LocalNetworkID)
+	require.Equal(t, destNetworkID, pledgeEvent.RemoteNetworkID)
 }
 
 func TestClaimRemoteAsset(t *testing.T) {
@@ -538,8 +552,34 @@ func TestReclaimAsset(t *testing.T) {
 	chaincodeStub.GetStateReturnsForKey(localNetworkIdKey, []byte(sourceNetworkID), nil)
 	chaincodeStub.PutStateReturns(nil)
 	chaincodeStub.DelStateReturns(nil)
+	err = simpleAsset.SetExpiryGraceWindow(transactionContext, 15 * 60)
+	require.EqualError(t, err, "Caller not a network admin; access denied")       // only a network admin can set the grace window
+	clientIdentity := &wtestmocks.ClientIdentity{}
+	clientIdentity.GetAttributeValueReturns("", true, nil)
+	transactionContext.GetClientIdentityReturns(clientIdentity)
+	err = simpleAsset.SetExpiryGraceWindow(transactionContext, 15 * 60)
+	require.NoError(t, err)
+	graceWindowKey, graceWindowBytes := chaincodeStub.PutStateArgsForCall(chaincodeStub.PutStateCallCount() - 1)
+	require.Equal(t, "expiryGraceWindowSecs", graceWindowKey)
+	chaincodeStub.GetStateReturnsForKey(graceWindowKey, graceWindowBytes, nil)
 	err = simpleAsset.ReclaimAsset(transactionContext, defaultPledgeId, getRecipientECertBase64(), destNetworkID, claimStatusBytes)
-	require.NoError(t, err)     // Asset is reclaimed
+	require.EqualError(t, err, "cannot reclaim asset with pledgeId abc123 as the expiry time is not yet elapsed")       // pledge expired, but within the grace window
+
+	chaincodeStub.GetStateReturnsForKey(graceWindowKey, []byte("60"), nil)
+	err = simpleAsset.ReclaimAsset(transactionContext, defaultPledgeId, getRecipientECertBase64(), destNetworkID, claimStatusBytes)
+	require.NoError(t, err)     // Asset is reclaimed once the grace window has elapsed too
+
+	eventName, eventBytes := chaincodeStub.SetEventArgsForCall(chaincodeStub.SetEventCallCount() - 1)
+	require.Equal(t, "AssetReclaimed", eventName)
+	pledgeEvent := &common.AssetPledgeEvent{}
+	err = proto.Unmarshal(eventBytes, pledgeEvent)
+	require.NoError(t, err)
+	require.Equal(t, defaultAssetType, pledgeEvent.AssetType)
+	require.Equal(t, defaultAssetId, pledgeEvent.AssetIdOrQuantity)
+	require.Equal(t, getLockerECertBase64(), pledgeEvent.Pledger)
+	require.Equal(t, getRecipientECertBase64(), pledgeEvent.Recipient)
+	require.Equal(t, sourceNetworkID, pledgeEvent.LocalNetworkID)
+	require.Equal(t, destNetworkID, pledgeEvent.RemoteNetworkID)
 }
 
 func TestAssetTransferQueries(t *testing.T) {
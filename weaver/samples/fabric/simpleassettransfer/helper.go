@@ -19,6 +19,8 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 )
 
 // functions to log and return errors
@@ -44,17 +46,57 @@ func generateSHA256HashInHexForm(preimage string) string {
 	return shaHashHex
 }
 
-func getBondAssetFromPledge(pledgeBytes64 string) (BondAsset, error) {
-	var asset BondAsset
+func getAssetPledge(pledgeBytes64 string) (*common.AssetPledge, error) {
 	pledge := &common.AssetPledge{}
 	assetPledgeSerialized, err := base64.StdEncoding.DecodeString(pledgeBytes64)
 	if err != nil {
-		return asset, err
+		return nil, err
 	}
 	if len(assetPledgeSerialized) == 0 {
-		return asset, fmt.Errorf("empty asset pledge")
+		return nil, fmt.Errorf("empty asset pledge")
 	}
 	err = proto.Unmarshal([]byte(assetPledgeSerialized), pledge)
+	if err != nil {
+		return nil, err
+	}
+	return pledge, nil
+}
+
+// getLocalAssetPledge builds the pledge just recorded by the common (library) logic, to record it in an event
+func getLocalAssetPledge(ctx contractapi.TransactionContextInterface, assetJSON []byte, remoteNetworkId, recipientCert string, expiryTimeSecs uint64) (*common.AssetPledge, error) {
+	localNetworkId, err := ctx.GetStub().GetState(localNetworkIdKey)
+	if err != nil {
+		return nil, err
+	}
+	return &common.AssetPledge{
+		AssetDetails:    assetJSON,
+		LocalNetworkID:  string(localNetworkId),
+		RemoteNetworkID: remoteNetworkId,
+		Recipient:       recipientCert,
+		ExpiryTimeSecs:  expiryTimeSecs,
+	}, nil
+}
+
+// emitAssetPledgeEvent records a state transition of a pledge, the pledged asset being identified by its type and ID
+// (or quantity) and the networks, recipient and expiry being read from the pledge
+func emitAssetPledgeEvent(ctx contractapi.TransactionContextInterface, eventType common.AssetPledgeEventType, pledgeId, assetType, assetIdOrQuantity, pledger string, pledge *common.AssetPledge) error {
+	return wutils.EmitAssetPledgeEvent(ctx, &common.AssetPledgeEvent{
+		EventType:         eventType,
+		PledgeId:          pledgeId,
+		AssetType:         assetType,
+		AssetIdOrQuantity: assetIdOrQuantity,
+		AssetDetails:      pledge.AssetDetails,
+		Pledger:           pledger,
+		Recipient:         pledge.Recipient,
+		ExpiryTimeSecs:    pledge.ExpiryTimeSecs,
+		LocalNetworkID:    pledge.LocalNetworkID,
+		RemoteNetworkID:   pledge.RemoteNetworkID,
+	})
+}
+
+func getBondAssetFromPledge(pledgeBytes64 string) (BondAsset, error) {
+	var asset BondAsset
+	pledge, err := getAssetPledge(pledgeBytes64)
 	if err != nil {
 		return asset, err
 	}
@@ -64,15 +106,7 @@ func getBondAssetFromPledge(pledgeBytes64 string) (BondAsset, error) {
 
 func getTokenAssetFromPledge(pledgeBytes64 string) (TokenAsset, error) {
 	var asset TokenAsset
-	pledge := &common.AssetPledge{}
-	assetPledgeSerialized, err := base64.StdEncoding.DecodeString(pledgeBytes64)
-	if err != nil {
-		return asset, err
-	}
-	if len(assetPledgeSerialized) == 0 {
-		return asset, fmt.Errorf("empty asset pledge")
-	}
-	err = proto.Unmarshal([]byte(assetPledgeSerialized), pledge)
+	pledge, err := getAssetPledge(pledgeBytes64)
 	if err != nil {
 		return asset, err
 	}
@@ -80,17 +114,25 @@ func getTokenAssetFromPledge(pledgeBytes64 string) (TokenAsset, error) {
 	return asset, err
 }
 
-func getBondAssetFromClaimStatus(claimStatusBase64 string) (BondAsset, error) {
-	var asset BondAsset
+func getAssetClaimStatus(claimStatusBase64 string) (*common.AssetClaimStatus, error) {
 	claimStatus := &common.AssetClaimStatus{}
 	claimStatusSerialized, err := base64.StdEncoding.DecodeString(claimStatusBase64)
 	if err != nil {
-		return asset, err
+		return nil, err
 	}
 	if len(claimStatusSerialized) == 0 {
-		return asset, fmt.Errorf("empty asset claim status")
+		return nil, fmt.Errorf("empty asset claim status")
 	}
 	err = proto.Unmarshal([]byte(claimStatusSerialized), claimStatus)
+	if err != nil {
+		return nil, err
+	}
+	return claimStatus, nil
+}
+
+func getBondAssetFromClaimStatus(claimStatusBase64 string) (BondAsset, error) {
+	var asset BondAsset
+	claimStatus, err := getAssetClaimStatus(claimStatusBase64)
 	if err != nil {
 		return asset, err
 	}
@@ -100,18 +142,26 @@ func getBondAssetFromClaimStatus(claimStatusBase64 string) (BondAsset, error) {
 
 func getTokenAssetFromClaimStatus(claimStatusBase64 string) (TokenAsset, error) {
 	var asset TokenAsset
-	claimStatus := &common.AssetClaimStatus{}
-	claimStatusSerialized, err := base64.StdEncoding.DecodeString(claimStatusBase64)
-	if err != nil {
-		return asset, err
-	}
-	if len(claimStatusSerialized) == 0 {
-		return asset, fmt.Errorf("empty asset claim status")
-	}
-	err = proto.Unmarshal([]byte(claimStatusSerialized), claimStatus)
+	claimStatus, err := getAssetClaimStatus(claimStatusBase64)
 	if err != nil {
 		return asset, err
 	}
 	err = json.Unmarshal(claimStatus.AssetDetails, &asset)
 	return asset, err
-}
\ No newline at end of file
+}
+
+// getReclaimedAssetPledge rebuilds the (deleted) pledge of a reclaimed asset from the claim status probed in the
+// remote network, to record the reclaim in an event
+func getReclaimedAssetPledge(pledgeAssetDetails []byte, recipientCert, claimStatusBase64 string) (*common.AssetPledge, error) {
+	claimStatus, err := getAssetClaimStatus(claimStatusBase64)
+	if err != nil {
+		return nil, err
+	}
+	return &common.AssetPledge{
+		AssetDetails:    pledgeAssetDetails,
+		LocalNetworkID:  claimStatus.RemoteNetworkID,
+		RemoteNetworkID: claimStatus.LocalNetworkID,
+		Recipient:       recipientCert,
+		ExpiryTimeSecs:  claimStatus.ExpiryTimeSecs,
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 	am "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/interfaces/asset-mgmt/v2"
+	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
 )
 
 // SmartContract provides functions for managing an BondAsset and TokenAsset
@@ -25,6 +26,18 @@ func (s *SmartContract) ConfigureInterop(interopChaincodeId string) {
 	s.amc.Configure(interopChaincodeId)
 }
 
+// SetExpiryGraceWindow is used by a network admin to record the grace window (in seconds) that must elapse after a
+// pledge's expiry time before the asset can be reclaimed; pledges are recorded in this chaincode, so the window set in
+// the interop chaincode (which applies to locks) does not cover them
+func (s *SmartContract) SetExpiryGraceWindow(ctx contractapi.TransactionContextInterface, graceWindowSecs uint64) error {
+	return wutils.SetExpiryGraceWindowSecs(ctx, graceWindowSecs)
+}
+
+// GetExpiryGraceWindow returns the grace window (in seconds) applied to pledges in this chaincode
+func (s *SmartContract) GetExpiryGraceWindow(ctx contractapi.TransactionContextInterface) (uint64, error) {
+	return wutils.GetExpiryGraceWindowSecs(ctx.GetStub())
+}
+
 func (s *SmartContract) InitLedger(ctx contractapi.TransactionContextInterface, interopChaincodeId string, localNetworkId string) error {
 	s.ConfigureInterop(interopChaincodeId)
 	var err error
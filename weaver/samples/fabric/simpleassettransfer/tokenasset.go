@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	wutils "github.com/hyperledger-cacti/cacti/weaver/core/network/fabric-interop-cc/libs/utils/v2"
 	"github.com/hyperledger/fabric-contract-api-go/contractapi"
 )
@@ -228,7 +229,15 @@ func (s *SmartContract) PledgeTokenAsset(ctx contractapi.TransactionContextInter
 	// Pledge the asset using common (library) logic
 	if pledgeId, err := wutils.PledgeAsset(ctx, assetJSON, assetType, strconv.Itoa(int(numUnits)), remoteNetworkId, recipientCert, expiryTimeSecs); err == nil {
 		// Deduce asset balance using app-specific logic
-		return pledgeId, s.DeleteTokenAssets(ctx, assetType, numUnits)
+		err = s.DeleteTokenAssets(ctx, assetType, numUnits)
+		if err != nil {
+			return pledgeId, err
+		}
+		pledge, err := getLocalAssetPledge(ctx, assetJSON, remoteNetworkId, recipientCert, expiryTimeSecs)
+		if err != nil {
+			return pledgeId, err
+		}
+		return pledgeId, emitAssetPledgeEvent(ctx, common.AssetPledgeEventType_PLEDGED, pledgeId, assetType, strconv.Itoa(int(numUnits)), owner, pledge)
 	} else {
 		return "", err
 	}
@@ -269,7 +278,15 @@ func (s *SmartContract) ClaimRemoteTokenAsset(ctx contractapi.TransactionContext
 	}
 
 	// Recreate the asset in this network and chaincode using app-specific logic: make the recipient the owner of the asset
-	return s.IssueTokenAssets(ctx, assetType, asset.NumUnits, claimer)
+	err = s.IssueTokenAssets(ctx, assetType, asset.NumUnits, claimer)
+	if err != nil {
+		return err
+	}
+	pledge, err := getAssetPledge(pledgeBytes64)
+	if err != nil {
+		return err
+	}
+	return emitAssetPledgeEvent(ctx, common.AssetPledgeEventType_REMOTE_CLAIMED, pledgeId, assetType, strconv.Itoa(int(numUnits)), owner, pledge)
 }
 
 // ReclaimTokenAsset gets back the ownership of an asset pledged for transfer to a different ledger/network.
@@ -288,7 +305,15 @@ func (s *SmartContract) ReclaimTokenAsset(ctx contractapi.TransactionContextInte
 	if err != nil {
 		return err
 	}
-	return s.IssueTokenAssets(ctx, pledgeAsset.Type, pledgeAsset.NumUnits, pledgeAsset.Owner)
+	err = s.IssueTokenAssets(ctx, pledgeAsset.Type, pledgeAsset.NumUnits, pledgeAsset.Owner)
+	if err != nil {
+		return err
+	}
+	pledge, err := getReclaimedAssetPledge(pledgeAssetDetails, recipientCert, claimStatusBytes64)
+	if err != nil {
+		return err
+	}
+	return emitAssetPledgeEvent(ctx, common.AssetPledgeEventType_RECLAIMED, pledgeId, pledgeAsset.Type, strconv.Itoa(int(pledgeAsset.NumUnits)), pledgeAsset.Owner, pledge)
 }
 
 // GetTokenAssetPledgeStatus returns the asset pledge status.
@@ -7,14 +7,20 @@ SPDX-License-Identifier: Apache-2.0
 package assetmanager
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/sha3"
 
 	"github.com/golang/protobuf/proto"
 )
@@ -63,9 +69,27 @@ func createFungibleAssetExchangeAgreementSerializedBase64(assetType string, numU
 	return base64.StdEncoding.EncodeToString(assetAgreementBytes), nil
 }
 
+// Create a hybrid asset exchange agreement structure
+func createHybridAssetExchangeAgreementSerializedBase64(assetType string, assetId string, numUnits uint64, recipientECertBase64 string, lockerECertBase64 string) (string, error) {
+	assetAgreement := &common.HybridAssetExchangeAgreement{
+		AssetType: assetType,
+		Id:        assetId,
+		NumUnits:  numUnits,
+		Recipient: recipientECertBase64,
+		Locker:    lockerECertBase64,
+	}
+	assetAgreementBytes, err := proto.Marshal(assetAgreement)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	return base64.StdEncoding.EncodeToString(assetAgreementBytes), nil
+}
+
 // Create an asset lock structure
-func createAssetLockInfoSerializedBase64(hashBase64 string, expiryTimeSecs uint64) (string, error) {
+func createAssetLockInfoSerializedBase64(hashMechanism common.HashMechanism, hashBase64 string, expiryTimeSecs uint64) (string, error) {
 	lockInfoHTLC := &common.AssetLockHTLC{
+		HashMechanism:  hashMechanism,
 		HashBase64:     []byte(hashBase64),
 		ExpiryTimeSecs: expiryTimeSecs,
 		TimeSpec:       common.TimeSpec_EPOCH,
@@ -88,8 +112,9 @@ func createAssetLockInfoSerializedBase64(hashBase64 string, expiryTimeSecs uint6
 }
 
 // Create an asset claim structure
-func createAssetClaimInfoSerializedBase64(hashPreimageBase64 string) (string, error) {
+func createAssetClaimInfoSerializedBase64(hashMechanism common.HashMechanism, hashPreimageBase64 string) (string, error) {
 	claimInfoHTLC := &common.AssetClaimHTLC{
+		HashMechanism:      hashMechanism,
 		HashPreimageBase64: []byte(hashPreimageBase64),
 	}
 	claimInfoHTLCBytes, err := proto.Marshal(claimInfoHTLC)
@@ -109,6 +134,53 @@ func createAssetClaimInfoSerializedBase64(hashPreimageBase64 string) (string, er
 	return base64.StdEncoding.EncodeToString(claimInfoBytes), nil
 }
 
+// Create an asset lock structure for a lock released on signatures from 'threshold' of the 'approvers'
+func createAssetLockSignatureInfoSerializedBase64(approverECertsBase64 []string, threshold uint32, expiryTimeSecs uint64) (string, error) {
+	lockInfoSignature := &common.AssetLockSignature{
+		Approvers:      approverECertsBase64,
+		Threshold:      threshold,
+		ExpiryTimeSecs: expiryTimeSecs,
+		TimeSpec:       common.TimeSpec_EPOCH,
+	}
+	lockInfoSignatureBytes, err := proto.Marshal(lockInfoSignature)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	lockInfo := &common.AssetLock{
+		LockMechanism: common.LockMechanism_SIGNATURE,
+		LockInfo:      lockInfoSignatureBytes,
+	}
+	lockInfoBytes, err := proto.Marshal(lockInfo)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	return base64.StdEncoding.EncodeToString(lockInfoBytes), nil
+}
+
+// Create an asset claim structure carrying approver signatures
+func createAssetClaimSignatureInfoSerializedBase64(signatures []*common.ApproverSignature) (string, error) {
+	claimInfoSignature := &common.AssetClaimSignature{
+		Signatures: signatures,
+	}
+	claimInfoSignatureBytes, err := proto.Marshal(claimInfoSignature)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	claimInfo := &common.AssetClaim{
+		LockMechanism: common.LockMechanism_SIGNATURE,
+		ClaimInfo:     claimInfoSignatureBytes,
+	}
+	claimInfoBytes, err := proto.Marshal(claimInfo)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	return base64.StdEncoding.EncodeToString(claimInfoBytes), nil
+}
+
 // function to generate a "SHA256" hash in base64 format for a given preimage
 func GenerateSHA256HashInBase64Form(hashPreimage string) string {
 	hasher := sha256.New()
@@ -119,8 +191,41 @@ func GenerateSHA256HashInBase64Form(hashPreimage string) string {
 	return shaHashBase64
 }
 
+// function to generate a "Keccak-256" hash (as used by Ethereum) in base64 format for a given preimage
+func GenerateKeccak256HashInBase64Form(hashPreimage string) string {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write([]byte(hashPreimage))
+	shaHash := hasher.Sum(nil)
+	shaHashBase64 := base64.StdEncoding.EncodeToString(shaHash)
+
+	return shaHashBase64
+}
+
+// function to generate a "SHA3-256" hash in base64 format for a given preimage
+func GenerateSHA3_256HashInBase64Form(hashPreimage string) string {
+	hasher := sha3.New256()
+	hasher.Write([]byte(hashPreimage))
+	shaHash := hasher.Sum(nil)
+	shaHashBase64 := base64.StdEncoding.EncodeToString(shaHash)
+
+	return shaHashBase64
+}
+
+// function to check that a hash mechanism is one of those supported by the interop chaincode
+func isHashMechanismSupported(hashMechanism common.HashMechanism) bool {
+	_, isSupported := common.HashMechanism_name[int32(hashMechanism)]
+	return isSupported
+}
+
 func CreateHTLC(contract GatewayContract, assetType string, assetId string, recipientECertBase64 string,
 	hashBase64 string, expiryTimeSecs uint64) (string, error) {
+	return CreateHTLCWithHashMechanism(contract, assetType, assetId, recipientECertBase64, common.HashMechanism_SHA256, hashBase64, expiryTimeSecs)
+}
+
+// function to lock an asset in an HTLC whose hash is computed using the given hash mechanism (e.g., KECCAK256 to share
+// the hash preimage with an HTLC on an Ethereum network)
+func CreateHTLCWithHashMechanism(contract GatewayContract, assetType string, assetId string, recipientECertBase64 string,
+	hashMechanism common.HashMechanism, hashBase64 string, expiryTimeSecs uint64) (string, error) {
 	if contract == nil {
 		return "", logThenErrorf("contract handle not supplied")
 	}
@@ -136,6 +241,9 @@ func CreateHTLC(contract GatewayContract, assetType string, assetId string, reci
 	if hashBase64 == "" {
 		return "", logThenErrorf("hashBase64 is not supplied")
 	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
 	currentTimeSecs := uint64(time.Now().Unix())
 	if expiryTimeSecs <= currentTimeSecs {
 		return "", logThenErrorf("supplied expirty time in the past")
@@ -145,7 +253,7 @@ func CreateHTLC(contract GatewayContract, assetType string, assetId string, reci
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
-	lockInfoStr, err := createAssetLockInfoSerializedBase64(hashBase64, expiryTimeSecs)
+	lockInfoStr, err := createAssetLockInfoSerializedBase64(hashMechanism, hashBase64, expiryTimeSecs)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
@@ -161,6 +269,12 @@ func CreateHTLC(contract GatewayContract, assetType string, assetId string, reci
 
 func CreateFungibleHTLC(contract GatewayContract, assetType string, numUnits uint64, recipientECertBase64 string,
 	hashBase64 string, expiryTimeSecs uint64) (string, error) {
+	return CreateFungibleHTLCWithHashMechanism(contract, assetType, numUnits, recipientECertBase64, common.HashMechanism_SHA256, hashBase64, expiryTimeSecs)
+}
+
+// function to lock a group of fungible assets in an HTLC whose hash is computed using the given hash mechanism
+func CreateFungibleHTLCWithHashMechanism(contract GatewayContract, assetType string, numUnits uint64, recipientECertBase64 string,
+	hashMechanism common.HashMechanism, hashBase64 string, expiryTimeSecs uint64) (string, error) {
 	if contract == nil {
 		return "", logThenErrorf("contract handle not supplied")
 	}
@@ -176,6 +290,9 @@ func CreateFungibleHTLC(contract GatewayContract, assetType string, numUnits uin
 	if hashBase64 == "" {
 		return "", logThenErrorf("hashBase64 is not supplied")
 	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
 	currentTimeSecs := uint64(time.Now().Unix())
 	if expiryTimeSecs <= currentTimeSecs {
 		return "", logThenErrorf("supplied expirty time in the past")
@@ -185,7 +302,7 @@ func CreateFungibleHTLC(contract GatewayContract, assetType string, numUnits uin
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
-	lockInfoStr, err := createAssetLockInfoSerializedBase64(hashBase64, expiryTimeSecs)
+	lockInfoStr, err := createAssetLockInfoSerializedBase64(hashMechanism, hashBase64, expiryTimeSecs)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
@@ -268,6 +385,12 @@ func IsAssetLockedInHTLCqueryUsingContractId(contract GatewayContract, contractI
 }
 
 func ClaimAssetInHTLC(contract GatewayContract, assetType string, assetId string, lockerECertBase64 string, hashPreimageBase64 string) (string, error) {
+	return ClaimAssetInHTLCWithHashMechanism(contract, assetType, assetId, lockerECertBase64, common.HashMechanism_SHA256, hashPreimageBase64)
+}
+
+// function to claim an asset locked in an HTLC whose hash was computed using the given hash mechanism
+func ClaimAssetInHTLCWithHashMechanism(contract GatewayContract, assetType string, assetId string, lockerECertBase64 string,
+	hashMechanism common.HashMechanism, hashPreimageBase64 string) (string, error) {
 	if contract == nil {
 		return "", logThenErrorf("contract handle not supplied")
 	}
@@ -283,8 +406,11 @@ func ClaimAssetInHTLC(contract GatewayContract, assetType string, assetId string
 	if hashPreimageBase64 == "" {
 		return "", logThenErrorf("hashPreimageBase64 is not supplied")
 	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
 
-	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashPreimageBase64)
+	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashMechanism, hashPreimageBase64)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
@@ -304,6 +430,11 @@ func ClaimAssetInHTLC(contract GatewayContract, assetType string, assetId string
 }
 
 func ClaimFungibleAssetInHTLC(contract GatewayContract, contractId string, hashPreimageBase64 string) (string, error) {
+	return ClaimFungibleAssetInHTLCWithHashMechanism(contract, contractId, common.HashMechanism_SHA256, hashPreimageBase64)
+}
+
+// function to claim a group of fungible assets locked in an HTLC whose hash was computed using the given hash mechanism
+func ClaimFungibleAssetInHTLCWithHashMechanism(contract GatewayContract, contractId string, hashMechanism common.HashMechanism, hashPreimageBase64 string) (string, error) {
 	if contract == nil {
 		return "", logThenErrorf("contract handle not supplied")
 	}
@@ -313,8 +444,11 @@ func ClaimFungibleAssetInHTLC(contract GatewayContract, contractId string, hashP
 	if hashPreimageBase64 == "" {
 		return "", logThenErrorf("hashPreimageBase64 is not supplied")
 	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
 
-	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashPreimageBase64)
+	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashMechanism, hashPreimageBase64)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
@@ -328,7 +462,85 @@ func ClaimFungibleAssetInHTLC(contract GatewayContract, contractId string, hashP
 	return string(result), nil
 }
 
+// FungibleAssetLockStatus reports the units of a lock on a group of fungible assets that are still locked, and those
+// claimed so far
+type FungibleAssetLockStatus struct {
+	ContractId   string `json:"contractId"`
+	IsLocked     bool   `json:"isLocked"`
+	LockedUnits  uint64 `json:"lockedUnits"`
+	ClaimedUnits uint64 `json:"claimedUnits"`
+}
+
+func PartialClaimFungibleAssetInHTLC(contract GatewayContract, contractId string, numUnits uint64, hashPreimageBase64 string) (uint64, error) {
+	return PartialClaimFungibleAssetInHTLCWithHashMechanism(contract, contractId, numUnits, common.HashMechanism_SHA256, hashPreimageBase64)
+}
+
+// function to claim some of the units of a group of fungible assets locked in an HTLC, returning the number of units
+// that remain locked under the same contractId
+func PartialClaimFungibleAssetInHTLCWithHashMechanism(contract GatewayContract, contractId string, numUnits uint64, hashMechanism common.HashMechanism, hashPreimageBase64 string) (uint64, error) {
+	if contract == nil {
+		return 0, logThenErrorf("contract handle not supplied")
+	}
+	if contractId == "" {
+		return 0, logThenErrorf("contractId not supplied")
+	}
+	if numUnits <= 0 {
+		return 0, logThenErrorf("asset count must be a positive number")
+	}
+	if hashPreimageBase64 == "" {
+		return 0, logThenErrorf("hashPreimageBase64 is not supplied")
+	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return 0, logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
+
+	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashMechanism, hashPreimageBase64)
+	if err != nil {
+		return 0, logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("PartialClaimFungibleAsset", contractId, strconv.FormatUint(numUnits, 10), claimInfoStr)
+	if err != nil {
+		return 0, logThenErrorf("error in contract.SubmitTransaction PartialClaimFungibleAsset: %+v", err.Error())
+	}
+	remainingUnits, err := strconv.ParseUint(string(result), 10, 64)
+	if err != nil {
+		return 0, logThenErrorf("error in parsing the number of units that remain locked: %+v", err)
+	}
+
+	return remainingUnits, nil
+}
+
+// function to query the units of a fungible asset lock that are still locked, and those claimed so far
+func GetFungibleAssetLockStatus(contract GatewayContract, contractId string) (*FungibleAssetLockStatus, error) {
+	if contract == nil {
+		return nil, logThenErrorf("contract handle not supplied")
+	}
+	if contractId == "" {
+		return nil, logThenErrorf("contractId not supplied")
+	}
+
+	// Normal invoke function
+	result, err := contract.EvaluateTransaction("GetFungibleAssetLockStatus", contractId)
+	if err != nil {
+		return nil, logThenErrorf("error in contract.EvaluateTransaction GetFungibleAssetLockStatus: %+v", err.Error())
+	}
+	lockStatus := &FungibleAssetLockStatus{}
+	err = json.Unmarshal(result, lockStatus)
+	if err != nil {
+		return nil, logThenErrorf("error in unmarshalling the fungible asset lock status: %+v", err)
+	}
+
+	return lockStatus, nil
+}
+
 func ClaimAssetInHTLCusingContractId(contract GatewayContract, contractId string, hashPreimageBase64 string) (string, error) {
+	return ClaimAssetInHTLCusingContractIdWithHashMechanism(contract, contractId, common.HashMechanism_SHA256, hashPreimageBase64)
+}
+
+// function to claim an asset, locked in an HTLC whose hash was computed using the given hash mechanism, by contractId
+func ClaimAssetInHTLCusingContractIdWithHashMechanism(contract GatewayContract, contractId string, hashMechanism common.HashMechanism, hashPreimageBase64 string) (string, error) {
 	if contract == nil {
 		return "", logThenErrorf("contract handle not supplied")
 	}
@@ -338,8 +550,11 @@ func ClaimAssetInHTLCusingContractId(contract GatewayContract, contractId string
 	if hashPreimageBase64 == "" {
 		return "", logThenErrorf("hashPreimageBase64 is not supplied")
 	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
 
-	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashPreimageBase64)
+	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashMechanism, hashPreimageBase64)
 	if err != nil {
 		return "", logThenErrorf(err.Error())
 	}
@@ -414,3 +629,558 @@ func ReclaimAssetInHTLCusingContractId(contract GatewayContract, contractId stri
 
 	return string(result), nil
 }
+
+// function to check the asset type, asset id and number of units of a hybrid asset
+func validateHybridAsset(assetType string, assetId string, numUnits uint64) error {
+	if assetType == "" {
+		return logThenErrorf("asset type not supplied")
+	}
+	if assetId == "" {
+		return logThenErrorf("asset id not supplied")
+	}
+	if numUnits <= 0 {
+		return logThenErrorf("asset count must be a positive number")
+	}
+	return nil
+}
+
+func CreateHybridHTLC(contract GatewayContract, assetType string, assetId string, numUnits uint64, recipientECertBase64 string,
+	hashBase64 string, expiryTimeSecs uint64) (string, error) {
+	return CreateHybridHTLCWithHashMechanism(contract, assetType, assetId, numUnits, recipientECertBase64, common.HashMechanism_SHA256, hashBase64, expiryTimeSecs)
+}
+
+// function to lock a number of units of a hybrid asset (i.e., one with both an identity and a quantity) in an HTLC
+// whose hash is computed using the given hash mechanism
+func CreateHybridHTLCWithHashMechanism(contract GatewayContract, assetType string, assetId string, numUnits uint64, recipientECertBase64 string,
+	hashMechanism common.HashMechanism, hashBase64 string, expiryTimeSecs uint64) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if err := validateHybridAsset(assetType, assetId, numUnits); err != nil {
+		return "", err
+	}
+	if recipientECertBase64 == "" {
+		return "", logThenErrorf("recipientECertBase64 id not supplied")
+	}
+	if hashBase64 == "" {
+		return "", logThenErrorf("hashBase64 is not supplied")
+	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
+	currentTimeSecs := uint64(time.Now().Unix())
+	if expiryTimeSecs <= currentTimeSecs {
+		return "", logThenErrorf("supplied expirty time in the past")
+	}
+
+	assetExchangeAgreementStr, err := createHybridAssetExchangeAgreementSerializedBase64(assetType, assetId, numUnits, recipientECertBase64, "")
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	lockInfoStr, err := createAssetLockInfoSerializedBase64(hashMechanism, hashBase64, expiryTimeSecs)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("LockHybridAsset", assetExchangeAgreementStr, lockInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction LockHybridAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func IsHybridAssetLockedInHTLC(contract GatewayContract, assetType string, assetId string, numUnits uint64, recipientECertBase64 string, lockerECertBase64 string) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if err := validateHybridAsset(assetType, assetId, numUnits); err != nil {
+		return "", err
+	}
+	if recipientECertBase64 == "" {
+		return "", logThenErrorf("recipientECertBase64 id not supplied")
+	}
+	if lockerECertBase64 == "" {
+		return "", logThenErrorf("lockerECertBase64 id not supplied")
+	}
+
+	assetExchangeAgreementStr, err := createHybridAssetExchangeAgreementSerializedBase64(assetType, assetId, numUnits, recipientECertBase64, lockerECertBase64)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.EvaluateTransaction("IsHybridAssetLocked", assetExchangeAgreementStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.EvaluateTransaction IsHybridAssetLocked: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func ClaimHybridAssetInHTLC(contract GatewayContract, assetType string, assetId string, numUnits uint64, lockerECertBase64 string, hashPreimageBase64 string) (string, error) {
+	return ClaimHybridAssetInHTLCWithHashMechanism(contract, assetType, assetId, numUnits, lockerECertBase64, common.HashMechanism_SHA256, hashPreimageBase64)
+}
+
+// function to claim a number of units of a hybrid asset locked in an HTLC whose hash was computed using the given hash mechanism
+func ClaimHybridAssetInHTLCWithHashMechanism(contract GatewayContract, assetType string, assetId string, numUnits uint64, lockerECertBase64 string,
+	hashMechanism common.HashMechanism, hashPreimageBase64 string) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if err := validateHybridAsset(assetType, assetId, numUnits); err != nil {
+		return "", err
+	}
+	if lockerECertBase64 == "" {
+		return "", logThenErrorf("lockerECertBase64 id not supplied")
+	}
+	if hashPreimageBase64 == "" {
+		return "", logThenErrorf("hashPreimageBase64 is not supplied")
+	}
+	if !isHashMechanismSupported(hashMechanism) {
+		return "", logThenErrorf("hash mechanism %d is not supported", hashMechanism)
+	}
+
+	claimInfoStr, err := createAssetClaimInfoSerializedBase64(hashMechanism, hashPreimageBase64)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	assetExchangeAgreementStr, err := createHybridAssetExchangeAgreementSerializedBase64(assetType, assetId, numUnits, "", lockerECertBase64)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("ClaimHybridAsset", assetExchangeAgreementStr, claimInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction ClaimHybridAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func ReclaimHybridAssetInHTLC(contract GatewayContract, assetType string, assetId string, numUnits uint64, recipientECertBase64 string) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if err := validateHybridAsset(assetType, assetId, numUnits); err != nil {
+		return "", err
+	}
+	if recipientECertBase64 == "" {
+		return "", logThenErrorf("recipientECertBase64 id not supplied")
+	}
+
+	assetExchangeAgreementStr, err := createHybridAssetExchangeAgreementSerializedBase64(assetType, assetId, numUnits, recipientECertBase64, "")
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("UnlockHybridAsset", assetExchangeAgreementStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction UnlockHybridAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+// function used by an approver of a signature lock to approve its claim, signing the message "ClaimSignatureLock:"
+// followed by the contractId of the lock; Ed25519 keys sign the message itself, whereas other keys (ECDSA, RSA) sign its
+// "SHA256" hash
+func SignLockContractId(contractId string, approverKey crypto.Signer) ([]byte, error) {
+	if approverKey == nil {
+		return nil, logThenErrorf("approver key not supplied")
+	}
+	if contractId == "" {
+		return nil, logThenErrorf("contractId not supplied")
+	}
+	return signMessage([]byte(fmt.Sprintf("ClaimSignatureLock:%s", contractId)), approverKey)
+}
+
+func signMessage(message []byte, key crypto.Signer) ([]byte, error) {
+	if _, isEd25519 := key.Public().(ed25519.PublicKey); isEd25519 {
+		return key.Sign(rand.Reader, message, crypto.Hash(0))
+	}
+	messageHash := sha256.Sum256(message)
+	return key.Sign(rand.Reader, messageHash[:], crypto.SHA256)
+}
+
+func CreateSignatureLock(contract GatewayContract, assetType string, assetId string, recipientECertBase64 string,
+	approverECertsBase64 []string, threshold uint32, expiryTimeSecs uint64) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if assetType == "" {
+		return "", logThenErrorf("asset type not supplied")
+	}
+	if assetId == "" {
+		return "", logThenErrorf("asset id not supplied")
+	}
+	if recipientECertBase64 == "" {
+		return "", logThenErrorf("recipientECertBase64 id not supplied")
+	}
+	if threshold == 0 || int(threshold) > len(approverECertsBase64) {
+		return "", logThenErrorf("threshold must be between 1 and the number of approvers")
+	}
+	currentTimeSecs := uint64(time.Now().Unix())
+	if expiryTimeSecs <= currentTimeSecs {
+		return "", logThenErrorf("supplied expirty time in the past")
+	}
+
+	assetExchangeAgreementStr, err := createAssetExchangeAgreementSerializedBase64(assetType, assetId, recipientECertBase64, "")
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	lockInfoStr, err := createAssetLockSignatureInfoSerializedBase64(approverECertsBase64, threshold, expiryTimeSecs)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("LockAsset", assetExchangeAgreementStr, lockInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction LockAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func CreateFungibleSignatureLock(contract GatewayContract, assetType string, numUnits uint64, recipientECertBase64 string,
+	approverECertsBase64 []string, threshold uint32, expiryTimeSecs uint64) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if assetType == "" {
+		return "", logThenErrorf("asset type not supplied")
+	}
+	if numUnits <= 0 {
+		return "", logThenErrorf("asset count must be a positive number")
+	}
+	if recipientECertBase64 == "" {
+		return "", logThenErrorf("recipientECertBase64 id not supplied")
+	}
+	if threshold == 0 || int(threshold) > len(approverECertsBase64) {
+		return "", logThenErrorf("threshold must be between 1 and the number of approvers")
+	}
+	currentTimeSecs := uint64(time.Now().Unix())
+	if expiryTimeSecs <= currentTimeSecs {
+		return "", logThenErrorf("supplied expirty time in the past")
+	}
+
+	assetExchangeAgreementStr, err := createFungibleAssetExchangeAgreementSerializedBase64(assetType, numUnits, recipientECertBase64, "")
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	lockInfoStr, err := createAssetLockSignatureInfoSerializedBase64(approverECertsBase64, threshold, expiryTimeSecs)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("LockFungibleAsset", assetExchangeAgreementStr, lockInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction LockFungibleAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func ClaimAssetWithSignatures(contract GatewayContract, assetType string, assetId string, lockerECertBase64 string, signatures []*common.ApproverSignature) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if assetType == "" {
+		return "", logThenErrorf("asset type not supplied")
+	}
+	if assetId == "" {
+		return "", logThenErrorf("asset id not supplied")
+	}
+	if lockerECertBase64 == "" {
+		return "", logThenErrorf("lockerECertBase64 id not supplied")
+	}
+	if len(signatures) == 0 {
+		return "", logThenErrorf("approver signatures are not supplied")
+	}
+
+	claimInfoStr, err := createAssetClaimSignatureInfoSerializedBase64(signatures)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	assetExchangeAgreementStr, err := createAssetExchangeAgreementSerializedBase64(assetType, assetId, "", lockerECertBase64)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("ClaimAsset", assetExchangeAgreementStr, claimInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction ClaimAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func ClaimFungibleAssetWithSignatures(contract GatewayContract, contractId string, signatures []*common.ApproverSignature) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if contractId == "" {
+		return "", logThenErrorf("contractId not supplied")
+	}
+	if len(signatures) == 0 {
+		return "", logThenErrorf("approver signatures are not supplied")
+	}
+
+	claimInfoStr, err := createAssetClaimSignatureInfoSerializedBase64(signatures)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("ClaimFungibleAsset", contractId, claimInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction ClaimFungibleAsset: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+func ClaimAssetWithSignaturesUsingContractId(contract GatewayContract, contractId string, signatures []*common.ApproverSignature) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if contractId == "" {
+		return "", logThenErrorf("contractId not supplied")
+	}
+	if len(signatures) == 0 {
+		return "", logThenErrorf("approver signatures are not supplied")
+	}
+
+	claimInfoStr, err := createAssetClaimSignatureInfoSerializedBase64(signatures)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("ClaimAssetUsingContractId", contractId, claimInfoStr)
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction ClaimAssetUsingContractId: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+// function used by the recipient of a lock to agree to the extension of its expiry to 'newExpiryTimeSecs' (interpreted
+// as an EPOCH time), signing as in SignLockContractId
+func SignExtendLockExpiry(contractId string, newExpiryTimeSecs uint64, recipientKey crypto.Signer) ([]byte, error) {
+	if recipientKey == nil {
+		return nil, logThenErrorf("recipient key not supplied")
+	}
+	if contractId == "" {
+		return nil, logThenErrorf("contractId not supplied")
+	}
+	return signMessage([]byte(fmt.Sprintf("ExtendLockExpiry:%s:%d", contractId, newExpiryTimeSecs)), recipientKey)
+}
+
+// function used by the recipient of a lock to agree to its cancellation, signing as in SignLockContractId
+func SignCancelLock(contractId string, recipientKey crypto.Signer) ([]byte, error) {
+	if recipientKey == nil {
+		return nil, logThenErrorf("recipient key not supplied")
+	}
+	if contractId == "" {
+		return nil, logThenErrorf("contractId not supplied")
+	}
+	return signMessage([]byte(fmt.Sprintf("CancelLock:%s", contractId)), recipientKey)
+}
+
+// function used by the locker to extend the expiry of a lock (on any type of asset), with the recipient's signature
+// generated by SignExtendLockExpiry
+func ExtendLockExpiry(contract GatewayContract, contractId string, newExpiryTimeSecs uint64, recipientSignature []byte) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if contractId == "" {
+		return "", logThenErrorf("contractId not supplied")
+	}
+	if len(recipientSignature) == 0 {
+		return "", logThenErrorf("recipient signature not supplied")
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("ExtendLockExpiry", contractId, strconv.FormatUint(newExpiryTimeSecs, 10), base64.StdEncoding.EncodeToString(recipientSignature))
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction ExtendLockExpiry: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+// function used by the locker to cancel a lock (on any type of asset) before its expiry, with the recipient's
+// signature generated by SignCancelLock
+func CancelLock(contract GatewayContract, contractId string, recipientSignature []byte) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if contractId == "" {
+		return "", logThenErrorf("contractId not supplied")
+	}
+	if len(recipientSignature) == 0 {
+		return "", logThenErrorf("recipient signature not supplied")
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("CancelLock", contractId, base64.StdEncoding.EncodeToString(recipientSignature))
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction CancelLock: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
+
+// Specification of one HTLC lock in a batch passed to CreateHTLCBatch
+type HTLCLock struct {
+	AssetType            string
+	AssetId              string
+	RecipientECertBase64 string
+	HashMechanism        common.HashMechanism // SHA256 if not set
+	HashBase64           string
+	ExpiryTimeSecs       uint64
+}
+
+// Specification of one HTLC claim in a batch passed to ClaimHTLCBatch
+type HTLCClaim struct {
+	AssetType          string
+	AssetId            string
+	LockerECertBase64  string
+	HashMechanism      common.HashMechanism // SHA256 if not set
+	HashPreimageBase64 string
+}
+
+// function to lock a batch of assets in HTLCs in a single transaction; either all the assets get locked or none does
+func CreateHTLCBatch(contract GatewayContract, htlcLocks []HTLCLock) ([]string, error) {
+	if contract == nil {
+		return []string{}, logThenErrorf("contract handle not supplied")
+	}
+	if len(htlcLocks) == 0 {
+		return []string{}, logThenErrorf("HTLC locks not supplied")
+	}
+
+	currentTimeSecs := uint64(time.Now().Unix())
+	assetExchangeAgreementStrs := []string{}
+	lockInfoStrs := []string{}
+	for i, htlcLock := range htlcLocks {
+		if htlcLock.AssetType == "" {
+			return []string{}, logThenErrorf("asset type not supplied in HTLC lock %d", i)
+		}
+		if htlcLock.AssetId == "" {
+			return []string{}, logThenErrorf("asset id not supplied in HTLC lock %d", i)
+		}
+		if htlcLock.RecipientECertBase64 == "" {
+			return []string{}, logThenErrorf("recipientECertBase64 id not supplied in HTLC lock %d", i)
+		}
+		if htlcLock.HashBase64 == "" {
+			return []string{}, logThenErrorf("hashBase64 is not supplied in HTLC lock %d", i)
+		}
+		if !isHashMechanismSupported(htlcLock.HashMechanism) {
+			return []string{}, logThenErrorf("hash mechanism %d is not supported in HTLC lock %d", htlcLock.HashMechanism, i)
+		}
+		if htlcLock.ExpiryTimeSecs <= currentTimeSecs {
+			return []string{}, logThenErrorf("supplied expirty time in the past in HTLC lock %d", i)
+		}
+
+		assetExchangeAgreementStr, err := createAssetExchangeAgreementSerializedBase64(htlcLock.AssetType, htlcLock.AssetId, htlcLock.RecipientECertBase64, "")
+		if err != nil {
+			return []string{}, logThenErrorf(err.Error())
+		}
+		lockInfoStr, err := createAssetLockInfoSerializedBase64(htlcLock.HashMechanism, htlcLock.HashBase64, htlcLock.ExpiryTimeSecs)
+		if err != nil {
+			return []string{}, logThenErrorf(err.Error())
+		}
+		assetExchangeAgreementStrs = append(assetExchangeAgreementStrs, assetExchangeAgreementStr)
+		lockInfoStrs = append(lockInfoStrs, lockInfoStr)
+	}
+
+	assetExchangeAgreementsJSON, err := json.Marshal(assetExchangeAgreementStrs)
+	if err != nil {
+		return []string{}, logThenErrorf(err.Error())
+	}
+	lockInfosJSON, err := json.Marshal(lockInfoStrs)
+	if err != nil {
+		return []string{}, logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("LockAssetBatch", string(assetExchangeAgreementsJSON), string(lockInfosJSON))
+	if err != nil {
+		return []string{}, logThenErrorf("error in contract.SubmitTransaction LockAssetBatch: %+v", err.Error())
+	}
+
+	contractIds := []string{}
+	err = json.Unmarshal(result, &contractIds)
+	if err != nil {
+		return []string{}, logThenErrorf("error in parsing the contractIds returned by LockAssetBatch: %+v", err.Error())
+	}
+
+	return contractIds, nil
+}
+
+// function to claim a batch of assets locked in HTLCs in a single transaction; either all the assets get claimed or none does
+func ClaimHTLCBatch(contract GatewayContract, htlcClaims []HTLCClaim) (string, error) {
+	if contract == nil {
+		return "", logThenErrorf("contract handle not supplied")
+	}
+	if len(htlcClaims) == 0 {
+		return "", logThenErrorf("HTLC claims not supplied")
+	}
+
+	assetExchangeAgreementStrs := []string{}
+	claimInfoStrs := []string{}
+	for i, htlcClaim := range htlcClaims {
+		if htlcClaim.AssetType == "" {
+			return "", logThenErrorf("asset type not supplied in HTLC claim %d", i)
+		}
+		if htlcClaim.AssetId == "" {
+			return "", logThenErrorf("asset id not supplied in HTLC claim %d", i)
+		}
+		if htlcClaim.LockerECertBase64 == "" {
+			return "", logThenErrorf("lockerECertBase64 id not supplied in HTLC claim %d", i)
+		}
+		if htlcClaim.HashPreimageBase64 == "" {
+			return "", logThenErrorf("hashPreimageBase64 is not supplied in HTLC claim %d", i)
+		}
+		if !isHashMechanismSupported(htlcClaim.HashMechanism) {
+			return "", logThenErrorf("hash mechanism %d is not supported in HTLC claim %d", htlcClaim.HashMechanism, i)
+		}
+
+		assetExchangeAgreementStr, err := createAssetExchangeAgreementSerializedBase64(htlcClaim.AssetType, htlcClaim.AssetId, "", htlcClaim.LockerECertBase64)
+		if err != nil {
+			return "", logThenErrorf(err.Error())
+		}
+		claimInfoStr, err := createAssetClaimInfoSerializedBase64(htlcClaim.HashMechanism, htlcClaim.HashPreimageBase64)
+		if err != nil {
+			return "", logThenErrorf(err.Error())
+		}
+		assetExchangeAgreementStrs = append(assetExchangeAgreementStrs, assetExchangeAgreementStr)
+		claimInfoStrs = append(claimInfoStrs, claimInfoStr)
+	}
+
+	assetExchangeAgreementsJSON, err := json.Marshal(assetExchangeAgreementStrs)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+	claimInfosJSON, err := json.Marshal(claimInfoStrs)
+	if err != nil {
+		return "", logThenErrorf(err.Error())
+	}
+
+	// Normal invoke function
+	result, err := contract.SubmitTransaction("ClaimAssetBatch", string(assetExchangeAgreementsJSON), string(claimInfosJSON))
+	if err != nil {
+		return "", logThenErrorf("error in contract.SubmitTransaction ClaimAssetBatch: %+v", err.Error())
+	}
+
+	return string(result), nil
+}
@@ -7,20 +7,33 @@ SPDX-License-Identifier: Apache-2.0
 package assetmanager_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	"github.com/stretchr/testify/require"
 	assetmanager "github.com/hyperledger-cacti/cacti/weaver/sdks/fabric/go-sdk/v2/asset-manager"
 )
 
 var submitTransactionMock func() ([]byte, error)
 var evaluateTransactionMock func() ([]byte, error)
+var submittedArgs []string
 
 type gatewayContractMock struct{}
 
 func (gwMock gatewayContractMock) SubmitTransaction(ccFunc string, args ...string) ([]byte, error) {
+	submittedArgs = args
 	return submitTransactionMock()
 }
 
@@ -101,6 +114,57 @@ func TestCreateHTLC(t *testing.T) {
 	require.EqualError(t, err, expectedError)
 }
 
+func TestCreateHTLCWithHashMechanism(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("contract-id"), nil
+	}
+
+	// the hashes match the standard test vectors, so that a preimage can unlock HTLCs on Ethereum networks as well
+	keccak256Hash, _ := base64.StdEncoding.DecodeString(assetmanager.GenerateKeccak256HashInBase64Form("abc"))
+	require.Equal(t, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45", hex.EncodeToString(keccak256Hash))
+	sha3_256Hash, _ := base64.StdEncoding.DecodeString(assetmanager.GenerateSHA3_256HashInBase64Form("abc"))
+	require.Equal(t, "3a985da74fe225b2045c172d6bd390bd855f086e3e9d525b46bfe24511431532", hex.EncodeToString(sha3_256Hash))
+
+	hashBase64 := assetmanager.GenerateKeccak256HashInBase64Form("hashPreimage")
+	expiryTimeSecs := uint64(time.Now().Unix()) + 10
+
+	expectedError := "hash mechanism 9 is not supported"
+	_, err := assetmanager.CreateHTLCWithHashMechanism(contract, "asset-type", "asset-id", "recipientECertBase64", common.HashMechanism(9), hashBase64, expiryTimeSecs)
+	require.EqualError(t, err, expectedError)
+
+	contractId, err := assetmanager.CreateHTLCWithHashMechanism(contract, "asset-type", "asset-id", "recipientECertBase64", common.HashMechanism_KECCAK256, hashBase64, expiryTimeSecs)
+	require.NoError(t, err)
+	require.Equal(t, "contract-id", contractId)
+	lockInfoBytes, err := base64.StdEncoding.DecodeString(submittedArgs[1])
+	require.NoError(t, err)
+	lockInfo := &common.AssetLock{}
+	require.NoError(t, proto.Unmarshal(lockInfoBytes, lockInfo))
+	lockInfoHTLC := &common.AssetLockHTLC{}
+	require.NoError(t, proto.Unmarshal(lockInfo.LockInfo, lockInfoHTLC))
+	require.Equal(t, common.HashMechanism_KECCAK256, lockInfoHTLC.HashMechanism)
+	require.Equal(t, hashBase64, string(lockInfoHTLC.HashBase64))
+
+	contractId, err = assetmanager.CreateFungibleHTLCWithHashMechanism(contract, "asset-type", 10, "recipientECertBase64", common.HashMechanism_SHA3_256, hashBase64, expiryTimeSecs)
+	require.NoError(t, err)
+	require.Equal(t, "contract-id", contractId)
+	lockInfoBytes, err = base64.StdEncoding.DecodeString(submittedArgs[1])
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(lockInfoBytes, lockInfo))
+	require.NoError(t, proto.Unmarshal(lockInfo.LockInfo, lockInfoHTLC))
+	require.Equal(t, common.HashMechanism_SHA3_256, lockInfoHTLC.HashMechanism)
+
+	// the hash mechanism defaults to SHA256
+	_, err = assetmanager.CreateHTLC(contract, "asset-type", "asset-id", "recipientECertBase64", hashBase64, expiryTimeSecs)
+	require.NoError(t, err)
+	lockInfoBytes, err = base64.StdEncoding.DecodeString(submittedArgs[1])
+	require.NoError(t, err)
+	require.NoError(t, proto.Unmarshal(lockInfoBytes, lockInfo))
+	require.NoError(t, proto.Unmarshal(lockInfo.LockInfo, lockInfoHTLC))
+	require.Equal(t, common.HashMechanism_SHA256, lockInfoHTLC.HashMechanism)
+}
+
 func TestCreateFungibleHTLC(t *testing.T) {
 
 	contract := gatewayContractMock{}
@@ -382,6 +446,46 @@ func TestClaimAssetInHTLC(t *testing.T) {
 	require.EqualError(t, err, expectedError)
 }
 
+func TestClaimAssetInHTLCWithHashMechanism(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("true"), nil
+	}
+
+	expectedError := "hash mechanism 9 is not supported"
+	_, err := assetmanager.ClaimAssetInHTLCWithHashMechanism(contract, "asset-type", "asset-id", "lockerECertBase64", common.HashMechanism(9), "hashPreimageBase64")
+	require.EqualError(t, err, expectedError)
+
+	getClaimedHashMechanism := func() common.HashMechanism {
+		claimInfoBytes, err := base64.StdEncoding.DecodeString(submittedArgs[1])
+		require.NoError(t, err)
+		claimInfo := &common.AssetClaim{}
+		require.NoError(t, proto.Unmarshal(claimInfoBytes, claimInfo))
+		claimInfoHTLC := &common.AssetClaimHTLC{}
+		require.NoError(t, proto.Unmarshal(claimInfo.ClaimInfo, claimInfoHTLC))
+		require.Equal(t, "hashPreimageBase64", string(claimInfoHTLC.HashPreimageBase64))
+		return claimInfoHTLC.HashMechanism
+	}
+
+	isClaimed, err := assetmanager.ClaimAssetInHTLCWithHashMechanism(contract, "asset-type", "asset-id", "lockerECertBase64", common.HashMechanism_KECCAK256, "hashPreimageBase64")
+	require.NoError(t, err)
+	require.Equal(t, "true", isClaimed)
+	require.Equal(t, common.HashMechanism_KECCAK256, getClaimedHashMechanism())
+
+	_, err = assetmanager.ClaimFungibleAssetInHTLCWithHashMechanism(contract, "contract-id", common.HashMechanism_SHA3_256, "hashPreimageBase64")
+	require.NoError(t, err)
+	require.Equal(t, common.HashMechanism_SHA3_256, getClaimedHashMechanism())
+
+	_, err = assetmanager.ClaimAssetInHTLCusingContractIdWithHashMechanism(contract, "contract-id", common.HashMechanism_KECCAK256, "hashPreimageBase64")
+	require.NoError(t, err)
+	require.Equal(t, common.HashMechanism_KECCAK256, getClaimedHashMechanism())
+
+	_, err = assetmanager.ClaimAssetInHTLCusingContractId(contract, "contract-id", "hashPreimageBase64")
+	require.NoError(t, err)
+	require.Equal(t, common.HashMechanism_SHA256, getClaimedHashMechanism())
+}
+
 func TestClaimFungibleAssetInHTLC(t *testing.T) {
 
 	contract := gatewayContractMock{}
@@ -430,6 +534,45 @@ func TestClaimFungibleAssetInHTLC(t *testing.T) {
 	require.EqualError(t, err, expectedError)
 }
 
+func TestPartialClaimFungibleAssetInHTLC(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("700"), nil
+	}
+	evaluateTransactionMock = func() ([]byte, error) {
+		return []byte(`{"contractId":"contract-id","isLocked":true,"lockedUnits":700,"claimedUnits":300}`), nil
+	}
+
+	contractId := "contract-id"
+	hashPreimageBase64 := "hashPreimage"
+
+	expectedError := "contractId not supplied"
+	_, err := assetmanager.PartialClaimFungibleAssetInHTLC(contract, "", 300, hashPreimageBase64)
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "asset count must be a positive number"
+	_, err = assetmanager.PartialClaimFungibleAssetInHTLC(contract, contractId, 0, hashPreimageBase64)
+	require.EqualError(t, err, expectedError)
+
+	remainingUnits, err := assetmanager.PartialClaimFungibleAssetInHTLC(contract, contractId, 300, hashPreimageBase64)
+	require.NoError(t, err)
+	require.Equal(t, uint64(700), remainingUnits)
+	require.Equal(t, contractId, submittedArgs[0])
+	require.Equal(t, "300", submittedArgs[1])
+
+	lockStatus, err := assetmanager.GetFungibleAssetLockStatus(contract, contractId)
+	require.NoError(t, err)
+	require.Equal(t, assetmanager.FungibleAssetLockStatus{ContractId: contractId, IsLocked: true, LockedUnits: 700, ClaimedUnits: 300}, *lockStatus)
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction PartialClaimFungibleAsset: failed submission"
+	_, err = assetmanager.PartialClaimFungibleAssetInHTLC(contract, contractId, 300, hashPreimageBase64)
+	require.EqualError(t, err, expectedError)
+}
+
 func TestClaimAssetInHTLCusingContractId(t *testing.T) {
 
 	contract := gatewayContractMock{}
@@ -613,3 +756,352 @@ func TestReclaimAssetInHTLCusingContractId(t *testing.T) {
 	}
 	require.EqualError(t, err, expectedError)
 }
+
+func TestHybridHTLC(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("contract-id"), nil
+	}
+	evaluateTransactionMock = func() ([]byte, error) {
+		return []byte("true"), nil
+	}
+
+	assetType := "asset-type"
+	assetId := "asset-id"
+	numUnits := uint64(10)
+	recipientECertBase64 := "recipientECertBase64"
+	lockerECertBase64 := "lockerECertBase64"
+	hashPreimageBase64 := "hashPreimage"
+	hashBase64 := assetmanager.GenerateSHA256HashInBase64Form(hashPreimageBase64)
+	expiryTimeSecs := uint64(time.Now().Unix()) + 10
+
+	expectedError := "asset id not supplied"
+	_, err := assetmanager.CreateHybridHTLC(contract, assetType, "", numUnits, recipientECertBase64, hashBase64, expiryTimeSecs)
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "asset count must be a positive number"
+	_, err = assetmanager.CreateHybridHTLC(contract, assetType, assetId, 0, recipientECertBase64, hashBase64, expiryTimeSecs)
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "supplied expirty time in the past"
+	_, err = assetmanager.CreateHybridHTLC(contract, assetType, assetId, numUnits, recipientECertBase64, hashBase64, expiryTimeSecs-20)
+	require.EqualError(t, err, expectedError)
+
+	// the agreement submitted for a lock carries both the asset id and the number of units
+	contractId, err := assetmanager.CreateHybridHTLC(contract, assetType, assetId, numUnits, recipientECertBase64, hashBase64, expiryTimeSecs)
+	require.NoError(t, err)
+	require.Equal(t, "contract-id", contractId)
+	assetAgreementBytes, err := base64.StdEncoding.DecodeString(submittedArgs[0])
+	require.NoError(t, err)
+	assetAgreement := &common.HybridAssetExchangeAgreement{}
+	require.NoError(t, proto.Unmarshal(assetAgreementBytes, assetAgreement))
+	require.Equal(t, assetId, assetAgreement.Id)
+	require.Equal(t, numUnits, assetAgreement.NumUnits)
+	require.Equal(t, recipientECertBase64, assetAgreement.Recipient)
+
+	isLocked, err := assetmanager.IsHybridAssetLockedInHTLC(contract, assetType, assetId, numUnits, recipientECertBase64, lockerECertBase64)
+	require.NoError(t, err)
+	require.Equal(t, "true", isLocked)
+
+	expectedError = "lockerECertBase64 id not supplied"
+	_, err = assetmanager.ClaimHybridAssetInHTLC(contract, assetType, assetId, numUnits, "", hashPreimageBase64)
+	require.EqualError(t, err, expectedError)
+
+	_, err = assetmanager.ClaimHybridAssetInHTLC(contract, assetType, assetId, numUnits, lockerECertBase64, hashPreimageBase64)
+	require.NoError(t, err)
+	claimInfoBytes, err := base64.StdEncoding.DecodeString(submittedArgs[1])
+	require.NoError(t, err)
+	claimInfo := &common.AssetClaim{}
+	require.NoError(t, proto.Unmarshal(claimInfoBytes, claimInfo))
+	claimInfoHTLC := &common.AssetClaimHTLC{}
+	require.NoError(t, proto.Unmarshal(claimInfo.ClaimInfo, claimInfoHTLC))
+	require.Equal(t, common.HashMechanism_SHA256, claimInfoHTLC.HashMechanism)
+	require.Equal(t, hashPreimageBase64, string(claimInfoHTLC.HashPreimageBase64))
+
+	_, err = assetmanager.ReclaimHybridAssetInHTLC(contract, assetType, assetId, numUnits, recipientECertBase64)
+	require.NoError(t, err)
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction UnlockHybridAsset: failed submission"
+	_, err = assetmanager.ReclaimHybridAssetInHTLC(contract, assetType, assetId, numUnits, recipientECertBase64)
+	require.EqualError(t, err, expectedError)
+}
+
+func TestSignLockContractId(t *testing.T) {
+
+	contractId := "contract-id"
+	claimMessage := []byte("ClaimSignatureLock:" + contractId)
+	claimMessageHash := sha256.Sum256(claimMessage)
+
+	ecdsaKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	signature, err := assetmanager.SignLockContractId(contractId, ecdsaKey)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.True(t, ecdsa.VerifyASN1(&ecdsaKey.PublicKey, claimMessageHash[:], signature))
+
+	ed25519PubKey, ed25519Key, _ := ed25519.GenerateKey(rand.Reader)
+	signature, err = assetmanager.SignLockContractId(contractId, ed25519Key)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.True(t, ed25519.Verify(ed25519PubKey, claimMessage, signature))
+
+	expectedError := "approver key not supplied"
+	_, err = assetmanager.SignLockContractId(contractId, nil)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "contractId not supplied"
+	_, err = assetmanager.SignLockContractId("", ecdsaKey)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+}
+
+func TestExtendLockExpiryAndCancelLock(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), nil
+	}
+
+	contractId := "contract-id"
+	newExpiryTimeSecs := uint64(time.Now().Unix()) + 600
+
+	ecdsaKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	extendSignature, err := assetmanager.SignExtendLockExpiry(contractId, newExpiryTimeSecs, ecdsaKey)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	extendMessageHash := sha256.Sum256([]byte(fmt.Sprintf("ExtendLockExpiry:%s:%d", contractId, newExpiryTimeSecs)))
+	require.True(t, ecdsa.VerifyASN1(&ecdsaKey.PublicKey, extendMessageHash[:], extendSignature))
+
+	ed25519PubKey, ed25519Key, _ := ed25519.GenerateKey(rand.Reader)
+	cancelSignature, err := assetmanager.SignCancelLock(contractId, ed25519Key)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.True(t, ed25519.Verify(ed25519PubKey, []byte("CancelLock:"+contractId), cancelSignature))
+
+	expectedError := "recipient key not supplied"
+	_, err = assetmanager.SignCancelLock(contractId, nil)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "recipient signature not supplied"
+	_, err = assetmanager.ExtendLockExpiry(contract, contractId, newExpiryTimeSecs, nil)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	_, err = assetmanager.ExtendLockExpiry(contract, contractId, newExpiryTimeSecs, extendSignature)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.Equal(t, []string{contractId, strconv.FormatUint(newExpiryTimeSecs, 10), base64.StdEncoding.EncodeToString(extendSignature)}, submittedArgs)
+
+	_, err = assetmanager.CancelLock(contract, contractId, cancelSignature)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.Equal(t, []string{contractId, base64.StdEncoding.EncodeToString(cancelSignature)}, submittedArgs)
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction CancelLock: failed submission"
+	_, err = assetmanager.CancelLock(contract, contractId, cancelSignature)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+}
+
+func TestCreateSignatureLock(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("contract-id"), nil
+	}
+
+	assetType := "asset-type"
+	assetId := "asset-id"
+	recipientECertBase64 := "recipientECertBase64"
+	approverECertsBase64 := []string{"arbiterECertBase64", "escrowECertBase64"}
+	expiryTimeSecs := uint64(time.Now().Unix()) + 300
+
+	expectedError := "contract handle not supplied"
+	_, err := assetmanager.CreateSignatureLock(nil, assetType, assetId, recipientECertBase64, approverECertsBase64, 2, expiryTimeSecs)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "threshold must be between 1 and the number of approvers"
+	_, err = assetmanager.CreateSignatureLock(contract, assetType, assetId, recipientECertBase64, approverECertsBase64, 3, expiryTimeSecs)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "supplied expirty time in the past"
+	_, err = assetmanager.CreateSignatureLock(contract, assetType, assetId, recipientECertBase64, approverECertsBase64, 2, expiryTimeSecs-600)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	contractId, err := assetmanager.CreateSignatureLock(contract, assetType, assetId, recipientECertBase64, approverECertsBase64, 2, expiryTimeSecs)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.Equal(t, contractId, "contract-id")
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction LockFungibleAsset: failed submission"
+	_, err = assetmanager.CreateFungibleSignatureLock(contract, assetType, 10, recipientECertBase64, approverECertsBase64, 1, expiryTimeSecs)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+}
+
+func TestClaimFungibleAssetWithSignatures(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("true"), nil
+	}
+
+	contractId := "contract-id"
+	signatures := []*common.ApproverSignature{{Approver: "arbiterECertBase64", Signature: []byte("signature")}}
+
+	expectedError := "contractId not supplied"
+	_, err := assetmanager.ClaimFungibleAssetWithSignatures(contract, "", signatures)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "approver signatures are not supplied"
+	_, err = assetmanager.ClaimFungibleAssetWithSignatures(contract, contractId, nil)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+
+	isClaimed, err := assetmanager.ClaimFungibleAssetWithSignatures(contract, contractId, signatures)
+	if err != nil {
+		t.Error("failed with error: ", err.Error())
+	}
+	require.Equal(t, isClaimed, "true")
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction ClaimAssetUsingContractId: failed submission"
+	_, err = assetmanager.ClaimAssetWithSignaturesUsingContractId(contract, contractId, signatures)
+	if err == nil {
+		t.Error("expected to fail with error " + expectedError + " but didn't")
+	}
+	require.EqualError(t, err, expectedError)
+}
+
+func TestCreateHTLCBatch(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(`["contract-id-1","contract-id-2"]`), nil
+	}
+
+	hashBase64 := assetmanager.GenerateSHA256HashInBase64Form("hashPreimage")
+	htlcLocks := []assetmanager.HTLCLock{
+		{AssetType: "asset-type", AssetId: "asset-id-1", RecipientECertBase64: "recipientECertBase64", HashBase64: hashBase64, ExpiryTimeSecs: uint64(time.Now().Unix()) + 10},
+		{AssetType: "asset-type", AssetId: "asset-id-2", RecipientECertBase64: "recipientECertBase64", HashBase64: hashBase64, ExpiryTimeSecs: uint64(time.Now().Unix()) - 10},
+	}
+
+	expectedError := "contract handle not supplied"
+	_, err := assetmanager.CreateHTLCBatch(nil, htlcLocks)
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "HTLC locks not supplied"
+	_, err = assetmanager.CreateHTLCBatch(contract, []assetmanager.HTLCLock{})
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "supplied expirty time in the past in HTLC lock 1"
+	_, err = assetmanager.CreateHTLCBatch(contract, htlcLocks)
+	require.EqualError(t, err, expectedError)
+
+	htlcLocks[1].ExpiryTimeSecs = uint64(time.Now().Unix()) + 10
+	htlcLocks[1].AssetId = ""
+	expectedError = "asset id not supplied in HTLC lock 1"
+	_, err = assetmanager.CreateHTLCBatch(contract, htlcLocks)
+	require.EqualError(t, err, expectedError)
+
+	htlcLocks[1].AssetId = "asset-id-2"
+	htlcLocks[1].HashMechanism = common.HashMechanism(9)
+	expectedError = "hash mechanism 9 is not supported in HTLC lock 1"
+	_, err = assetmanager.CreateHTLCBatch(contract, htlcLocks)
+	require.EqualError(t, err, expectedError)
+
+	htlcLocks[1].HashMechanism = common.HashMechanism_KECCAK256
+	contractIds, err := assetmanager.CreateHTLCBatch(contract, htlcLocks)
+	require.NoError(t, err)
+	require.Equal(t, []string{"contract-id-1", "contract-id-2"}, contractIds)
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction LockAssetBatch: failed submission"
+	_, err = assetmanager.CreateHTLCBatch(contract, htlcLocks)
+	require.EqualError(t, err, expectedError)
+}
+
+func TestClaimHTLCBatch(t *testing.T) {
+
+	contract := gatewayContractMock{}
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte("true"), nil
+	}
+
+	htlcClaims := []assetmanager.HTLCClaim{
+		{AssetType: "asset-type", AssetId: "asset-id-1", LockerECertBase64: "lockerECertBase64", HashPreimageBase64: "hashPreimageBase64"},
+		{AssetType: "asset-type", AssetId: "asset-id-2", LockerECertBase64: "lockerECertBase64", HashPreimageBase64: ""},
+	}
+
+	expectedError := "contract handle not supplied"
+	_, err := assetmanager.ClaimHTLCBatch(nil, htlcClaims)
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "HTLC claims not supplied"
+	_, err = assetmanager.ClaimHTLCBatch(contract, nil)
+	require.EqualError(t, err, expectedError)
+
+	expectedError = "hashPreimageBase64 is not supplied in HTLC claim 1"
+	_, err = assetmanager.ClaimHTLCBatch(contract, htlcClaims)
+	require.EqualError(t, err, expectedError)
+
+	htlcClaims[1].HashPreimageBase64 = "hashPreimageBase64"
+	isClaimed, err := assetmanager.ClaimHTLCBatch(contract, htlcClaims)
+	require.NoError(t, err)
+	require.Equal(t, "true", isClaimed)
+
+	submitTransactionMock = func() ([]byte, error) {
+		return []byte(""), errors.New("failed submission")
+	}
+	expectedError = "error in contract.SubmitTransaction ClaimAssetBatch: failed submission"
+	_, err = assetmanager.ClaimHTLCBatch(contract, htlcClaims)
+	require.EqualError(t, err, expectedError)
+}
@@ -11,6 +11,7 @@ require (
 	github.com/hyperledger/fabric-protos-go v0.3.3
 	github.com/sirupsen/logrus v1.8.1
 	github.com/stretchr/testify v1.8.1
+	golang.org/x/crypto v0.35.0
 	google.golang.org/grpc v1.64.1
 	google.golang.org/protobuf v1.36.5
 )
@@ -17,6 +17,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/besu"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/common"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/corda"
 	"github.com/hyperledger-cacti/cacti/weaver/common/protos-go/v2/fabric"
@@ -286,6 +287,22 @@ func GetResponseDataFromView(view *common.View) ([]byte, error) {
 				}
 			}
 		}
+	} else if view.Meta.Protocol == common.Meta_ETHEREUM {
+		var besuViewData besu.BesuView
+		err := protoV2.Unmarshal(view.Data, &besuViewData)
+		if err != nil {
+			return nil, logThenErrorf("besuView unmarshal error: %s", err.Error())
+		}
+		var interopPayload common.InteropPayload
+		err = protoV2.Unmarshal(besuViewData.InteropPayload, &interopPayload)
+		if err != nil {
+			return nil, logThenErrorf("unable to unmarshal interopPayload: %s", err.Error())
+		}
+		if interopPayload.GetConfidential() {
+			// TODO Add support for confidential (encrypted) view payloads
+			return nil, logThenErrorf("Encrypted view payloads not currently supported in Weaver Go SDK")
+		}
+		viewPayload = interopPayload.GetPayload()
 	} else {
 		return nil, logThenErrorf("cannot extract data from view; unsupported DLT type: %+v", view.Meta.Protocol)
 	}
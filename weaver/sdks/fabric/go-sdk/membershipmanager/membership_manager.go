@@ -213,16 +213,7 @@ func GetMembershipForMspIdFromBlock(block *common.Block, mspId string) (*cactipr
 				}
 
 				if fabricMspConfig.GetName() == mspId {
-					memberUnit := &cactiprotos.Member{}
-					memberUnit.Type = "certificate"
-					memberUnit.Value = ""
-					memberUnit.Chain = []string{}
-					for _, certBytes := range fabricMspConfig.GetRootCerts() {
-						memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
-					}
-					for _, certBytes := range fabricMspConfig.GetIntermediateCerts() {
-						memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
-					}
+					memberUnit := getMemberFromFabricMSPConfig(&fabricMspConfig)
 					return memberUnit, nil
 				}
 			}
@@ -232,6 +223,26 @@ func GetMembershipForMspIdFromBlock(block *common.Block, mspId string) (*cactipr
 	return nil, nil
 }
 
+// getMemberFromFabricMSPConfig builds the membership unit of an MSP: its root and intermediate CA certificates form
+// the chain, and its revocation list (holding PEM-encoded CRLs) the CRLs
+func getMemberFromFabricMSPConfig(fabricMspConfig *mspprotos.FabricMSPConfig) *cactiprotos.Member {
+	memberUnit := &cactiprotos.Member{}
+	memberUnit.Type = "certificate"
+	memberUnit.Value = ""
+	memberUnit.Chain = []string{}
+	for _, certBytes := range fabricMspConfig.GetRootCerts() {
+		memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
+	}
+	for _, certBytes := range fabricMspConfig.GetIntermediateCerts() {
+		memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
+	}
+	memberUnit.Crls = []string{}
+	for _, crlBytes := range fabricMspConfig.GetRevocationList() {
+		memberUnit.Crls = append(memberUnit.Crls, string(crlBytes))
+	}
+	return memberUnit
+}
+
 func GetMembershipForMspIdsFromBlock(block *common.Block, mspIds []string) (*cactiprotos.Membership, error) {
 	// Convert slice to map
 	var mspMap = make(map[string]bool)
@@ -292,16 +303,7 @@ func GetMembershipForMspIdsFromBlock(block *common.Block, mspIds []string) (*cac
 				}
 
 				if mspMap[fabricMspConfig.GetName()] == true {
-					memberUnit := &cactiprotos.Member{}
-					memberUnit.Type = "certificate"
-					memberUnit.Value = ""
-					memberUnit.Chain = []string{}
-					for _, certBytes := range fabricMspConfig.GetRootCerts() {
-						memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
-					}
-					for _, certBytes := range fabricMspConfig.GetIntermediateCerts() {
-						memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
-					}
+					memberUnit := getMemberFromFabricMSPConfig(&fabricMspConfig)
 					membership.Members[fabricMspConfig.GetName()] = memberUnit
 				}
 			}
@@ -371,16 +373,7 @@ func GetMembershipForAllMspIdsFromBlock(block *common.Block, ordererMspIds []str
 				}
 
 				if _, isOrdererMspId := ordererMspMap[fabricMspConfig.GetName()]; !isOrdererMspId {
-					memberUnit := &cactiprotos.Member{}
-					memberUnit.Type = "certificate"
-					memberUnit.Value = ""
-					memberUnit.Chain = []string{}
-					for _, certBytes := range fabricMspConfig.GetRootCerts() {
-						memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
-					}
-					for _, certBytes := range fabricMspConfig.GetIntermediateCerts() {
-						memberUnit.Chain = append(memberUnit.Chain, string(certBytes))
-					}
+					memberUnit := getMemberFromFabricMSPConfig(&fabricMspConfig)
 					membership.Members[fabricMspConfig.GetName()] = memberUnit
 				}
 			}
@@ -11,10 +11,86 @@ import (
 	"os"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/common"
+	mspprotos "github.com/hyperledger/fabric-protos-go/msp"
 	"github.com/stretchr/testify/require"
 	mmsdk "github.com/hyperledger-cacti/cacti/weaver/sdks/fabric/go-sdk/v2/membershipmanager"
  )
 
+// makeConfigBlock returns a channel config block holding the application MSPs with the given configurations
+func makeConfigBlock(t *testing.T, fabricMspConfigs ...*mspprotos.FabricMSPConfig) *common.Block {
+	orgGroups := map[string]*common.ConfigGroup{}
+	for _, fabricMspConfig := range fabricMspConfigs {
+		fabricMspConfigBytes, err := proto.Marshal(fabricMspConfig)
+		require.NoError(t, err)
+		mspConfigBytes, err := proto.Marshal(&mspprotos.MSPConfig{Type: 0, Config: fabricMspConfigBytes})
+		require.NoError(t, err)
+		orgGroups[fabricMspConfig.Name] = &common.ConfigGroup{
+			Values: map[string]*common.ConfigValue{"MSP": {Value: mspConfigBytes}},
+		}
+	}
+	configEnvelopeBytes, err := proto.Marshal(&common.ConfigEnvelope{
+		Config: &common.Config{
+			ChannelGroup: &common.ConfigGroup{
+				Groups: map[string]*common.ConfigGroup{"Application": {Groups: orgGroups}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	channelHeaderBytes, err := proto.Marshal(&common.ChannelHeader{Type: int32(common.HeaderType_CONFIG)})
+	require.NoError(t, err)
+	payloadBytes, err := proto.Marshal(&common.Payload{
+		Header: &common.Header{ChannelHeader: channelHeaderBytes},
+		Data:   configEnvelopeBytes,
+	})
+	require.NoError(t, err)
+	envelopeBytes, err := proto.Marshal(&common.Envelope{Payload: payloadBytes})
+	require.NoError(t, err)
+	return &common.Block{Data: &common.BlockData{Data: [][]byte{envelopeBytes}}}
+}
+
+func TestGetMembershipFromBlock(t *testing.T) {
+	org1MspConfig := &mspprotos.FabricMSPConfig{
+		Name:              "Org1MSP",
+		RootCerts:         [][]byte{[]byte("org1-root-ca")},
+		IntermediateCerts: [][]byte{[]byte("org1-intermediate-ca")},
+		RevocationList:    [][]byte{[]byte("org1-root-crl"), []byte("org1-intermediate-crl")},
+	}
+	org2MspConfig := &mspprotos.FabricMSPConfig{
+		Name:      "Org2MSP",
+		RootCerts: [][]byte{[]byte("org2-root-ca")},
+	}
+	ordererMspConfig := &mspprotos.FabricMSPConfig{
+		Name:      "OrdererMSP",
+		RootCerts: [][]byte{[]byte("orderer-root-ca")},
+	}
+	block := makeConfigBlock(t, org1MspConfig, org2MspConfig, ordererMspConfig)
+
+	// the CA chain and the CRLs of an MSP are extracted from the block
+	member, err := mmsdk.GetMembershipForMspIdFromBlock(block, "Org1MSP")
+	require.NoError(t, err)
+	require.Equal(t, "certificate", member.Type)
+	require.Equal(t, []string{"org1-root-ca", "org1-intermediate-ca"}, member.Chain)
+	require.Equal(t, []string{"org1-root-crl", "org1-intermediate-crl"}, member.Crls)
+	member, err = mmsdk.GetMembershipForMspIdFromBlock(block, "Org3MSP")
+	require.NoError(t, err)
+	require.Nil(t, member)
+
+	membership, err := mmsdk.GetMembershipForMspIdsFromBlock(block, []string{"Org1MSP", "Org2MSP"})
+	require.NoError(t, err)
+	require.Len(t, membership.Members, 2)
+	require.Equal(t, []string{"org1-root-crl", "org1-intermediate-crl"}, membership.Members["Org1MSP"].Crls)
+	require.Equal(t, []string{"org2-root-ca"}, membership.Members["Org2MSP"].Chain)
+	require.Empty(t, membership.Members["Org2MSP"].Crls)
+
+	membership, err = mmsdk.GetMembershipForAllMspIdsFromBlock(block, []string{"OrdererMSP"})
+	require.NoError(t, err)
+	require.Len(t, membership.Members, 2)
+	require.Equal(t, []string{"org1-root-crl", "org1-intermediate-crl"}, membership.Members["Org1MSP"].Crls)
+	require.NotContains(t, membership.Members, "OrdererMSP")
+}
+
 
 func TestMembershipManager(t *testing.T) {
 
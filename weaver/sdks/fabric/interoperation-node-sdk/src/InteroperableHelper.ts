@@ -96,10 +96,104 @@ const decryptRemoteChaincodeOutput = (
   return propResp;
 };
 
-const decryptData = (dataBytes, eciesPrivateKeyPEM) => {
-  const privKey = keyutil.getKeyFromPlainPrivatePKCS8PEM(eciesPrivateKeyPEM);
+// Context bound to the keys used in the hybrid encryption of confidential payloads for Ed25519 and RSA keys
+const hybridEncryptionInfo = "weaver-confidential-payload";
+// DER encodings of raw X25519 keys are these prefixes followed by the 32 key bytes
+const x25519PKCS8Prefix = Buffer.from("302e020100300506032b656e04220420", "hex");
+const x25519SPKIPrefix = Buffer.from("302a300506032b656e032100", "hex");
+
+/**
+ * Decrypts data encrypted for the owner of the given private key: ECIES for ECDSA keys, and hybrid encryption
+ * (AES-256-GCM under a key agreed using X25519, or wrapped using RSA-OAEP) for Ed25519 and RSA keys
+ **/
+const decryptData = (dataBytes, privKeyPEM) => {
+  const privKey = crypto.createPrivateKey(privKeyPEM);
+  if (privKey.asymmetricKeyType === "ed25519") {
+    return decryptDataWithEd25519Key(Buffer.from(dataBytes), privKey);
+  } else if (privKey.asymmetricKeyType === "rsa") {
+    return decryptDataWithRSAKey(Buffer.from(dataBytes), privKey);
+  }
+  const eciesPrivKey = keyutil.getKeyFromPlainPrivatePKCS8PEM(privKeyPEM);
   const decryptionOptions = { hashAlgorithm: "SHA2" };
-  return eciesCrypto.eciesDecryptMessage(privKey, dataBytes, decryptionOptions);
+  return eciesCrypto.eciesDecryptMessage(
+    eciesPrivKey,
+    dataBytes,
+    decryptionOptions,
+  );
+};
+
+// The data is the ephemeral X25519 public key of the sender, followed by the sealed message
+const decryptDataWithEd25519Key = (
+  dataBytes: Buffer,
+  privKey: crypto.KeyObject,
+) => {
+  // The X25519 private key of the same key pair is the first half of the SHA-512 hash of the Ed25519 seed
+  const seed = Buffer.from(
+    privKey.export({ format: "jwk" }).d as string,
+    "base64url",
+  );
+  const x25519PrivKey = crypto.createPrivateKey({
+    key: Buffer.concat([
+      x25519PKCS8Prefix,
+      crypto.createHash("sha512").update(seed).digest().subarray(0, 32),
+    ]),
+    format: "der",
+    type: "pkcs8",
+  });
+  const x25519PubKeyBytes = crypto
+    .createPublicKey(x25519PrivKey)
+    .export({ format: "der", type: "spki" })
+    .subarray(x25519SPKIPrefix.length);
+  const ephemeralPubKeyBytes = dataBytes.subarray(0, 32);
+  const sharedSecret = crypto.diffieHellman({
+    privateKey: x25519PrivKey,
+    publicKey: crypto.createPublicKey({
+      key: Buffer.concat([x25519SPKIPrefix, ephemeralPubKeyBytes]),
+      format: "der",
+      type: "spki",
+    }),
+  });
+  const key = Buffer.from(
+    crypto.hkdfSync(
+      "sha256",
+      sharedSecret,
+      Buffer.concat([ephemeralPubKeyBytes, x25519PubKeyBytes]),
+      hybridEncryptionInfo,
+      32,
+    ),
+  );
+  return openWithAESGCM(dataBytes.subarray(32), key);
+};
+
+// The data is the message key wrapped using RSA-OAEP (as long as the key's modulus), followed by the sealed message
+const decryptDataWithRSAKey = (dataBytes: Buffer, privKey: crypto.KeyObject) => {
+  const wrappedKeyLength = Math.ceil(
+    privKey.asymmetricKeyDetails.modulusLength / 8,
+  );
+  const key = crypto.privateDecrypt(
+    {
+      key: privKey,
+      padding: crypto.constants.RSA_PKCS1_OAEP_PADDING,
+      oaepHash: "sha256",
+      oaepLabel: Buffer.from(hybridEncryptionInfo),
+    },
+    dataBytes.subarray(0, wrappedKeyLength),
+  );
+  return openWithAESGCM(dataBytes.subarray(wrappedKeyLength), key);
+};
+
+// The sealed message is the GCM nonce (12 bytes), followed by the ciphertext and the tag (16 bytes)
+const openWithAESGCM = (sealedMessage: Buffer, key: Buffer) => {
+  const decipher = crypto.createDecipheriv(
+    "aes-256-gcm",
+    key,
+    sealedMessage.subarray(0, 12),
+  );
+  decipher.setAuthTag(sealedMessage.subarray(sealedMessage.length - 16));
+  return Buffer.concat([
+    decipher.update(sealedMessage.subarray(12, sealedMessage.length - 16)),
+    decipher.final(),
+  ]);
 };
 
 /* Validate proposal response received from remote network
@@ -236,7 +330,7 @@ const getResponseDataFromView = (view, privKeyPEM = null) => {
         fabricViewChaincodeAction.getResponse().getPayload_asU8(),
       );
       if (interopPayload.getConfidential()) {
-        // Currently this is only supported for Fabric because it uses ECDSA keys in wallets
+        // Currently this is only supported for Fabric views
         const confidentialPayload =
           interopPayloadPb.ConfidentialPayload.deserializeBinary(
             interopPayload.getPayload_asU8(),
@@ -24,6 +24,7 @@ const ecies = require("../src/eciesCrypto");
 const {
   decryptRemoteProposalResponse,
   decryptRemoteChaincodeOutput,
+  decryptData,
   verifyDecryptedRemoteProposalResponse,
   verifyRemoteProposalResponse,
   getKeyAndCertForRemoteRequestbyUserName,
@@ -246,6 +247,58 @@ describe("InteroperableHelper", () => {
     });
   });
 
+  describe("decrypt data", () => {
+    // Payloads encrypted by the Fabric Interoperation Chaincode for requesters with Ed25519 and RSA keys
+    const confidentialPayloads = JSON.parse(
+      fs.readFileSync(`${__dirname}/data/confidential_payloads.json`).toString(),
+    );
+
+    it("decrypt data using an ECDSA private key", () => {
+      const data = '{ "data": "xyz" }';
+      const privKeyPEM = fs
+        .readFileSync(`${__dirname}/data/privKey.pem`)
+        .toString();
+      const signCertPEM = fs
+        .readFileSync(`${__dirname}/data/signCert.pem`)
+        .toString();
+      const encryptedData = ecies.eciesEncryptMessage(
+        keyutil.getKey(signCertPEM),
+        Buffer.from(data),
+        { hashAlgorithm: "SHA2" },
+      );
+      const decryptedData = decryptData(encryptedData, privKeyPEM);
+      expect(decryptedData.toString()).to.equal(data);
+    });
+
+    it("decrypt data using an Ed25519 or RSA private key", () => {
+      for (const keyType of ["ed25519", "rsa"]) {
+        const decryptedData = decryptData(
+          Buffer.from(
+            confidentialPayloads[keyType].encryptedPayload,
+            "base64",
+          ),
+          confidentialPayloads[keyType].privateKey,
+        );
+        expect(decryptedData.toString()).to.equal(
+          confidentialPayloads.message,
+        );
+      }
+    });
+
+    it("fail to decrypt tampered data using an Ed25519 or RSA private key", () => {
+      for (const keyType of ["ed25519", "rsa"]) {
+        const encryptedData = Buffer.from(
+          confidentialPayloads[keyType].encryptedPayload,
+          "base64",
+        );
+        encryptedData[encryptedData.length - 1] ^= 1;
+        expect(() =>
+          decryptData(encryptedData, confidentialPayloads[keyType].privateKey),
+        ).to.throw();
+      }
+    });
+  });
+
   describe("verify remote proposal response", () => {
     beforeEach(() => {
       const ccResult = fs.readFileSync(